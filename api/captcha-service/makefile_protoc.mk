@@ -0,0 +1,33 @@
+# captcha service
+CAPTCHA_V1_PROTO_SERVICE=$(shell cd $(PROJECT_PATH) && find api/captcha-service/v1 -name "*.proto")
+#CAPTCHA_V1_PROTO_CONFIG=$(shell cd $(PROJECT_PATH) && find app/captcha-service/internal/conf -name "*.proto")
+CAPTCHA_V1_PROTO_CONFIG=
+CAPTCHA_V1_PROTO_FILES=""
+ifneq ($(CAPTCHA_V1_PROTO_CONFIG), "")
+	CAPTCHA_V1_PROTO_FILES=$(CAPTCHA_V1_PROTO_SERVICE) $(CAPTCHA_V1_PROTO_CONFIG)
+else
+	CAPTCHA_V1_PROTO_FILES=$(CAPTCHA_V1_PROTO_SERVICE)
+endif
+.PHONY: protoc-captcha-v1
+# protoc :-->: generate captcha v1 server protobuf
+protoc-captcha-v1:
+	@echo "# generate captcha-service protobuf"
+	if [ "$(CAPTCHA_V1_PROTO_FILES)" != "" ]; then \
+		cd $(PROJECT_PATH); \
+		protoc \
+			--proto_path=. \
+			--proto_path=$(GOPATH)/src \
+			--proto_path=./third_party \
+			--go_out=paths=source_relative:. \
+			--go-grpc_out=paths=source_relative:. \
+			--go-http_out=paths=source_relative:. \
+			--go-errors_out=paths=source_relative:. \
+			--validate_out=paths=source_relative,lang=go:. \
+			--openapiv2_out . \
+			--openapiv2_opt logtostderr=true \
+			--openapiv2_opt allow_delete_body=true \
+			--openapiv2_opt json_names_for_fields=false \
+			--openapiv2_opt enums_as_ints=true \
+			--openapi_out=fq_schema_naming=true,enum_type=integer,default_response=true:. \
+			$(CAPTCHA_V1_PROTO_FILES) ; \
+	fi
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.6
+// source: api/captcha-service/v1/enums/captcha.enum.v1.proto
+
+package enumv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CaptchaType 验证码类型
+type CaptchaTypeEnum_CaptchaType int32
+
+const (
+	// UNSPECIFIED 未指定
+	CaptchaTypeEnum_UNSPECIFIED CaptchaTypeEnum_CaptchaType = 0
+	// SMS 短信
+	CaptchaTypeEnum_SMS CaptchaTypeEnum_CaptchaType = 1
+	// EMAIL 邮件
+	CaptchaTypeEnum_EMAIL CaptchaTypeEnum_CaptchaType = 2
+	// IMAGE 图片
+	CaptchaTypeEnum_IMAGE CaptchaTypeEnum_CaptchaType = 3
+)
+
+// Enum value maps for CaptchaTypeEnum_CaptchaType.
+var (
+	CaptchaTypeEnum_CaptchaType_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SMS",
+		2: "EMAIL",
+		3: "IMAGE",
+	}
+	CaptchaTypeEnum_CaptchaType_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SMS":         1,
+		"EMAIL":       2,
+		"IMAGE":       3,
+	}
+)
+
+func (x CaptchaTypeEnum_CaptchaType) Enum() *CaptchaTypeEnum_CaptchaType {
+	p := new(CaptchaTypeEnum_CaptchaType)
+	*p = x
+	return p
+}
+
+func (x CaptchaTypeEnum_CaptchaType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CaptchaTypeEnum_CaptchaType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_captcha_service_v1_enums_captcha_enum_v1_proto_enumTypes[0].Descriptor()
+}
+
+func (CaptchaTypeEnum_CaptchaType) Type() protoreflect.EnumType {
+	return &file_api_captcha_service_v1_enums_captcha_enum_v1_proto_enumTypes[0]
+}
+
+func (x CaptchaTypeEnum_CaptchaType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CaptchaTypeEnum_CaptchaType.Descriptor instead.
+func (CaptchaTypeEnum_CaptchaType) EnumDescriptor() ([]byte, []int) {
+	return file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescGZIP(), []int{0, 0}
+}
+
+// CaptchaTypeEnum 验证码类型
+type CaptchaTypeEnum struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CaptchaTypeEnum) Reset() {
+	*x = CaptchaTypeEnum{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_captcha_service_v1_enums_captcha_enum_v1_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptchaTypeEnum) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptchaTypeEnum) ProtoMessage() {}
+
+func (x *CaptchaTypeEnum) ProtoReflect() protoreflect.Message {
+	mi := &file_api_captcha_service_v1_enums_captcha_enum_v1_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptchaTypeEnum.ProtoReflect.Descriptor instead.
+func (*CaptchaTypeEnum) Descriptor() ([]byte, []int) {
+	return file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescGZIP(), []int{0}
+}
+
+var File_api_captcha_service_v1_enums_captcha_enum_v1_proto protoreflect.FileDescriptor
+
+var file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDesc = []byte{
+	0x0a, 0x32, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x63,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x17, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x76, 0x31, 0x22, 0x50, 0x0a,
+	0x0f, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75, 0x6d,
+	0x22, 0x3d, 0x0a, 0x0b, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x53, 0x4d, 0x53, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41,
+	0x49, 0x4c, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0x03, 0x42,
+	0x7c, 0x0a, 0x17, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74,
+	0x63, 0x68, 0x61, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x76, 0x31, 0x42, 0x14, 0x53, 0x61, 0x61, 0x73,
+	0x41, 0x70, 0x69, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x31,
+	0x50, 0x01, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d,
+	0x79, 0x2d, 0x73, 0x61, 0x61, 0x73, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f,
+	0x61, 0x70, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61,
+	0x70, 0x74, 0x63, 0x68, 0x61, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x3b, 0x65, 0x6e, 0x75, 0x6d, 0x76, 0x31, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescOnce sync.Once
+	file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescData = file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDesc
+)
+
+func file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescGZIP() []byte {
+	file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescOnce.Do(func() {
+		file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescData)
+	})
+	return file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDescData
+}
+
+var file_api_captcha_service_v1_enums_captcha_enum_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_captcha_service_v1_enums_captcha_enum_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_captcha_service_v1_enums_captcha_enum_v1_proto_goTypes = []interface{}{
+	(CaptchaTypeEnum_CaptchaType)(0), // 0: saas.api.captcha.enumv1.CaptchaTypeEnum.CaptchaType
+	(*CaptchaTypeEnum)(nil),          // 1: saas.api.captcha.enumv1.CaptchaTypeEnum
+}
+var file_api_captcha_service_v1_enums_captcha_enum_v1_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_captcha_service_v1_enums_captcha_enum_v1_proto_init() }
+func file_api_captcha_service_v1_enums_captcha_enum_v1_proto_init() {
+	if File_api_captcha_service_v1_enums_captcha_enum_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_captcha_service_v1_enums_captcha_enum_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptchaTypeEnum); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_captcha_service_v1_enums_captcha_enum_v1_proto_goTypes,
+		DependencyIndexes: file_api_captcha_service_v1_enums_captcha_enum_v1_proto_depIdxs,
+		EnumInfos:         file_api_captcha_service_v1_enums_captcha_enum_v1_proto_enumTypes,
+		MessageInfos:      file_api_captcha_service_v1_enums_captcha_enum_v1_proto_msgTypes,
+	}.Build()
+	File_api_captcha_service_v1_enums_captcha_enum_v1_proto = out.File
+	file_api_captcha_service_v1_enums_captcha_enum_v1_proto_rawDesc = nil
+	file_api_captcha_service_v1_enums_captcha_enum_v1_proto_goTypes = nil
+	file_api_captcha_service_v1_enums_captcha_enum_v1_proto_depIdxs = nil
+}
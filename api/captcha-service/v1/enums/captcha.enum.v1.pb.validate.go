@@ -0,0 +1,136 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/captcha-service/v1/enums/captcha.enum.v1.proto
+
+package enumv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on CaptchaTypeEnum with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CaptchaTypeEnum) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CaptchaTypeEnum with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CaptchaTypeEnumMultiError, or nil if none found.
+func (m *CaptchaTypeEnum) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CaptchaTypeEnum) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return CaptchaTypeEnumMultiError(errors)
+	}
+
+	return nil
+}
+
+// CaptchaTypeEnumMultiError is an error wrapping multiple validation errors
+// returned by CaptchaTypeEnum.ValidateAll() if the designated constraints
+// aren't met.
+type CaptchaTypeEnumMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CaptchaTypeEnumMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CaptchaTypeEnumMultiError) AllErrors() []error { return m }
+
+// CaptchaTypeEnumValidationError is the validation error returned by
+// CaptchaTypeEnum.Validate if the designated constraints aren't met.
+type CaptchaTypeEnumValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptchaTypeEnumValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptchaTypeEnumValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptchaTypeEnumValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptchaTypeEnumValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptchaTypeEnumValidationError) ErrorName() string { return "CaptchaTypeEnumValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CaptchaTypeEnumValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptchaTypeEnum.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptchaTypeEnumValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptchaTypeEnumValidationError{}
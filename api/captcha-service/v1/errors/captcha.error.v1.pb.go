@@ -0,0 +1,188 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.6
+// source: api/captcha-service/v1/errors/captcha.error.v1.proto
+
+package errorv1
+
+import (
+	_ "github.com/go-kratos/kratos/v2/errors"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ERROR .
+type ERROR int32
+
+const (
+	ERROR_UNKNOWN ERROR = 0
+	// CAPTCHA_INVALID_TYPE 不支持的验证码类型
+	ERROR_CAPTCHA_INVALID_TYPE ERROR = 100300001
+	// CAPTCHA_INVALID_TARGET 接收者为空
+	ERROR_CAPTCHA_INVALID_TARGET ERROR = 100300002
+	// CAPTCHA_NOT_FOUND 验证码不存在：已过期或已使用
+	ERROR_CAPTCHA_NOT_FOUND ERROR = 100300003
+	// CAPTCHA_INCORRECT 验证码错误
+	ERROR_CAPTCHA_INCORRECT ERROR = 100300004
+	// CAPTCHA_TOO_MANY_ATTEMPTS 验证次数过多；验证码已失效
+	ERROR_CAPTCHA_TOO_MANY_ATTEMPTS ERROR = 100300005
+	// CAPTCHA_SEND_TOO_FREQUENT 发送过于频繁
+	ERROR_CAPTCHA_SEND_TOO_FREQUENT ERROR = 100300006
+	// CAPTCHA_SEND_FAILED 发送失败
+	ERROR_CAPTCHA_SEND_FAILED ERROR = 100300007
+	// CAPTCHA_INVALID_TOKEN 验证凭证无效：签名错误、已过期、已使用，或接收者与用途不一致
+	ERROR_CAPTCHA_INVALID_TOKEN ERROR = 100300008
+)
+
+// Enum value maps for ERROR.
+var (
+	ERROR_name = map[int32]string{
+		0:         "UNKNOWN",
+		100300001: "CAPTCHA_INVALID_TYPE",
+		100300002: "CAPTCHA_INVALID_TARGET",
+		100300003: "CAPTCHA_NOT_FOUND",
+		100300004: "CAPTCHA_INCORRECT",
+		100300005: "CAPTCHA_TOO_MANY_ATTEMPTS",
+		100300006: "CAPTCHA_SEND_TOO_FREQUENT",
+		100300007: "CAPTCHA_SEND_FAILED",
+		100300008: "CAPTCHA_INVALID_TOKEN",
+	}
+	ERROR_value = map[string]int32{
+		"UNKNOWN":                   0,
+		"CAPTCHA_INVALID_TYPE":      100300001,
+		"CAPTCHA_INVALID_TARGET":    100300002,
+		"CAPTCHA_NOT_FOUND":         100300003,
+		"CAPTCHA_INCORRECT":         100300004,
+		"CAPTCHA_TOO_MANY_ATTEMPTS": 100300005,
+		"CAPTCHA_SEND_TOO_FREQUENT": 100300006,
+		"CAPTCHA_SEND_FAILED":       100300007,
+		"CAPTCHA_INVALID_TOKEN":     100300008,
+	}
+)
+
+func (x ERROR) Enum() *ERROR {
+	p := new(ERROR)
+	*p = x
+	return p
+}
+
+func (x ERROR) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ERROR) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_captcha_service_v1_errors_captcha_error_v1_proto_enumTypes[0].Descriptor()
+}
+
+func (ERROR) Type() protoreflect.EnumType {
+	return &file_api_captcha_service_v1_errors_captcha_error_v1_proto_enumTypes[0]
+}
+
+func (x ERROR) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ERROR.Descriptor instead.
+func (ERROR) EnumDescriptor() ([]byte, []int) {
+	return file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescGZIP(), []int{0}
+}
+
+var File_api_captcha_service_v1_errors_captcha_error_v1_proto protoreflect.FileDescriptor
+
+var file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDesc = []byte{
+	0x0a, 0x34, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f,
+	0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x18, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x76, 0x31,
+	0x1a, 0x13, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2a, 0xbe, 0x02, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x12,
+	0x11, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x1a, 0x04, 0xa8, 0x45,
+	0x94, 0x03, 0x12, 0x21, 0x0a, 0x14, 0x43, 0x41, 0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0xe1, 0xe9, 0xe9, 0x2f, 0x1a,
+	0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x23, 0x0a, 0x16, 0x43, 0x41, 0x50, 0x54, 0x43, 0x48, 0x41,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x10,
+	0xe2, 0xe9, 0xe9, 0x2f, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x11, 0x43, 0x41,
+	0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10,
+	0xe3, 0xe9, 0xe9, 0x2f, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x1e, 0x0a, 0x11, 0x43, 0x41,
+	0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x10,
+	0xe4, 0xe9, 0xe9, 0x2f, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03, 0x12, 0x26, 0x0a, 0x19, 0x43, 0x41,
+	0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4d, 0x41, 0x4e, 0x59, 0x5f, 0x41,
+	0x54, 0x54, 0x45, 0x4d, 0x50, 0x54, 0x53, 0x10, 0xe5, 0xe9, 0xe9, 0x2f, 0x1a, 0x04, 0xa8, 0x45,
+	0x90, 0x03, 0x12, 0x26, 0x0a, 0x19, 0x43, 0x41, 0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x53, 0x45,
+	0x4e, 0x44, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x46, 0x52, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x54, 0x10,
+	0xe6, 0xe9, 0xe9, 0x2f, 0x1a, 0x04, 0xa8, 0x45, 0xad, 0x03, 0x12, 0x20, 0x0a, 0x13, 0x43, 0x41,
+	0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x10, 0xe7, 0xe9, 0xe9, 0x2f, 0x1a, 0x04, 0xa8, 0x45, 0xf4, 0x03, 0x12, 0x22, 0x0a, 0x15,
+	0x43, 0x41, 0x50, 0x54, 0x43, 0x48, 0x41, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x54, 0x4f, 0x4b, 0x45, 0x4e, 0x10, 0xe8, 0xe9, 0xe9, 0x2f, 0x1a, 0x04, 0xa8, 0x45, 0x90, 0x03,
+	0x1a, 0x04, 0xa0, 0x45, 0xf4, 0x03, 0x42, 0x80, 0x01, 0x0a, 0x18, 0x73, 0x61, 0x61, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x76, 0x31, 0x42, 0x15, 0x53, 0x61, 0x61, 0x73, 0x41, 0x70, 0x69, 0x43, 0x61, 0x70, 0x74,
+	0x63, 0x68, 0x61, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x56, 0x31, 0x50, 0x01, 0x5a, 0x4b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x79, 0x2d, 0x73, 0x61, 0x61, 0x73,
+	0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x3b, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescOnce sync.Once
+	file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescData = file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDesc
+)
+
+func file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescGZIP() []byte {
+	file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescOnce.Do(func() {
+		file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescData)
+	})
+	return file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDescData
+}
+
+var file_api_captcha_service_v1_errors_captcha_error_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_captcha_service_v1_errors_captcha_error_v1_proto_goTypes = []interface{}{
+	(ERROR)(0), // 0: saas.api.captcha.errorv1.ERROR
+}
+var file_api_captcha_service_v1_errors_captcha_error_v1_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_captcha_service_v1_errors_captcha_error_v1_proto_init() }
+func file_api_captcha_service_v1_errors_captcha_error_v1_proto_init() {
+	if File_api_captcha_service_v1_errors_captcha_error_v1_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_captcha_service_v1_errors_captcha_error_v1_proto_goTypes,
+		DependencyIndexes: file_api_captcha_service_v1_errors_captcha_error_v1_proto_depIdxs,
+		EnumInfos:         file_api_captcha_service_v1_errors_captcha_error_v1_proto_enumTypes,
+	}.Build()
+	File_api_captcha_service_v1_errors_captcha_error_v1_proto = out.File
+	file_api_captcha_service_v1_errors_captcha_error_v1_proto_rawDesc = nil
+	file_api_captcha_service_v1_errors_captcha_error_v1_proto_goTypes = nil
+	file_api_captcha_service_v1_errors_captcha_error_v1_proto_depIdxs = nil
+}
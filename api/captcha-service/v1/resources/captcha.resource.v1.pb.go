@@ -0,0 +1,475 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.6
+// source: api/captcha-service/v1/resources/captcha.resource.v1.proto
+
+package resourcev1
+
+import (
+	enums "github.com/my-saas-platform/api-proto/api/captcha-service/v1/enums"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SendCaptchaReq 发送验证码
+type SendCaptchaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// captcha_type 验证码类型
+	CaptchaType enums.CaptchaTypeEnum_CaptchaType `protobuf:"varint,1,opt,name=captcha_type,json=captchaType,proto3,enum=saas.api.captcha.enumv1.CaptchaTypeEnum_CaptchaType" json:"captcha_type,omitempty"`
+	// target 接收者：手机号、邮箱；图片验证码为空
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// purpose 用途；例：login、register；验证时需一致
+	Purpose string `protobuf:"bytes,3,opt,name=purpose,proto3" json:"purpose,omitempty"`
+}
+
+func (x *SendCaptchaReq) Reset() {
+	*x = SendCaptchaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendCaptchaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCaptchaReq) ProtoMessage() {}
+
+func (x *SendCaptchaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCaptchaReq.ProtoReflect.Descriptor instead.
+func (*SendCaptchaReq) Descriptor() ([]byte, []int) {
+	return file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SendCaptchaReq) GetCaptchaType() enums.CaptchaTypeEnum_CaptchaType {
+	if x != nil {
+		return x.CaptchaType
+	}
+	return enums.CaptchaTypeEnum_CaptchaType(0)
+}
+
+func (x *SendCaptchaReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *SendCaptchaReq) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+// SendCaptchaResp 发送验证码
+type SendCaptchaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// captcha_id 验证码ID；验证时使用
+	CaptchaId string `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`
+	// expires_in 有效时间
+	ExpiresIn *durationpb.Duration `protobuf:"bytes,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// resend_after 重新发送的等待时间；图片验证码为空
+	ResendAfter *durationpb.Duration `protobuf:"bytes,3,opt,name=resend_after,json=resendAfter,proto3" json:"resend_after,omitempty"`
+	// image 图片验证码(png)；短信、邮件验证码为空
+	Image []byte `protobuf:"bytes,4,opt,name=image,proto3" json:"image,omitempty"`
+}
+
+func (x *SendCaptchaResp) Reset() {
+	*x = SendCaptchaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendCaptchaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendCaptchaResp) ProtoMessage() {}
+
+func (x *SendCaptchaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendCaptchaResp.ProtoReflect.Descriptor instead.
+func (*SendCaptchaResp) Descriptor() ([]byte, []int) {
+	return file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SendCaptchaResp) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *SendCaptchaResp) GetExpiresIn() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return nil
+}
+
+func (x *SendCaptchaResp) GetResendAfter() *durationpb.Duration {
+	if x != nil {
+		return x.ResendAfter
+	}
+	return nil
+}
+
+func (x *SendCaptchaResp) GetImage() []byte {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+// VerifyCaptchaReq 验证验证码；验证成功后失效，返回验证凭证
+type VerifyCaptchaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// captcha_id 验证码ID
+	CaptchaId string `protobuf:"bytes,1,opt,name=captcha_id,json=captchaId,proto3" json:"captcha_id,omitempty"`
+	// code 验证码
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// target 接收者；与发送时一致
+	Target string `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// purpose 用途；与发送时一致
+	Purpose string `protobuf:"bytes,4,opt,name=purpose,proto3" json:"purpose,omitempty"`
+}
+
+func (x *VerifyCaptchaReq) Reset() {
+	*x = VerifyCaptchaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCaptchaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCaptchaReq) ProtoMessage() {}
+
+func (x *VerifyCaptchaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCaptchaReq.ProtoReflect.Descriptor instead.
+func (*VerifyCaptchaReq) Descriptor() ([]byte, []int) {
+	return file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VerifyCaptchaReq) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaReq) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaReq) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaReq) GetPurpose() string {
+	if x != nil {
+		return x.Purpose
+	}
+	return ""
+}
+
+// VerifyCaptchaResp 验证验证码；验证失败时返回错误
+type VerifyCaptchaResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// verification_token 验证凭证；签名的短期凭证，仅可使用一次；业务接口使用 captchautil.Service.VerifyToken 验证
+	VerificationToken string `protobuf:"bytes,1,opt,name=verification_token,json=verificationToken,proto3" json:"verification_token,omitempty"`
+	// expires_in 验证凭证的有效时间
+	ExpiresIn *durationpb.Duration `protobuf:"bytes,2,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+}
+
+func (x *VerifyCaptchaResp) Reset() {
+	*x = VerifyCaptchaResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCaptchaResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCaptchaResp) ProtoMessage() {}
+
+func (x *VerifyCaptchaResp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCaptchaResp.ProtoReflect.Descriptor instead.
+func (*VerifyCaptchaResp) Descriptor() ([]byte, []int) {
+	return file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *VerifyCaptchaResp) GetVerificationToken() string {
+	if x != nil {
+		return x.VerificationToken
+	}
+	return ""
+}
+
+func (x *VerifyCaptchaResp) GetExpiresIn() *durationpb.Duration {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return nil
+}
+
+var File_api_captcha_service_v1_resources_captcha_resource_v1_proto protoreflect.FileDescriptor
+
+var file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDesc = []byte{
+	0x0a, 0x3a, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1b, 0x73, 0x61,
+	0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x32, 0x61, 0x70, 0x69, 0x2f,
+	0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61,
+	0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0,
+	0x01, 0x0a, 0x0e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65,
+	0x71, 0x12, 0x5c, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x34, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x76,
+	0x31, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x75,
+	0x6d, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x79, 0x70, 0x65, 0x42, 0x03, 0xe0,
+	0x41, 0x02, 0x52, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73,
+	0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x49, 0x6e, 0x12, 0x3c,
+	0x0a, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0b, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x10, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02,
+	0x52, 0x09, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x7c, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x38, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x49, 0x6e, 0x42, 0x8c, 0x01, 0x0a, 0x1b, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x76, 0x31, 0x42, 0x18, 0x53, 0x61, 0x61, 0x73, 0x41, 0x70, 0x69, 0x43, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x56, 0x31, 0x50, 0x01,
+	0x5a, 0x51, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x79, 0x2d,
+	0x73, 0x61, 0x61, 0x73, 0x2d, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x70,
+	0x69, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74,
+	0x63, 0x68, 0x61, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x3b, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescOnce sync.Once
+	file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescData = file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDesc
+)
+
+func file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescGZIP() []byte {
+	file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescOnce.Do(func() {
+		file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescData)
+	})
+	return file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDescData
+}
+
+var file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_api_captcha_service_v1_resources_captcha_resource_v1_proto_goTypes = []interface{}{
+	(*SendCaptchaReq)(nil),                 // 0: saas.api.captcha.resourcev1.SendCaptchaReq
+	(*SendCaptchaResp)(nil),                // 1: saas.api.captcha.resourcev1.SendCaptchaResp
+	(*VerifyCaptchaReq)(nil),               // 2: saas.api.captcha.resourcev1.VerifyCaptchaReq
+	(*VerifyCaptchaResp)(nil),              // 3: saas.api.captcha.resourcev1.VerifyCaptchaResp
+	(enums.CaptchaTypeEnum_CaptchaType)(0), // 4: saas.api.captcha.enumv1.CaptchaTypeEnum.CaptchaType
+	(*durationpb.Duration)(nil),            // 5: google.protobuf.Duration
+}
+var file_api_captcha_service_v1_resources_captcha_resource_v1_proto_depIdxs = []int32{
+	4, // 0: saas.api.captcha.resourcev1.SendCaptchaReq.captcha_type:type_name -> saas.api.captcha.enumv1.CaptchaTypeEnum.CaptchaType
+	5, // 1: saas.api.captcha.resourcev1.SendCaptchaResp.expires_in:type_name -> google.protobuf.Duration
+	5, // 2: saas.api.captcha.resourcev1.SendCaptchaResp.resend_after:type_name -> google.protobuf.Duration
+	5, // 3: saas.api.captcha.resourcev1.VerifyCaptchaResp.expires_in:type_name -> google.protobuf.Duration
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_api_captcha_service_v1_resources_captcha_resource_v1_proto_init() }
+func file_api_captcha_service_v1_resources_captcha_resource_v1_proto_init() {
+	if File_api_captcha_service_v1_resources_captcha_resource_v1_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCaptchaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendCaptchaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCaptchaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCaptchaResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_captcha_service_v1_resources_captcha_resource_v1_proto_goTypes,
+		DependencyIndexes: file_api_captcha_service_v1_resources_captcha_resource_v1_proto_depIdxs,
+		MessageInfos:      file_api_captcha_service_v1_resources_captcha_resource_v1_proto_msgTypes,
+	}.Build()
+	File_api_captcha_service_v1_resources_captcha_resource_v1_proto = out.File
+	file_api_captcha_service_v1_resources_captcha_resource_v1_proto_rawDesc = nil
+	file_api_captcha_service_v1_resources_captcha_resource_v1_proto_goTypes = nil
+	file_api_captcha_service_v1_resources_captcha_resource_v1_proto_depIdxs = nil
+}
@@ -0,0 +1,549 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/captcha-service/v1/resources/captcha.resource.v1.proto
+
+package resourcev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	enumv1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/enums"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+
+	_ = enumv1.CaptchaTypeEnum_CaptchaType(0)
+)
+
+// Validate checks the field values on SendCaptchaReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SendCaptchaReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendCaptchaReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SendCaptchaReqMultiError,
+// or nil if none found.
+func (m *SendCaptchaReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendCaptchaReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaptchaType
+
+	// no validation rules for Target
+
+	// no validation rules for Purpose
+
+	if len(errors) > 0 {
+		return SendCaptchaReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendCaptchaReqMultiError is an error wrapping multiple validation errors
+// returned by SendCaptchaReq.ValidateAll() if the designated constraints
+// aren't met.
+type SendCaptchaReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendCaptchaReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendCaptchaReqMultiError) AllErrors() []error { return m }
+
+// SendCaptchaReqValidationError is the validation error returned by
+// SendCaptchaReq.Validate if the designated constraints aren't met.
+type SendCaptchaReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendCaptchaReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendCaptchaReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendCaptchaReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendCaptchaReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendCaptchaReqValidationError) ErrorName() string { return "SendCaptchaReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendCaptchaReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendCaptchaReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendCaptchaReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendCaptchaReqValidationError{}
+
+// Validate checks the field values on SendCaptchaResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SendCaptchaResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SendCaptchaResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SendCaptchaRespMultiError, or nil if none found.
+func (m *SendCaptchaResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SendCaptchaResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaptchaId
+
+	if all {
+		switch v := interface{}(m.GetExpiresIn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendCaptchaRespValidationError{
+					field:  "ExpiresIn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendCaptchaRespValidationError{
+					field:  "ExpiresIn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresIn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendCaptchaRespValidationError{
+				field:  "ExpiresIn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResendAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SendCaptchaRespValidationError{
+					field:  "ResendAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SendCaptchaRespValidationError{
+					field:  "ResendAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResendAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendCaptchaRespValidationError{
+				field:  "ResendAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Image
+
+	if len(errors) > 0 {
+		return SendCaptchaRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// SendCaptchaRespMultiError is an error wrapping multiple validation errors
+// returned by SendCaptchaResp.ValidateAll() if the designated constraints
+// aren't met.
+type SendCaptchaRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SendCaptchaRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SendCaptchaRespMultiError) AllErrors() []error { return m }
+
+// SendCaptchaRespValidationError is the validation error returned by
+// SendCaptchaResp.Validate if the designated constraints aren't met.
+type SendCaptchaRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendCaptchaRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendCaptchaRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendCaptchaRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendCaptchaRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendCaptchaRespValidationError) ErrorName() string { return "SendCaptchaRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendCaptchaRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendCaptchaResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendCaptchaRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendCaptchaRespValidationError{}
+
+// Validate checks the field values on VerifyCaptchaReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyCaptchaReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyCaptchaReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyCaptchaReqMultiError, or nil if none found.
+func (m *VerifyCaptchaReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyCaptchaReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaptchaId
+
+	// no validation rules for Code
+
+	// no validation rules for Target
+
+	// no validation rules for Purpose
+
+	if len(errors) > 0 {
+		return VerifyCaptchaReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyCaptchaReqMultiError is an error wrapping multiple validation errors
+// returned by VerifyCaptchaReq.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyCaptchaReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyCaptchaReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyCaptchaReqMultiError) AllErrors() []error { return m }
+
+// VerifyCaptchaReqValidationError is the validation error returned by
+// VerifyCaptchaReq.Validate if the designated constraints aren't met.
+type VerifyCaptchaReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyCaptchaReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyCaptchaReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyCaptchaReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyCaptchaReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyCaptchaReqValidationError) ErrorName() string { return "VerifyCaptchaReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyCaptchaReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyCaptchaReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyCaptchaReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyCaptchaReqValidationError{}
+
+// Validate checks the field values on VerifyCaptchaResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyCaptchaResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyCaptchaResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyCaptchaRespMultiError, or nil if none found.
+func (m *VerifyCaptchaResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyCaptchaResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VerificationToken
+
+	if all {
+		switch v := interface{}(m.GetExpiresIn()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VerifyCaptchaRespValidationError{
+					field:  "ExpiresIn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VerifyCaptchaRespValidationError{
+					field:  "ExpiresIn",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresIn()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VerifyCaptchaRespValidationError{
+				field:  "ExpiresIn",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VerifyCaptchaRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyCaptchaRespMultiError is an error wrapping multiple validation errors
+// returned by VerifyCaptchaResp.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyCaptchaRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyCaptchaRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyCaptchaRespMultiError) AllErrors() []error { return m }
+
+// VerifyCaptchaRespValidationError is the validation error returned by
+// VerifyCaptchaResp.Validate if the designated constraints aren't met.
+type VerifyCaptchaRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyCaptchaRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyCaptchaRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyCaptchaRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyCaptchaRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyCaptchaRespValidationError) ErrorName() string {
+	return "VerifyCaptchaRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyCaptchaRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyCaptchaResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyCaptchaRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyCaptchaRespValidationError{}
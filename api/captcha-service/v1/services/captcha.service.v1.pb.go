@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v3.21.6
+// source: api/captcha-service/v1/services/captcha.service.v1.proto
+
+package servicev1
+
+import (
+	resources "github.com/my-saas-platform/api-proto/api/captcha-service/v1/resources"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_api_captcha_service_v1_services_captcha_service_v1_proto protoreflect.FileDescriptor
+
+var file_api_captcha_service_v1_services_captcha_service_v1_proto_rawDesc = []byte{
+	0x0a, 0x38, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1a, 0x73, 0x61, 0x61, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3a, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x32, 0xae, 0x02, 0x0a, 0x0c, 0x53, 0x72, 0x76, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x56,
+	0x31, 0x12, 0x89, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x12, 0x2b, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x2c,
+	0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x1f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22, 0x14, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31,
+	0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x91, 0x01,
+	0x0a, 0x0d, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12,
+	0x2d, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x2e,
+	0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
+	0x61, 0x2e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x76, 0x31, 0x2e, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x73, 0x70, 0x22, 0x21,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x3a, 0x01, 0x2a, 0x22, 0x16, 0x2f, 0x61, 0x70, 0x69, 0x2f,
+	0x76, 0x31, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x42, 0x88, 0x01, 0x0a, 0x1a, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x76, 0x31,
+	0x42, 0x17, 0x53, 0x61, 0x61, 0x73, 0x41, 0x70, 0x69, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x31, 0x50, 0x01, 0x5a, 0x4f, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x79, 0x2d, 0x73, 0x61, 0x61, 0x73, 0x2d,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var file_api_captcha_service_v1_services_captcha_service_v1_proto_goTypes = []interface{}{
+	(*resources.SendCaptchaReq)(nil),    // 0: saas.api.captcha.resourcev1.SendCaptchaReq
+	(*resources.VerifyCaptchaReq)(nil),  // 1: saas.api.captcha.resourcev1.VerifyCaptchaReq
+	(*resources.SendCaptchaResp)(nil),   // 2: saas.api.captcha.resourcev1.SendCaptchaResp
+	(*resources.VerifyCaptchaResp)(nil), // 3: saas.api.captcha.resourcev1.VerifyCaptchaResp
+}
+var file_api_captcha_service_v1_services_captcha_service_v1_proto_depIdxs = []int32{
+	0, // 0: saas.api.captcha.servicev1.SrvCaptchaV1.SendCaptcha:input_type -> saas.api.captcha.resourcev1.SendCaptchaReq
+	1, // 1: saas.api.captcha.servicev1.SrvCaptchaV1.VerifyCaptcha:input_type -> saas.api.captcha.resourcev1.VerifyCaptchaReq
+	2, // 2: saas.api.captcha.servicev1.SrvCaptchaV1.SendCaptcha:output_type -> saas.api.captcha.resourcev1.SendCaptchaResp
+	3, // 3: saas.api.captcha.servicev1.SrvCaptchaV1.VerifyCaptcha:output_type -> saas.api.captcha.resourcev1.VerifyCaptchaResp
+	2, // [2:4] is the sub-list for method output_type
+	0, // [0:2] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_api_captcha_service_v1_services_captcha_service_v1_proto_init() }
+func file_api_captcha_service_v1_services_captcha_service_v1_proto_init() {
+	if File_api_captcha_service_v1_services_captcha_service_v1_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_captcha_service_v1_services_captcha_service_v1_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_captcha_service_v1_services_captcha_service_v1_proto_goTypes,
+		DependencyIndexes: file_api_captcha_service_v1_services_captcha_service_v1_proto_depIdxs,
+	}.Build()
+	File_api_captcha_service_v1_services_captcha_service_v1_proto = out.File
+	file_api_captcha_service_v1_services_captcha_service_v1_proto_rawDesc = nil
+	file_api_captcha_service_v1_services_captcha_service_v1_proto_goTypes = nil
+	file_api_captcha_service_v1_services_captcha_service_v1_proto_depIdxs = nil
+}
@@ -0,0 +1,151 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.6
+// source: api/captcha-service/v1/services/captcha.service.v1.proto
+
+package servicev1
+
+import (
+	context "context"
+	resources "github.com/my-saas-platform/api-proto/api/captcha-service/v1/resources"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SrvCaptchaV1_SendCaptcha_FullMethodName   = "/saas.api.captcha.servicev1.SrvCaptchaV1/SendCaptcha"
+	SrvCaptchaV1_VerifyCaptcha_FullMethodName = "/saas.api.captcha.servicev1.SrvCaptchaV1/VerifyCaptcha"
+)
+
+// SrvCaptchaV1Client is the client API for SrvCaptchaV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SrvCaptchaV1Client interface {
+	// SendCaptcha 发送验证码
+	SendCaptcha(ctx context.Context, in *resources.SendCaptchaReq, opts ...grpc.CallOption) (*resources.SendCaptchaResp, error)
+	// VerifyCaptcha 验证验证码
+	VerifyCaptcha(ctx context.Context, in *resources.VerifyCaptchaReq, opts ...grpc.CallOption) (*resources.VerifyCaptchaResp, error)
+}
+
+type srvCaptchaV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSrvCaptchaV1Client(cc grpc.ClientConnInterface) SrvCaptchaV1Client {
+	return &srvCaptchaV1Client{cc}
+}
+
+func (c *srvCaptchaV1Client) SendCaptcha(ctx context.Context, in *resources.SendCaptchaReq, opts ...grpc.CallOption) (*resources.SendCaptchaResp, error) {
+	out := new(resources.SendCaptchaResp)
+	err := c.cc.Invoke(ctx, SrvCaptchaV1_SendCaptcha_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srvCaptchaV1Client) VerifyCaptcha(ctx context.Context, in *resources.VerifyCaptchaReq, opts ...grpc.CallOption) (*resources.VerifyCaptchaResp, error) {
+	out := new(resources.VerifyCaptchaResp)
+	err := c.cc.Invoke(ctx, SrvCaptchaV1_VerifyCaptcha_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SrvCaptchaV1Server is the server API for SrvCaptchaV1 service.
+// All implementations must embed UnimplementedSrvCaptchaV1Server
+// for forward compatibility
+type SrvCaptchaV1Server interface {
+	// SendCaptcha 发送验证码
+	SendCaptcha(context.Context, *resources.SendCaptchaReq) (*resources.SendCaptchaResp, error)
+	// VerifyCaptcha 验证验证码
+	VerifyCaptcha(context.Context, *resources.VerifyCaptchaReq) (*resources.VerifyCaptchaResp, error)
+	mustEmbedUnimplementedSrvCaptchaV1Server()
+}
+
+// UnimplementedSrvCaptchaV1Server must be embedded to have forward compatible implementations.
+type UnimplementedSrvCaptchaV1Server struct {
+}
+
+func (UnimplementedSrvCaptchaV1Server) SendCaptcha(context.Context, *resources.SendCaptchaReq) (*resources.SendCaptchaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCaptcha not implemented")
+}
+func (UnimplementedSrvCaptchaV1Server) VerifyCaptcha(context.Context, *resources.VerifyCaptchaReq) (*resources.VerifyCaptchaResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCaptcha not implemented")
+}
+func (UnimplementedSrvCaptchaV1Server) mustEmbedUnimplementedSrvCaptchaV1Server() {}
+
+// UnsafeSrvCaptchaV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SrvCaptchaV1Server will
+// result in compilation errors.
+type UnsafeSrvCaptchaV1Server interface {
+	mustEmbedUnimplementedSrvCaptchaV1Server()
+}
+
+func RegisterSrvCaptchaV1Server(s grpc.ServiceRegistrar, srv SrvCaptchaV1Server) {
+	s.RegisterService(&SrvCaptchaV1_ServiceDesc, srv)
+}
+
+func _SrvCaptchaV1_SendCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(resources.SendCaptchaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrvCaptchaV1Server).SendCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrvCaptchaV1_SendCaptcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrvCaptchaV1Server).SendCaptcha(ctx, req.(*resources.SendCaptchaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrvCaptchaV1_VerifyCaptcha_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(resources.VerifyCaptchaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrvCaptchaV1Server).VerifyCaptcha(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrvCaptchaV1_VerifyCaptcha_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrvCaptchaV1Server).VerifyCaptcha(ctx, req.(*resources.VerifyCaptchaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SrvCaptchaV1_ServiceDesc is the grpc.ServiceDesc for SrvCaptchaV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SrvCaptchaV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "saas.api.captcha.servicev1.SrvCaptchaV1",
+	HandlerType: (*SrvCaptchaV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendCaptcha",
+			Handler:    _SrvCaptchaV1_SendCaptcha_Handler,
+		},
+		{
+			MethodName: "VerifyCaptcha",
+			Handler:    _SrvCaptchaV1_VerifyCaptcha_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/captcha-service/v1/services/captcha.service.v1.proto",
+}
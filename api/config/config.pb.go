@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -218,6 +219,10 @@ type Server struct {
 	Http *Server_HTTP `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
 	// grpc grpc服务配置
 	Grpc *Server_GRPC `protobuf:"bytes,2,opt,name=grpc,proto3" json:"grpc,omitempty"`
+	// metrics prometheus 指标
+	Metrics *Server_Metrics `protobuf:"bytes,3,opt,name=metrics,proto3" json:"metrics,omitempty"`
+	// admin 引擎状态的管理端点
+	Admin *Server_Admin `protobuf:"bytes,4,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (x *Server) Reset() {
@@ -266,6 +271,20 @@ func (x *Server) GetGrpc() *Server_GRPC {
 	return nil
 }
 
+func (x *Server) GetMetrics() *Server_Metrics {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *Server) GetAdmin() *Server_Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
 // Infrastructure 基础
 type Infrastructure struct {
 	state         protoimpl.MessageState
@@ -280,6 +299,15 @@ type Infrastructure struct {
 	Consul    *Infrastructure_Consul    `protobuf:"bytes,6,opt,name=consul,proto3" json:"consul,omitempty"`
 	Jaeger    *Infrastructure_Jaeger    `protobuf:"bytes,7,opt,name=jaeger,proto3" json:"jaeger,omitempty"`
 	Snowflake *Infrastructure_Snowflake `protobuf:"bytes,8,opt,name=snowflake,proto3" json:"snowflake,omitempty"`
+	// mysql_instances 命名的MySQL实例；key = 实例名称；mysql 为默认实例(default)
+	MysqlInstances map[string]*Infrastructure_MySQL `protobuf:"bytes,9,rep,name=mysql_instances,json=mysqlInstances,proto3" json:"mysql_instances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// psql_instances 命名的Postgres实例；key = 实例名称；psql 为默认实例(default)
+	PsqlInstances map[string]*Infrastructure_PSQL `protobuf:"bytes,10,rep,name=psql_instances,json=psqlInstances,proto3" json:"psql_instances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// redis_instances 命名的Redis实例；key = 实例名称；redis 为默认实例(default)
+	RedisInstances map[string]*Infrastructure_Redis `protobuf:"bytes,11,rep,name=redis_instances,json=redisInstances,proto3" json:"redis_instances,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// otlp 链路追踪导出；启用后 jaeger 为遗留选项
+	Otlp *Infrastructure_Otlp `protobuf:"bytes,12,opt,name=otlp,proto3" json:"otlp,omitempty"` // etcd 服务注册与发现
+	Etcd *Infrastructure_Etcd `protobuf:"bytes,13,opt,name=etcd,proto3" json:"etcd,omitempty"`
 }
 
 func (x *Infrastructure) Reset() {
@@ -370,13 +398,48 @@ func (x *Infrastructure) GetSnowflake() *Infrastructure_Snowflake {
 	return nil
 }
 
+func (x *Infrastructure) GetMysqlInstances() map[string]*Infrastructure_MySQL {
+	if x != nil {
+		return x.MysqlInstances
+	}
+	return nil
+}
+
+func (x *Infrastructure) GetPsqlInstances() map[string]*Infrastructure_PSQL {
+	if x != nil {
+		return x.PsqlInstances
+	}
+	return nil
+}
+
+func (x *Infrastructure) GetRedisInstances() map[string]*Infrastructure_Redis {
+	if x != nil {
+		return x.RedisInstances
+	}
+	return nil
+}
+
+func (x *Infrastructure) GetOtlp() *Infrastructure_Otlp {
+	if x != nil {
+		return x.Otlp
+	}
+	return nil
+}
+
+func (x *Infrastructure) GetEtcd() *Infrastructure_Etcd {
+	if x != nil {
+		return x.Etcd
+	}
+	return nil
+}
+
 // Setting 设置
 type Setting struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// enable_service_registry 启用服务注册与发现
+	// enable_service_registry 启用服务注册与发现；需启用 consul 或 etcd；注册 app.http_endpoints 与 app.grpc_endpoints
 	EnableServiceRegistry bool `protobuf:"varint,1,opt,name=enable_service_registry,json=enableServiceRegistry,proto3" json:"enable_service_registry,omitempty"`
 	// enable_snowflake_worker 启用雪花算法
 	EnableSnowflakeWorker bool `protobuf:"varint,3,opt,name=enable_snowflake_worker,json=enableSnowflakeWorker,proto3" json:"enable_snowflake_worker,omitempty"`
@@ -384,12 +447,20 @@ type Setting struct {
 	EnableMigrateDb bool `protobuf:"varint,4,opt,name=enable_migrate_db,json=enableMigrateDb,proto3" json:"enable_migrate_db,omitempty"`
 	// enable_schedule_task 启用定时任务、计划任务
 	EnableScheduleTask bool `protobuf:"varint,5,opt,name=enable_schedule_task,json=enableScheduleTask,proto3" json:"enable_schedule_task,omitempty"`
-	// Captcha 验证码
+	// Captcha 验证码；setuputil.Engine.NewCaptchaService；需启用 redis
 	Captcha *Setting_Captcha `protobuf:"bytes,6,opt,name=captcha,proto3" json:"captcha,omitempty"`
-	// Login 登录
+	// Login 登录错误锁定；setuputil.Engine.GetLoginGuard；需启用 redis
 	Login *Setting_Login `protobuf:"bytes,7,opt,name=login,proto3" json:"login,omitempty"`
 	// secret 密码
 	EncryptSecret *Setting_EncryptSecret `protobuf:"bytes,8,opt,name=encrypt_secret,json=encryptSecret,proto3" json:"encrypt_secret,omitempty"`
+	// enable_outbox_relay 启用事务发件箱转发；需启用 rabbitmq 与 mysql 或 psql
+	EnableOutboxRelay bool `protobuf:"varint,9,opt,name=enable_outbox_relay,json=enableOutboxRelay,proto3" json:"enable_outbox_relay,omitempty"`
+	// service_registry_type 服务注册与发现的类型：consul、etcd；默认：启用的 consul，其次启用的 etcd
+	ServiceRegistryType string `protobuf:"bytes,10,opt,name=service_registry_type,json=serviceRegistryType,proto3" json:"service_registry_type,omitempty"`
+	// lock 分布式锁；需启用 redis
+	Lock *Setting_Lock `protobuf:"bytes,11,opt,name=lock,proto3" json:"lock,omitempty"`
+	// rate_limit 限流；使用 redis，不可用时使用进程内限流；配置变更时生效
+	RateLimit *Setting_RateLimit `protobuf:"bytes,12,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
 }
 
 func (x *Setting) Reset() {
@@ -473,6 +544,34 @@ func (x *Setting) GetEncryptSecret() *Setting_EncryptSecret {
 	return nil
 }
 
+func (x *Setting) GetEnableOutboxRelay() bool {
+	if x != nil {
+		return x.EnableOutboxRelay
+	}
+	return false
+}
+
+func (x *Setting) GetServiceRegistryType() string {
+	if x != nil {
+		return x.ServiceRegistryType
+	}
+	return ""
+}
+
+func (x *Setting) GetLock() *Setting_Lock {
+	if x != nil {
+		return x.Lock
+	}
+	return nil
+}
+
+func (x *Setting) GetRateLimit() *Setting_RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
 // ClientApi 客户端api
 type ClientApi struct {
 	state         protoimpl.MessageState
@@ -681,6 +780,158 @@ func (x *Server_GRPC) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+// Metrics prometheus 指标；指标名称前缀为 app.server_name
+type Server_Metrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enable 是否启动
+	Enable bool `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
+	// path http 路径；默认 /metrics
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// duration_buckets 耗时直方图的桶(s)；默认 prometheus.DefBuckets
+	DurationBuckets []float64 `protobuf:"fixed64,2,rep,packed,name=duration_buckets,json=durationBuckets,proto3" json:"duration_buckets,omitempty"`
+}
+
+func (x *Server_Metrics) Reset() {
+	*x = Server_Metrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Server_Metrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Metrics) ProtoMessage() {}
+
+func (x *Server_Metrics) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Metrics.ProtoReflect.Descriptor instead.
+func (*Server_Metrics) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{2, 2}
+}
+
+func (x *Server_Metrics) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Server_Metrics) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Server_Metrics) GetDurationBuckets() []float64 {
+	if x != nil {
+		return x.DurationBuckets
+	}
+	return nil
+}
+
+// Admin 引擎状态的管理端点：组件状态、服务注册与配置来源
+type Server_Admin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enable 是否启动
+	Enable bool `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
+	// path http 路径；默认 /debug/engine
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// username 基础认证的用户名；为空时不认证；未配置 username 与 allow_ips 时仅允许本机访问
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password 基础认证的密码
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// allow_ips 允许访问的 ip 或 cidr；为空且配置了 username 时不限制；例：127.0.0.1、10.0.0.0/8
+	AllowIps []string `protobuf:"bytes,4,rep,name=allow_ips,json=allowIps,proto3" json:"allow_ips,omitempty"`
+}
+
+func (x *Server_Admin) Reset() {
+	*x = Server_Admin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Server_Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Server_Admin) ProtoMessage() {}
+
+func (x *Server_Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Server_Admin.ProtoReflect.Descriptor instead.
+func (*Server_Admin) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{2, 3}
+}
+
+func (x *Server_Admin) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Server_Admin) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Server_Admin) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Server_Admin) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Server_Admin) GetAllowIps() []string {
+	if x != nil {
+		return x.AllowIps
+	}
+	return nil
+}
+
 // Log 日志
 type Infrastructure_Log struct {
 	state         protoimpl.MessageState
@@ -691,12 +942,15 @@ type Infrastructure_Log struct {
 	Console *Infrastructure_Log_Console `protobuf:"bytes,1,opt,name=console,proto3" json:"console,omitempty"`
 	// file 输出到文件
 	File *Infrastructure_Log_File `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// field_schema 结构化日志(console.format = json)的字段名；default(默认)、ecs；
+	// 字段名见 util/setup/README.md，发布后保持不变
+	FieldSchema string `protobuf:"bytes,3,opt,name=field_schema,json=fieldSchema,proto3" json:"field_schema,omitempty"`
 }
 
 func (x *Infrastructure_Log) Reset() {
 	*x = Infrastructure_Log{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[9]
+		mi := &file_api_config_config_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -709,7 +963,7 @@ func (x *Infrastructure_Log) String() string {
 func (*Infrastructure_Log) ProtoMessage() {}
 
 func (x *Infrastructure_Log) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[9]
+	mi := &file_api_config_config_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -739,6 +993,13 @@ func (x *Infrastructure_Log) GetFile() *Infrastructure_Log_File {
 	return nil
 }
 
+func (x *Infrastructure_Log) GetFieldSchema() string {
+	if x != nil {
+		return x.FieldSchema
+	}
+	return ""
+}
+
 // MySQL MySQL
 type Infrastructure_MySQL struct {
 	state         protoimpl.MessageState
@@ -762,12 +1023,29 @@ type Infrastructure_MySQL struct {
 	ConnMaxIdle uint32 `protobuf:"varint,8,opt,name=conn_max_idle,json=connMaxIdle,proto3" json:"conn_max_idle,omitempty"`
 	// conn_max_idle_time 设置连接空闲的最长时间(s)
 	ConnMaxIdleTime *durationpb.Duration `protobuf:"bytes,9,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
+	// replicas 从库dsn；配置后读请求路由到从库，写请求与事务路由到主库
+	Replicas []string `protobuf:"bytes,10,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// replica_policy 从库负载均衡策略；值：random(默认)、round_robin
+	ReplicaPolicy string `protobuf:"bytes,11,opt,name=replica_policy,json=replicaPolicy,proto3" json:"replica_policy,omitempty"`
+	// replica_health_check_interval 从库健康检查间隔(默认：5s)；检查失败的从库暂停使用
+	ReplicaHealthCheckInterval *durationpb.Duration `protobuf:"bytes,12,opt,name=replica_health_check_interval,json=replicaHealthCheckInterval,proto3" json:"replica_health_check_interval,omitempty"`
+	// enable_tls 是否启用tls；主库与从库使用相同的tls配置
+	EnableTls          bool `protobuf:"varint,13,opt,name=enable_tls,json=enableTls,proto3" json:"enable_tls,omitempty"`
+	InsecureSkipVerify bool `protobuf:"varint,14,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	// tls_server_name 校验证书的服务名称；默认为连接地址
+	TlsServerName string `protobuf:"bytes,15,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	TlsCaPem      string `protobuf:"bytes,16,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
+	TlsCertPem    string `protobuf:"bytes,17,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
+	TlsKeyPem     string `protobuf:"bytes,18,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
+	// password_provider 密码提供者名称；通过 setuputil.RegisterPasswordProvider 注册
+	// 配置后连接池每次新建连接时重新获取密码，用于轮换密码、IAM令牌等
+	PasswordProvider string `protobuf:"bytes,19,opt,name=password_provider,json=passwordProvider,proto3" json:"password_provider,omitempty"`
 }
 
 func (x *Infrastructure_MySQL) Reset() {
 	*x = Infrastructure_MySQL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[10]
+		mi := &file_api_config_config_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -780,7 +1058,7 @@ func (x *Infrastructure_MySQL) String() string {
 func (*Infrastructure_MySQL) ProtoMessage() {}
 
 func (x *Infrastructure_MySQL) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[10]
+	mi := &file_api_config_config_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -866,6 +1144,76 @@ func (x *Infrastructure_MySQL) GetConnMaxIdleTime() *durationpb.Duration {
 	return nil
 }
 
+func (x *Infrastructure_MySQL) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *Infrastructure_MySQL) GetReplicaPolicy() string {
+	if x != nil {
+		return x.ReplicaPolicy
+	}
+	return ""
+}
+
+func (x *Infrastructure_MySQL) GetReplicaHealthCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReplicaHealthCheckInterval
+	}
+	return nil
+}
+
+func (x *Infrastructure_MySQL) GetEnableTls() bool {
+	if x != nil {
+		return x.EnableTls
+	}
+	return false
+}
+
+func (x *Infrastructure_MySQL) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *Infrastructure_MySQL) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *Infrastructure_MySQL) GetTlsCaPem() string {
+	if x != nil {
+		return x.TlsCaPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_MySQL) GetTlsCertPem() string {
+	if x != nil {
+		return x.TlsCertPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_MySQL) GetTlsKeyPem() string {
+	if x != nil {
+		return x.TlsKeyPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_MySQL) GetPasswordProvider() string {
+	if x != nil {
+		return x.PasswordProvider
+	}
+	return ""
+}
+
 // Redis redis
 type Infrastructure_Redis struct {
 	state         protoimpl.MessageState
@@ -890,12 +1238,33 @@ type Infrastructure_Redis struct {
 	ConnMinIdle uint32 `protobuf:"varint,11,opt,name=conn_min_idle,json=connMinIdle,proto3" json:"conn_min_idle,omitempty"`
 	// conn_max_idle_time 设置连接空闲的最长时间(s)
 	ConnMaxIdleTime *durationpb.Duration `protobuf:"bytes,12,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
+	// mode 部署模式；值：single(单节点)、sentinel(哨兵)、cluster(集群)
+	// 未配置时由 addresses 与 master_name 决定：配置 master_name 为 sentinel；多个地址为 cluster；否则为 single
+	Mode string `protobuf:"bytes,13,opt,name=mode,proto3" json:"mode,omitempty"`
+	// master_name 哨兵模式的主节点名称
+	MasterName string `protobuf:"bytes,14,opt,name=master_name,json=masterName,proto3" json:"master_name,omitempty"`
+	// sentinel_username 哨兵的用户名
+	SentinelUsername string `protobuf:"bytes,15,opt,name=sentinel_username,json=sentinelUsername,proto3" json:"sentinel_username,omitempty"`
+	// sentinel_password 哨兵的密码
+	SentinelPassword string `protobuf:"bytes,16,opt,name=sentinel_password,json=sentinelPassword,proto3" json:"sentinel_password,omitempty"`
+	// read_from 只读命令路由；值：master(默认)、replica(从节点；仅cluster)、latency(按延迟)、random(随机)
+	// sentinel 不支持 replica：哨兵客户端无法仅将只读命令路由到从节点(ReplicaOnly 的写命令同样发往从节点)；
+	// sentinel 读从节点请使用 latency 或 random：只读命令在主节点与从节点间路由，写命令发往主节点
+	ReadFrom string `protobuf:"bytes,17,opt,name=read_from,json=readFrom,proto3" json:"read_from,omitempty"`
+	// enable_tls 是否启用tls
+	EnableTls          bool `protobuf:"varint,18,opt,name=enable_tls,json=enableTls,proto3" json:"enable_tls,omitempty"`
+	InsecureSkipVerify bool `protobuf:"varint,19,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	// tls_server_name 校验证书的服务名称；默认为连接地址
+	TlsServerName string `protobuf:"bytes,20,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	TlsCaPem      string `protobuf:"bytes,21,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
+	TlsCertPem    string `protobuf:"bytes,22,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
+	TlsKeyPem     string `protobuf:"bytes,23,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
 }
 
 func (x *Infrastructure_Redis) Reset() {
 	*x = Infrastructure_Redis{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[11]
+		mi := &file_api_config_config_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -908,7 +1277,7 @@ func (x *Infrastructure_Redis) String() string {
 func (*Infrastructure_Redis) ProtoMessage() {}
 
 func (x *Infrastructure_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[11]
+	mi := &file_api_config_config_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,35 +1384,129 @@ func (x *Infrastructure_Redis) GetConnMaxIdleTime() *durationpb.Duration {
 	return nil
 }
 
-// PSQL postgres
-type Infrastructure_PSQL struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Infrastructure_Redis) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
 
-	// enable 是否启动
-	Enable bool   `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
-	Dsn    string `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
-	// slow_threshold 慢查询(s)
-	SlowThreshold  *durationpb.Duration `protobuf:"bytes,2,opt,name=slow_threshold,json=slowThreshold,proto3" json:"slow_threshold,omitempty"`
-	LoggerEnable   bool                 `protobuf:"varint,3,opt,name=logger_enable,json=loggerEnable,proto3" json:"logger_enable,omitempty"`
-	LoggerColorful bool                 `protobuf:"varint,4,opt,name=logger_colorful,json=loggerColorful,proto3" json:"logger_colorful,omitempty"`
-	// logger_level 日志级别；值：DEBUG、INFO、WARN、ERROR、FATAL
-	LoggerLevel string `protobuf:"bytes,5,opt,name=logger_level,json=loggerLevel,proto3" json:"logger_level,omitempty"`
-	// conn_max_active 连接可复用的最大时间
-	ConnMaxActive uint32 `protobuf:"varint,6,opt,name=conn_max_active,json=connMaxActive,proto3" json:"conn_max_active,omitempty"`
-	// conn_max_lifetime 可复用的最大时间(s)
-	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,7,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
-	// conn_max_idle 连接池中空闲连接的最大数量
-	ConnMaxIdle uint32 `protobuf:"varint,8,opt,name=conn_max_idle,json=connMaxIdle,proto3" json:"conn_max_idle,omitempty"`
+func (x *Infrastructure_Redis) GetMasterName() string {
+	if x != nil {
+		return x.MasterName
+	}
+	return ""
+}
+
+func (x *Infrastructure_Redis) GetSentinelUsername() string {
+	if x != nil {
+		return x.SentinelUsername
+	}
+	return ""
+}
+
+func (x *Infrastructure_Redis) GetSentinelPassword() string {
+	if x != nil {
+		return x.SentinelPassword
+	}
+	return ""
+}
+
+func (x *Infrastructure_Redis) GetReadFrom() string {
+	if x != nil {
+		return x.ReadFrom
+	}
+	return ""
+}
+
+func (x *Infrastructure_Redis) GetEnableTls() bool {
+	if x != nil {
+		return x.EnableTls
+	}
+	return false
+}
+
+func (x *Infrastructure_Redis) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *Infrastructure_Redis) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *Infrastructure_Redis) GetTlsCaPem() string {
+	if x != nil {
+		return x.TlsCaPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_Redis) GetTlsCertPem() string {
+	if x != nil {
+		return x.TlsCertPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_Redis) GetTlsKeyPem() string {
+	if x != nil {
+		return x.TlsKeyPem
+	}
+	return ""
+}
+
+// PSQL postgres
+type Infrastructure_PSQL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enable 是否启动
+	Enable bool   `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
+	Dsn    string `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// slow_threshold 慢查询(s)
+	SlowThreshold  *durationpb.Duration `protobuf:"bytes,2,opt,name=slow_threshold,json=slowThreshold,proto3" json:"slow_threshold,omitempty"`
+	LoggerEnable   bool                 `protobuf:"varint,3,opt,name=logger_enable,json=loggerEnable,proto3" json:"logger_enable,omitempty"`
+	LoggerColorful bool                 `protobuf:"varint,4,opt,name=logger_colorful,json=loggerColorful,proto3" json:"logger_colorful,omitempty"`
+	// logger_level 日志级别；值：DEBUG、INFO、WARN、ERROR、FATAL
+	LoggerLevel string `protobuf:"bytes,5,opt,name=logger_level,json=loggerLevel,proto3" json:"logger_level,omitempty"`
+	// conn_max_active 连接可复用的最大时间
+	ConnMaxActive uint32 `protobuf:"varint,6,opt,name=conn_max_active,json=connMaxActive,proto3" json:"conn_max_active,omitempty"`
+	// conn_max_lifetime 可复用的最大时间(s)
+	ConnMaxLifetime *durationpb.Duration `protobuf:"bytes,7,opt,name=conn_max_lifetime,json=connMaxLifetime,proto3" json:"conn_max_lifetime,omitempty"`
+	// conn_max_idle 连接池中空闲连接的最大数量
+	ConnMaxIdle uint32 `protobuf:"varint,8,opt,name=conn_max_idle,json=connMaxIdle,proto3" json:"conn_max_idle,omitempty"`
 	// conn_max_idle_time 设置连接空闲的最长时间(s)
 	ConnMaxIdleTime *durationpb.Duration `protobuf:"bytes,9,opt,name=conn_max_idle_time,json=connMaxIdleTime,proto3" json:"conn_max_idle_time,omitempty"`
+	// replicas 从库dsn；配置后读请求路由到从库，写请求与事务路由到主库
+	Replicas []string `protobuf:"bytes,10,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// replica_policy 从库负载均衡策略；值：random(默认)、round_robin
+	ReplicaPolicy string `protobuf:"bytes,11,opt,name=replica_policy,json=replicaPolicy,proto3" json:"replica_policy,omitempty"`
+	// replica_health_check_interval 从库健康检查间隔(默认：5s)；检查失败的从库暂停使用
+	ReplicaHealthCheckInterval *durationpb.Duration `protobuf:"bytes,12,opt,name=replica_health_check_interval,json=replicaHealthCheckInterval,proto3" json:"replica_health_check_interval,omitempty"`
+	// enable_tls 是否启用tls；主库与从库使用相同的tls配置；dsn 的备用主机(host=a,b)同样使用tls，不回退到非加密连接
+	EnableTls          bool `protobuf:"varint,13,opt,name=enable_tls,json=enableTls,proto3" json:"enable_tls,omitempty"`
+	InsecureSkipVerify bool `protobuf:"varint,14,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	// tls_server_name 校验证书的服务名称；默认为连接地址
+	TlsServerName string `protobuf:"bytes,15,opt,name=tls_server_name,json=tlsServerName,proto3" json:"tls_server_name,omitempty"`
+	TlsCaPem      string `protobuf:"bytes,16,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
+	TlsCertPem    string `protobuf:"bytes,17,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
+	TlsKeyPem     string `protobuf:"bytes,18,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
+	// password_provider 密码提供者名称；通过 setuputil.RegisterPasswordProvider 注册
+	// 配置后连接池每次新建连接时重新获取密码，用于轮换密码、IAM令牌等
+	PasswordProvider string `protobuf:"bytes,19,opt,name=password_provider,json=passwordProvider,proto3" json:"password_provider,omitempty"`
 }
 
 func (x *Infrastructure_PSQL) Reset() {
 	*x = Infrastructure_PSQL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[12]
+		mi := &file_api_config_config_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1519,7 @@ func (x *Infrastructure_PSQL) String() string {
 func (*Infrastructure_PSQL) ProtoMessage() {}
 
 func (x *Infrastructure_PSQL) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[12]
+	mi := &file_api_config_config_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,6 +1605,76 @@ func (x *Infrastructure_PSQL) GetConnMaxIdleTime() *durationpb.Duration {
 	return nil
 }
 
+func (x *Infrastructure_PSQL) GetReplicas() []string {
+	if x != nil {
+		return x.Replicas
+	}
+	return nil
+}
+
+func (x *Infrastructure_PSQL) GetReplicaPolicy() string {
+	if x != nil {
+		return x.ReplicaPolicy
+	}
+	return ""
+}
+
+func (x *Infrastructure_PSQL) GetReplicaHealthCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReplicaHealthCheckInterval
+	}
+	return nil
+}
+
+func (x *Infrastructure_PSQL) GetEnableTls() bool {
+	if x != nil {
+		return x.EnableTls
+	}
+	return false
+}
+
+func (x *Infrastructure_PSQL) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *Infrastructure_PSQL) GetTlsServerName() string {
+	if x != nil {
+		return x.TlsServerName
+	}
+	return ""
+}
+
+func (x *Infrastructure_PSQL) GetTlsCaPem() string {
+	if x != nil {
+		return x.TlsCaPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_PSQL) GetTlsCertPem() string {
+	if x != nil {
+		return x.TlsCertPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_PSQL) GetTlsKeyPem() string {
+	if x != nil {
+		return x.TlsKeyPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_PSQL) GetPasswordProvider() string {
+	if x != nil {
+		return x.PasswordProvider
+	}
+	return ""
+}
+
 // Consul consul
 type Infrastructure_Consul struct {
 	state         protoimpl.MessageState
@@ -1166,12 +1699,20 @@ type Infrastructure_Consul struct {
 	TlsCaPem           string               `protobuf:"bytes,14,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
 	TlsCertPem         string               `protobuf:"bytes,15,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
 	TlsKeyPem          string               `protobuf:"bytes,16,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
+	// registry_health_check 服务注册的健康检查：ttl(默认；心跳)、http(请求 app.http_endpoints[0] + registry_health_check_path)
+	RegistryHealthCheck string `protobuf:"bytes,17,opt,name=registry_health_check,json=registryHealthCheck,proto3" json:"registry_health_check,omitempty"`
+	// registry_health_check_path http 健康检查的路径；默认 /health
+	RegistryHealthCheckPath string `protobuf:"bytes,18,opt,name=registry_health_check_path,json=registryHealthCheckPath,proto3" json:"registry_health_check_path,omitempty"`
+	// registry_health_check_interval 健康检查间隔；默认10s；ttl 为间隔的2倍
+	RegistryHealthCheckInterval *durationpb.Duration `protobuf:"bytes,19,opt,name=registry_health_check_interval,json=registryHealthCheckInterval,proto3" json:"registry_health_check_interval,omitempty"`
+	// registry_deregister_critical_after 健康检查失败多久后注销；默认600s
+	RegistryDeregisterCriticalAfter *durationpb.Duration `protobuf:"bytes,20,opt,name=registry_deregister_critical_after,json=registryDeregisterCriticalAfter,proto3" json:"registry_deregister_critical_after,omitempty"`
 }
 
 func (x *Infrastructure_Consul) Reset() {
 	*x = Infrastructure_Consul{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[13]
+		mi := &file_api_config_config_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1184,7 +1725,7 @@ func (x *Infrastructure_Consul) String() string {
 func (*Infrastructure_Consul) ProtoMessage() {}
 
 func (x *Infrastructure_Consul) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[13]
+	mi := &file_api_config_config_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1319,37 +1860,78 @@ func (x *Infrastructure_Consul) GetTlsKeyPem() string {
 	return ""
 }
 
-// Jaeger jaeger
-type Infrastructure_Jaeger struct {
+func (x *Infrastructure_Consul) GetRegistryHealthCheck() string {
+	if x != nil {
+		return x.RegistryHealthCheck
+	}
+	return ""
+}
+
+func (x *Infrastructure_Consul) GetRegistryHealthCheckPath() string {
+	if x != nil {
+		return x.RegistryHealthCheckPath
+	}
+	return ""
+}
+
+func (x *Infrastructure_Consul) GetRegistryHealthCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RegistryHealthCheckInterval
+	}
+	return nil
+}
+
+func (x *Infrastructure_Consul) GetRegistryDeregisterCriticalAfter() *durationpb.Duration {
+	if x != nil {
+		return x.RegistryDeregisterCriticalAfter
+	}
+	return nil
+}
+
+// Etcd etcd；服务注册与发现
+type Infrastructure_Etcd struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// enable 是否启动
-	Enable            bool   `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
-	Endpoint          string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	WithHttpBasicAuth bool   `protobuf:"varint,2,opt,name=with_http_basic_auth,json=withHttpBasicAuth,proto3" json:"with_http_basic_auth,omitempty"`
-	Username          string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Password          string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-}
-
-func (x *Infrastructure_Jaeger) Reset() {
-	*x = Infrastructure_Jaeger{}
+	Enable bool `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
+	// endpoints 地址；例：127.0.0.1:2379
+	Endpoints []string `protobuf:"bytes,1,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	Username  string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// dial_timeout 连接超时时间；默认5s
+	DialTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=dial_timeout,json=dialTimeout,proto3" json:"dial_timeout,omitempty"`
+	// insecure_skip_verify 跳过证书验证；配置 tls_*_pem 或 insecure_skip_verify 时使用 tls
+	InsecureSkipVerify bool   `protobuf:"varint,5,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	TlsCaPem           string `protobuf:"bytes,6,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
+	TlsCertPem         string `protobuf:"bytes,7,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
+	TlsKeyPem          string `protobuf:"bytes,8,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
+	// registry_namespace 服务注册的键前缀；默认 /microservices
+	RegistryNamespace string `protobuf:"bytes,9,opt,name=registry_namespace,json=registryNamespace,proto3" json:"registry_namespace,omitempty"`
+	// registry_ttl 服务注册的租约时长；默认15s；通过keepalive续约
+	RegistryTtl *durationpb.Duration `protobuf:"bytes,10,opt,name=registry_ttl,json=registryTtl,proto3" json:"registry_ttl,omitempty"`
+	// registry_max_retry 续约失败后重新注册的最多次数；默认5
+	RegistryMaxRetry uint32 `protobuf:"varint,11,opt,name=registry_max_retry,json=registryMaxRetry,proto3" json:"registry_max_retry,omitempty"`
+}
+
+func (x *Infrastructure_Etcd) Reset() {
+	*x = Infrastructure_Etcd{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[14]
+		mi := &file_api_config_config_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Infrastructure_Jaeger) String() string {
+func (x *Infrastructure_Etcd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Infrastructure_Jaeger) ProtoMessage() {}
+func (*Infrastructure_Etcd) ProtoMessage() {}
 
-func (x *Infrastructure_Jaeger) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[14]
+func (x *Infrastructure_Etcd) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1360,137 +1942,97 @@ func (x *Infrastructure_Jaeger) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Infrastructure_Jaeger.ProtoReflect.Descriptor instead.
-func (*Infrastructure_Jaeger) Descriptor() ([]byte, []int) {
+// Deprecated: Use Infrastructure_Etcd.ProtoReflect.Descriptor instead.
+func (*Infrastructure_Etcd) Descriptor() ([]byte, []int) {
 	return file_api_config_config_proto_rawDescGZIP(), []int{3, 5}
 }
 
-func (x *Infrastructure_Jaeger) GetEnable() bool {
+func (x *Infrastructure_Etcd) GetEnable() bool {
 	if x != nil {
 		return x.Enable
 	}
 	return false
 }
 
-func (x *Infrastructure_Jaeger) GetEndpoint() string {
-	if x != nil {
-		return x.Endpoint
-	}
-	return ""
-}
-
-func (x *Infrastructure_Jaeger) GetWithHttpBasicAuth() bool {
+func (x *Infrastructure_Etcd) GetEndpoints() []string {
 	if x != nil {
-		return x.WithHttpBasicAuth
+		return x.Endpoints
 	}
-	return false
+	return nil
 }
 
-func (x *Infrastructure_Jaeger) GetUsername() string {
+func (x *Infrastructure_Etcd) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *Infrastructure_Jaeger) GetPassword() string {
+func (x *Infrastructure_Etcd) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-// RabbitMQ rabbitmq
-type Infrastructure_Rabbitmq struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// enable 是否启动
-	Enable     bool   `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
-	Url        string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
-	TlsAddress string `protobuf:"bytes,2,opt,name=tls_address,json=tlsAddress,proto3" json:"tls_address,omitempty"`
-	TlsCaPem   string `protobuf:"bytes,3,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
-	TlsCertPem string `protobuf:"bytes,4,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
-	TlsKeyPem  string `protobuf:"bytes,5,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
-}
-
-func (x *Infrastructure_Rabbitmq) Reset() {
-	*x = Infrastructure_Rabbitmq{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Infrastructure_Rabbitmq) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Infrastructure_Rabbitmq) ProtoMessage() {}
-
-func (x *Infrastructure_Rabbitmq) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Infrastructure_Etcd) GetDialTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.DialTimeout
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Infrastructure_Rabbitmq.ProtoReflect.Descriptor instead.
-func (*Infrastructure_Rabbitmq) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{3, 6}
+	return nil
 }
 
-func (x *Infrastructure_Rabbitmq) GetEnable() bool {
+func (x *Infrastructure_Etcd) GetInsecureSkipVerify() bool {
 	if x != nil {
-		return x.Enable
+		return x.InsecureSkipVerify
 	}
 	return false
 }
 
-func (x *Infrastructure_Rabbitmq) GetUrl() string {
+func (x *Infrastructure_Etcd) GetTlsCaPem() string {
 	if x != nil {
-		return x.Url
+		return x.TlsCaPem
 	}
 	return ""
 }
 
-func (x *Infrastructure_Rabbitmq) GetTlsAddress() string {
+func (x *Infrastructure_Etcd) GetTlsCertPem() string {
 	if x != nil {
-		return x.TlsAddress
+		return x.TlsCertPem
 	}
 	return ""
 }
 
-func (x *Infrastructure_Rabbitmq) GetTlsCaPem() string {
+func (x *Infrastructure_Etcd) GetTlsKeyPem() string {
 	if x != nil {
-		return x.TlsCaPem
+		return x.TlsKeyPem
 	}
 	return ""
 }
 
-func (x *Infrastructure_Rabbitmq) GetTlsCertPem() string {
+func (x *Infrastructure_Etcd) GetRegistryNamespace() string {
 	if x != nil {
-		return x.TlsCertPem
+		return x.RegistryNamespace
 	}
 	return ""
 }
 
-func (x *Infrastructure_Rabbitmq) GetTlsKeyPem() string {
+func (x *Infrastructure_Etcd) GetRegistryTtl() *durationpb.Duration {
 	if x != nil {
-		return x.TlsKeyPem
+		return x.RegistryTtl
 	}
-	return ""
+	return nil
 }
 
-// Snowflake snowflake
-type Infrastructure_Snowflake struct {
+func (x *Infrastructure_Etcd) GetRegistryMaxRetry() uint32 {
+	if x != nil {
+		return x.RegistryMaxRetry
+	}
+	return 0
+}
+
+// Jaeger jaeger
+type Infrastructure_Jaeger struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1498,29 +2040,28 @@ type Infrastructure_Snowflake struct {
 	// enable 是否启动
 	Enable            bool   `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
 	Endpoint          string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	WithDiscovery     bool   `protobuf:"varint,2,opt,name=with_discovery,json=withDiscovery,proto3" json:"with_discovery,omitempty"`
-	WithHttpBasicAuth bool   `protobuf:"varint,3,opt,name=with_http_basic_auth,json=withHttpBasicAuth,proto3" json:"with_http_basic_auth,omitempty"`
-	Username          string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	Password          string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	WithHttpBasicAuth bool   `protobuf:"varint,2,opt,name=with_http_basic_auth,json=withHttpBasicAuth,proto3" json:"with_http_basic_auth,omitempty"`
+	Username          string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password          string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 }
 
-func (x *Infrastructure_Snowflake) Reset() {
-	*x = Infrastructure_Snowflake{}
+func (x *Infrastructure_Jaeger) Reset() {
+	*x = Infrastructure_Jaeger{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[16]
+		mi := &file_api_config_config_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Infrastructure_Snowflake) String() string {
+func (x *Infrastructure_Jaeger) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Infrastructure_Snowflake) ProtoMessage() {}
+func (*Infrastructure_Jaeger) ProtoMessage() {}
 
-func (x *Infrastructure_Snowflake) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[16]
+func (x *Infrastructure_Jaeger) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1531,82 +2072,935 @@ func (x *Infrastructure_Snowflake) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Infrastructure_Snowflake.ProtoReflect.Descriptor instead.
-func (*Infrastructure_Snowflake) Descriptor() ([]byte, []int) {
+// Deprecated: Use Infrastructure_Jaeger.ProtoReflect.Descriptor instead.
+func (*Infrastructure_Jaeger) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{3, 6}
+}
+
+func (x *Infrastructure_Jaeger) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Infrastructure_Jaeger) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Infrastructure_Jaeger) GetWithHttpBasicAuth() bool {
+	if x != nil {
+		return x.WithHttpBasicAuth
+	}
+	return false
+}
+
+func (x *Infrastructure_Jaeger) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Infrastructure_Jaeger) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Otlp OpenTelemetry 链路追踪导出(OTLP)；sampler 与 resource_attributes 同时用于 jaeger
+type Infrastructure_Otlp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enable 是否启动
+	Enable bool `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
+	// endpoint 地址；例：127.0.0.1:4317(grpc)、127.0.0.1:4318(http)
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// protocol 传输协议：grpc(默认)、http
+	Protocol string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// url_path http 路径；默认 /v1/traces
+	UrlPath string `protobuf:"bytes,3,opt,name=url_path,json=urlPath,proto3" json:"url_path,omitempty"`
+	// headers 请求头；例：鉴权令牌
+	Headers map[string]string `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// insecure 不使用 tls
+	Insecure bool `protobuf:"varint,5,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	// insecure_skip_verify 跳过证书验证
+	InsecureSkipVerify bool   `protobuf:"varint,6,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	TlsCaPem           string `protobuf:"bytes,7,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
+	TlsCertPem         string `protobuf:"bytes,8,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
+	TlsKeyPem          string `protobuf:"bytes,9,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
+	// compression 压缩：none(默认)、gzip
+	Compression string `protobuf:"bytes,10,opt,name=compression,proto3" json:"compression,omitempty"`
+	// timeout 导出超时时间；默认10s
+	Timeout *durationpb.Duration `protobuf:"bytes,11,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// sampler 采样：parentbased_traceidratio(默认)、traceidratio、always_on、always_off、parentbased_always_on、parentbased_always_off
+	Sampler string `protobuf:"bytes,12,opt,name=sampler,proto3" json:"sampler,omitempty"`
+	// sampler_ratio 采样率[0,1]；未配置时为 1；0 时不采样(根 span)
+	SamplerRatio *float64 `protobuf:"fixed64,13,opt,name=sampler_ratio,json=samplerRatio,proto3,oneof" json:"sampler_ratio,omitempty"`
+	// resource_attributes 附加的资源属性；service.name 等由 app 配置生成
+	ResourceAttributes map[string]string `protobuf:"bytes,14,rep,name=resource_attributes,json=resourceAttributes,proto3" json:"resource_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Infrastructure_Otlp) Reset() {
+	*x = Infrastructure_Otlp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Infrastructure_Otlp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infrastructure_Otlp) ProtoMessage() {}
+
+func (x *Infrastructure_Otlp) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infrastructure_Otlp.ProtoReflect.Descriptor instead.
+func (*Infrastructure_Otlp) Descriptor() ([]byte, []int) {
 	return file_api_config_config_proto_rawDescGZIP(), []int{3, 7}
 }
 
-func (x *Infrastructure_Snowflake) GetEnable() bool {
+func (x *Infrastructure_Otlp) GetEnable() bool {
 	if x != nil {
 		return x.Enable
 	}
 	return false
 }
 
-func (x *Infrastructure_Snowflake) GetEndpoint() string {
+func (x *Infrastructure_Otlp) GetEndpoint() string {
 	if x != nil {
 		return x.Endpoint
 	}
 	return ""
 }
 
-func (x *Infrastructure_Snowflake) GetWithDiscovery() bool {
+func (x *Infrastructure_Otlp) GetProtocol() string {
 	if x != nil {
-		return x.WithDiscovery
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Infrastructure_Otlp) GetUrlPath() string {
+	if x != nil {
+		return x.UrlPath
+	}
+	return ""
+}
+
+func (x *Infrastructure_Otlp) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Infrastructure_Otlp) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
 	}
 	return false
 }
 
-func (x *Infrastructure_Snowflake) GetWithHttpBasicAuth() bool {
+func (x *Infrastructure_Otlp) GetInsecureSkipVerify() bool {
 	if x != nil {
-		return x.WithHttpBasicAuth
+		return x.InsecureSkipVerify
 	}
 	return false
 }
 
-func (x *Infrastructure_Snowflake) GetUsername() string {
+func (x *Infrastructure_Otlp) GetTlsCaPem() string {
 	if x != nil {
-		return x.Username
+		return x.TlsCaPem
 	}
 	return ""
 }
 
-func (x *Infrastructure_Snowflake) GetPassword() string {
+func (x *Infrastructure_Otlp) GetTlsCertPem() string {
 	if x != nil {
-		return x.Password
+		return x.TlsCertPem
 	}
 	return ""
 }
 
-// Console 输出到控制台
-type Infrastructure_Log_Console struct {
+func (x *Infrastructure_Otlp) GetTlsKeyPem() string {
+	if x != nil {
+		return x.TlsKeyPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_Otlp) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *Infrastructure_Otlp) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *Infrastructure_Otlp) GetSampler() string {
+	if x != nil {
+		return x.Sampler
+	}
+	return ""
+}
+
+func (x *Infrastructure_Otlp) GetSamplerRatio() float64 {
+	if x != nil && x.SamplerRatio != nil {
+		return *x.SamplerRatio
+	}
+	return 0
+}
+
+func (x *Infrastructure_Otlp) GetResourceAttributes() map[string]string {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+// RabbitMQ rabbitmq
+type Infrastructure_Rabbitmq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// enable 是否启用
-	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
-	// level 日志级别；DEBUG、INFO、WARN、ERROR、FATAL
-	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// enable 是否启动
+	Enable     bool   `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
+	Url        string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	TlsAddress string `protobuf:"bytes,2,opt,name=tls_address,json=tlsAddress,proto3" json:"tls_address,omitempty"`
+	TlsCaPem   string `protobuf:"bytes,3,opt,name=tls_ca_pem,json=tlsCaPem,proto3" json:"tls_ca_pem,omitempty"`
+	TlsCertPem string `protobuf:"bytes,4,opt,name=tls_cert_pem,json=tlsCertPem,proto3" json:"tls_cert_pem,omitempty"`
+	TlsKeyPem  string `protobuf:"bytes,5,opt,name=tls_key_pem,json=tlsKeyPem,proto3" json:"tls_key_pem,omitempty"`
+	// channel_pool_size 空闲channel池大小；默认16
+	ChannelPoolSize uint32 `protobuf:"varint,6,opt,name=channel_pool_size,json=channelPoolSize,proto3" json:"channel_pool_size,omitempty"`
+	// enable_publisher_confirms 启用发布确认；发布后等待服务端确认
+	EnablePublisherConfirms bool `protobuf:"varint,7,opt,name=enable_publisher_confirms,json=enablePublisherConfirms,proto3" json:"enable_publisher_confirms,omitempty"`
+	// reconnect_initial_backoff 重连的初始间隔；默认500ms；每次失败后翻倍
+	ReconnectInitialBackoff *durationpb.Duration `protobuf:"bytes,8,opt,name=reconnect_initial_backoff,json=reconnectInitialBackoff,proto3" json:"reconnect_initial_backoff,omitempty"`
+	// reconnect_max_backoff 重连的最大间隔；默认30s
+	ReconnectMaxBackoff *durationpb.Duration `protobuf:"bytes,9,opt,name=reconnect_max_backoff,json=reconnectMaxBackoff,proto3" json:"reconnect_max_backoff,omitempty"`
+	// insecure_skip_verify 跳过证书验证
+	InsecureSkipVerify bool `protobuf:"varint,10,opt,name=insecure_skip_verify,json=insecureSkipVerify,proto3" json:"insecure_skip_verify,omitempty"`
+	// consumer_concurrency 每个队列同时处理的消息数量；默认1
+	ConsumerConcurrency uint32 `protobuf:"varint,11,opt,name=consumer_concurrency,json=consumerConcurrency,proto3" json:"consumer_concurrency,omitempty"`
+	// consumer_max_attempts 消息最多处理次数；默认3；超过后进入死信队列
+	ConsumerMaxAttempts uint32 `protobuf:"varint,12,opt,name=consumer_max_attempts,json=consumerMaxAttempts,proto3" json:"consumer_max_attempts,omitempty"`
+	// consumer_retry_initial_backoff 首次重试的延迟；默认1s；每次重试后翻倍
+	ConsumerRetryInitialBackoff *durationpb.Duration `protobuf:"bytes,13,opt,name=consumer_retry_initial_backoff,json=consumerRetryInitialBackoff,proto3" json:"consumer_retry_initial_backoff,omitempty"`
+	// consumer_retry_max_backoff 重试的最大延迟；默认1m
+	ConsumerRetryMaxBackoff *durationpb.Duration `protobuf:"bytes,14,opt,name=consumer_retry_max_backoff,json=consumerRetryMaxBackoff,proto3" json:"consumer_retry_max_backoff,omitempty"`
+	// consumer_drain_timeout 关闭时等待处理中消息的超时时间；默认30s
+	ConsumerDrainTimeout *durationpb.Duration `protobuf:"bytes,15,opt,name=consumer_drain_timeout,json=consumerDrainTimeout,proto3" json:"consumer_drain_timeout,omitempty"`
+}
+
+func (x *Infrastructure_Rabbitmq) Reset() {
+	*x = Infrastructure_Rabbitmq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Infrastructure_Rabbitmq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infrastructure_Rabbitmq) ProtoMessage() {}
+
+func (x *Infrastructure_Rabbitmq) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infrastructure_Rabbitmq.ProtoReflect.Descriptor instead.
+func (*Infrastructure_Rabbitmq) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{3, 8}
+}
+
+func (x *Infrastructure_Rabbitmq) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Infrastructure_Rabbitmq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Infrastructure_Rabbitmq) GetTlsAddress() string {
+	if x != nil {
+		return x.TlsAddress
+	}
+	return ""
+}
+
+func (x *Infrastructure_Rabbitmq) GetTlsCaPem() string {
+	if x != nil {
+		return x.TlsCaPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_Rabbitmq) GetTlsCertPem() string {
+	if x != nil {
+		return x.TlsCertPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_Rabbitmq) GetTlsKeyPem() string {
+	if x != nil {
+		return x.TlsKeyPem
+	}
+	return ""
+}
+
+func (x *Infrastructure_Rabbitmq) GetChannelPoolSize() uint32 {
+	if x != nil {
+		return x.ChannelPoolSize
+	}
+	return 0
+}
+
+func (x *Infrastructure_Rabbitmq) GetEnablePublisherConfirms() bool {
+	if x != nil {
+		return x.EnablePublisherConfirms
+	}
+	return false
+}
+
+func (x *Infrastructure_Rabbitmq) GetReconnectInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.ReconnectInitialBackoff
+	}
+	return nil
+}
+
+func (x *Infrastructure_Rabbitmq) GetReconnectMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.ReconnectMaxBackoff
+	}
+	return nil
+}
+
+func (x *Infrastructure_Rabbitmq) GetInsecureSkipVerify() bool {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return false
+}
+
+func (x *Infrastructure_Rabbitmq) GetConsumerConcurrency() uint32 {
+	if x != nil {
+		return x.ConsumerConcurrency
+	}
+	return 0
+}
+
+func (x *Infrastructure_Rabbitmq) GetConsumerMaxAttempts() uint32 {
+	if x != nil {
+		return x.ConsumerMaxAttempts
+	}
+	return 0
+}
+
+func (x *Infrastructure_Rabbitmq) GetConsumerRetryInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.ConsumerRetryInitialBackoff
+	}
+	return nil
+}
+
+func (x *Infrastructure_Rabbitmq) GetConsumerRetryMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.ConsumerRetryMaxBackoff
+	}
+	return nil
+}
+
+func (x *Infrastructure_Rabbitmq) GetConsumerDrainTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConsumerDrainTimeout
+	}
+	return nil
+}
+
+// Snowflake snowflake-service；未启用时使用 redis 分配雪花算法节点ID
+type Infrastructure_Snowflake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enable 是否启动
+	Enable            bool   `protobuf:"varint,100,opt,name=enable,proto3" json:"enable,omitempty"`
+	Endpoint          string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	WithDiscovery     bool   `protobuf:"varint,2,opt,name=with_discovery,json=withDiscovery,proto3" json:"with_discovery,omitempty"`
+	WithHttpBasicAuth bool   `protobuf:"varint,3,opt,name=with_http_basic_auth,json=withHttpBasicAuth,proto3" json:"with_http_basic_auth,omitempty"`
+	Username          string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	Password          string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// lease_ttl 节点ID租约时长；默认60s
+	LeaseTtl *durationpb.Duration `protobuf:"bytes,6,opt,name=lease_ttl,json=leaseTtl,proto3" json:"lease_ttl,omitempty"`
+	// renew_interval 续期间隔；默认5s；需小于 lease_ttl
+	RenewInterval *durationpb.Duration `protobuf:"bytes,7,opt,name=renew_interval,json=renewInterval,proto3" json:"renew_interval,omitempty"`
+	// startup_timeout 启动时获取节点ID的超时时间；默认30s；超时后启动失败
+	StartupTimeout *durationpb.Duration `protobuf:"bytes,8,opt,name=startup_timeout,json=startupTimeout,proto3" json:"startup_timeout,omitempty"`
+}
+
+func (x *Infrastructure_Snowflake) Reset() {
+	*x = Infrastructure_Snowflake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Infrastructure_Snowflake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infrastructure_Snowflake) ProtoMessage() {}
+
+func (x *Infrastructure_Snowflake) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infrastructure_Snowflake.ProtoReflect.Descriptor instead.
+func (*Infrastructure_Snowflake) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{3, 9}
+}
+
+func (x *Infrastructure_Snowflake) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Infrastructure_Snowflake) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *Infrastructure_Snowflake) GetWithDiscovery() bool {
+	if x != nil {
+		return x.WithDiscovery
+	}
+	return false
+}
+
+func (x *Infrastructure_Snowflake) GetWithHttpBasicAuth() bool {
+	if x != nil {
+		return x.WithHttpBasicAuth
+	}
+	return false
+}
+
+func (x *Infrastructure_Snowflake) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Infrastructure_Snowflake) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Infrastructure_Snowflake) GetLeaseTtl() *durationpb.Duration {
+	if x != nil {
+		return x.LeaseTtl
+	}
+	return nil
+}
+
+func (x *Infrastructure_Snowflake) GetRenewInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RenewInterval
+	}
+	return nil
+}
+
+func (x *Infrastructure_Snowflake) GetStartupTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.StartupTimeout
+	}
+	return nil
+}
+
+// Console 输出到控制台
+type Infrastructure_Log_Console struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enable 是否启用
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// level 日志级别；DEBUG、INFO、WARN、ERROR、FATAL；
+	// 监听配置变更，无需重启；管理端点 server.admin.path + /log-level 可临时调整
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// format 输出格式；text(默认)：便于阅读；json：每行一个 json 对象，
+	// 日志前缀与链路信息输出为独立的字段，字段名见 field_schema
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+}
+
+func (x *Infrastructure_Log_Console) Reset() {
+	*x = Infrastructure_Log_Console{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Infrastructure_Log_Console) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infrastructure_Log_Console) ProtoMessage() {}
+
+func (x *Infrastructure_Log_Console) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infrastructure_Log_Console.ProtoReflect.Descriptor instead.
+func (*Infrastructure_Log_Console) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{3, 0, 0}
+}
+
+func (x *Infrastructure_Log_Console) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Infrastructure_Log_Console) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Infrastructure_Log_Console) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// File 输出到文件
+type Infrastructure_Log_File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// enable 是否启用
+	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// level 日志级别；DEBUG、INFO、WARN、ERROR、FATAL；
+	// 监听配置变更，无需重启；管理端点 server.admin.path + /log-level 可临时调整
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	// dir 存储目录
+	Dir string `protobuf:"bytes,3,opt,name=dir,proto3" json:"dir,omitempty"`
+	// filename 文件名(默认：${filename}_app.%Y%m%d%H%M%S.log)
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// rotate_time 轮询规则：n久(默认：86400s # 86400s = 1天)
+	// 轮询规则：默认为：rotate_time(s)
+	RotateTime *durationpb.Duration `protobuf:"bytes,5,opt,name=rotate_time,json=rotateTime,proto3" json:"rotate_time,omitempty"`
+	// rotate_size 轮询规则：按文件大小(默认：52428800 # 50<<20 = 50M)
+	// 轮询规则：默认为：rotate_time
+	RotateSize int64 `protobuf:"varint,6,opt,name=rotate_size,json=rotateSize,proto3" json:"rotate_size,omitempty"`
+	// storage_age 存储n久(默认：2592000s = 30天)
+	// 存储规则：默认为：storage_age(s)
+	StorageAge *durationpb.Duration `protobuf:"bytes,8,opt,name=storage_age,json=storageAge,proto3" json:"storage_age,omitempty"`
+	// storage_counter 存储：n个 或 有效期storage_age(默认：2592000s = 30天)
+	// 存储规则：默认为：storage_age
+	StorageCounter uint32 `protobuf:"varint,7,opt,name=storage_counter,json=storageCounter,proto3" json:"storage_counter,omitempty"`
+}
+
+func (x *Infrastructure_Log_File) Reset() {
+	*x = Infrastructure_Log_File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Infrastructure_Log_File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Infrastructure_Log_File) ProtoMessage() {}
+
+func (x *Infrastructure_Log_File) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Infrastructure_Log_File.ProtoReflect.Descriptor instead.
+func (*Infrastructure_Log_File) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{3, 0, 1}
+}
+
+func (x *Infrastructure_Log_File) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Infrastructure_Log_File) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *Infrastructure_Log_File) GetDir() string {
+	if x != nil {
+		return x.Dir
+	}
+	return ""
+}
+
+func (x *Infrastructure_Log_File) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *Infrastructure_Log_File) GetRotateTime() *durationpb.Duration {
+	if x != nil {
+		return x.RotateTime
+	}
+	return nil
+}
+
+func (x *Infrastructure_Log_File) GetRotateSize() int64 {
+	if x != nil {
+		return x.RotateSize
+	}
+	return 0
+}
+
+func (x *Infrastructure_Log_File) GetStorageAge() *durationpb.Duration {
+	if x != nil {
+		return x.StorageAge
+	}
+	return nil
+}
+
+func (x *Infrastructure_Log_File) GetStorageCounter() uint32 {
+	if x != nil {
+		return x.StorageCounter
+	}
+	return 0
+}
+
+// Captcha 验证码
+type Setting_Captcha struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaptchaLen uint32               `protobuf:"varint,1,opt,name=captcha_len,json=captchaLen,proto3" json:"captcha_len,omitempty"` // 验证码长度
+	CaptchaTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=captcha_ttl,json=captchaTtl,proto3" json:"captcha_ttl,omitempty"`  // 验证码有效时间(s)
+	// max_verify_attempts 验证码的最大验证次数，超过后失效；默认 5
+	MaxVerifyAttempts uint32 `protobuf:"varint,3,opt,name=max_verify_attempts,json=maxVerifyAttempts,proto3" json:"max_verify_attempts,omitempty"`
+	// resend_interval 同一个接收者的重新发送间隔；默认 60s
+	ResendInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=resend_interval,json=resendInterval,proto3" json:"resend_interval,omitempty"`
+	// image_width 图片验证码的宽度；默认 120
+	ImageWidth uint32 `protobuf:"varint,5,opt,name=image_width,json=imageWidth,proto3" json:"image_width,omitempty"`
+	// image_height 图片验证码的高度；默认 40
+	ImageHeight uint32 `protobuf:"varint,6,opt,name=image_height,json=imageHeight,proto3" json:"image_height,omitempty"`
+	// image_send_limit 同一个ip与用途在 resend_interval 内图片验证码的发送次数；默认 10
+	ImageSendLimit uint32 `protobuf:"varint,7,opt,name=image_send_limit,json=imageSendLimit,proto3" json:"image_send_limit,omitempty"`
+	// token_secret 验证凭证的签名密钥(HMAC-SHA256)；VerifyCaptcha 返回验证凭证，未配置时不可用
+	TokenSecret string `protobuf:"bytes,8,opt,name=token_secret,json=tokenSecret,proto3" json:"token_secret,omitempty"`
+	// token_ttl 验证凭证的有效时间；默认 5m
+	TokenTtl *durationpb.Duration `protobuf:"bytes,9,opt,name=token_ttl,json=tokenTtl,proto3" json:"token_ttl,omitempty"`
+}
+
+func (x *Setting_Captcha) Reset() {
+	*x = Setting_Captcha{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Setting_Captcha) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setting_Captcha) ProtoMessage() {}
+
+func (x *Setting_Captcha) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setting_Captcha.ProtoReflect.Descriptor instead.
+func (*Setting_Captcha) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Setting_Captcha) GetCaptchaLen() uint32 {
+	if x != nil {
+		return x.CaptchaLen
+	}
+	return 0
+}
+
+func (x *Setting_Captcha) GetCaptchaTtl() *durationpb.Duration {
+	if x != nil {
+		return x.CaptchaTtl
+	}
+	return nil
+}
+
+func (x *Setting_Captcha) GetMaxVerifyAttempts() uint32 {
+	if x != nil {
+		return x.MaxVerifyAttempts
+	}
+	return 0
+}
+
+func (x *Setting_Captcha) GetResendInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ResendInterval
+	}
+	return nil
+}
+
+func (x *Setting_Captcha) GetImageWidth() uint32 {
+	if x != nil {
+		return x.ImageWidth
+	}
+	return 0
+}
+
+func (x *Setting_Captcha) GetImageHeight() uint32 {
+	if x != nil {
+		return x.ImageHeight
+	}
+	return 0
+}
+
+func (x *Setting_Captcha) GetImageSendLimit() uint32 {
+	if x != nil {
+		return x.ImageSendLimit
+	}
+	return 0
+}
+
+func (x *Setting_Captcha) GetTokenSecret() string {
+	if x != nil {
+		return x.TokenSecret
+	}
+	return ""
+}
+
+func (x *Setting_Captcha) GetTokenTtl() *durationpb.Duration {
+	if x != nil {
+		return x.TokenTtl
+	}
+	return nil
+}
+
+// Login 登录
+type Setting_Login struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 密码连续输错N次后短暂锁定账号
+	PasswordErrSerialTimes uint32 `protobuf:"varint,1,opt,name=password_err_serial_times,json=passwordErrSerialTimes,proto3" json:"password_err_serial_times,omitempty"`
+	// N分钟内连续输出密码(s)
+	PasswordErrSerialDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=password_err_serial_duration,json=passwordErrSerialDuration,proto3" json:"password_err_serial_duration,omitempty"`
+	// 密码连续错误后,锁定N分钟后重试(s)
+	PasswordErrLockDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=password_err_lock_duration,json=passwordErrLockDuration,proto3" json:"password_err_lock_duration,omitempty"`
+	// 当日密码错误上限
+	PasswordErrDailyLimitTimes uint32 `protobuf:"varint,4,opt,name=password_err_daily_limit_times,json=passwordErrDailyLimitTimes,proto3" json:"password_err_daily_limit_times,omitempty"`
+	// timezone 当日错误上限的时区，每日零点重置；例：Asia/Shanghai；默认本地时区
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *Setting_Login) Reset() {
+	*x = Setting_Login{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_config_config_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Setting_Login) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Setting_Login) ProtoMessage() {}
+
+func (x *Setting_Login) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Setting_Login.ProtoReflect.Descriptor instead.
+func (*Setting_Login) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 1}
+}
+
+func (x *Setting_Login) GetPasswordErrSerialTimes() uint32 {
+	if x != nil {
+		return x.PasswordErrSerialTimes
+	}
+	return 0
+}
+
+func (x *Setting_Login) GetPasswordErrSerialDuration() *durationpb.Duration {
+	if x != nil {
+		return x.PasswordErrSerialDuration
+	}
+	return nil
+}
+
+func (x *Setting_Login) GetPasswordErrLockDuration() *durationpb.Duration {
+	if x != nil {
+		return x.PasswordErrLockDuration
+	}
+	return nil
+}
+
+func (x *Setting_Login) GetPasswordErrDailyLimitTimes() uint32 {
+	if x != nil {
+		return x.PasswordErrDailyLimitTimes
+	}
+	return 0
+}
+
+func (x *Setting_Login) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Lock 分布式锁
+type Setting_Lock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ttl 默认的租约时间；默认 30s
+	Ttl *durationpb.Duration `protobuf:"bytes,1,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	// redlock_redis_instances Redlock 的 redis 命名实例(infrastructure.redis_instances)；
+	// 配置多个时使用 Redlock，各实例需相互独立；默认使用 infrastructure.redis
+	RedlockRedisInstances []string `protobuf:"bytes,2,rep,name=redlock_redis_instances,json=redlockRedisInstances,proto3" json:"redlock_redis_instances,omitempty"`
+	// fence_ttl fencing token 的键的过期时间，每次获取与续期时重置；默认 7 天，至少为租约时间的 10 倍
+	FenceTtl *durationpb.Duration `protobuf:"bytes,3,opt,name=fence_ttl,json=fenceTtl,proto3" json:"fence_ttl,omitempty"`
 }
 
-func (x *Infrastructure_Log_Console) Reset() {
-	*x = Infrastructure_Log_Console{}
+func (x *Setting_Lock) Reset() {
+	*x = Setting_Lock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[17]
+		mi := &file_api_config_config_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Infrastructure_Log_Console) String() string {
+func (x *Setting_Lock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Infrastructure_Log_Console) ProtoMessage() {}
+func (*Setting_Lock) ProtoMessage() {}
 
-func (x *Infrastructure_Log_Console) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[17]
+func (x *Setting_Lock) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1617,70 +3011,68 @@ func (x *Infrastructure_Log_Console) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Infrastructure_Log_Console.ProtoReflect.Descriptor instead.
-func (*Infrastructure_Log_Console) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{3, 0, 0}
+// Deprecated: Use Setting_Lock.ProtoReflect.Descriptor instead.
+func (*Setting_Lock) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 2}
 }
 
-func (x *Infrastructure_Log_Console) GetEnable() bool {
+func (x *Setting_Lock) GetTtl() *durationpb.Duration {
 	if x != nil {
-		return x.Enable
+		return x.Ttl
 	}
-	return false
+	return nil
 }
 
-func (x *Infrastructure_Log_Console) GetLevel() string {
+func (x *Setting_Lock) GetRedlockRedisInstances() []string {
 	if x != nil {
-		return x.Level
+		return x.RedlockRedisInstances
 	}
-	return ""
+	return nil
 }
 
-// File 输出到文件
-type Infrastructure_Log_File struct {
+func (x *Setting_Lock) GetFenceTtl() *durationpb.Duration {
+	if x != nil {
+		return x.FenceTtl
+	}
+	return nil
+}
+
+// RateLimit 限流
+type Setting_RateLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// enable 是否启用
 	Enable bool `protobuf:"varint,1,opt,name=enable,proto3" json:"enable,omitempty"`
-	// level 日志级别；DEBUG、INFO、WARN、ERROR、FATAL
-	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
-	// dir 存储目录
-	Dir string `protobuf:"bytes,3,opt,name=dir,proto3" json:"dir,omitempty"`
-	// filename 文件名(默认：${filename}_app.%Y%m%d%H%M%S.log)
-	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
-	// rotate_time 轮询规则：n久(默认：86400s # 86400s = 1天)
-	// 轮询规则：默认为：rotate_time(s)
-	RotateTime *durationpb.Duration `protobuf:"bytes,5,opt,name=rotate_time,json=rotateTime,proto3" json:"rotate_time,omitempty"`
-	// rotate_size 轮询规则：按文件大小(默认：52428800 # 50<<20 = 50M)
-	// 轮询规则：默认为：rotate_time
-	RotateSize int64 `protobuf:"varint,6,opt,name=rotate_size,json=rotateSize,proto3" json:"rotate_size,omitempty"`
-	// storage_age 存储n久(默认：2592000s = 30天)
-	// 存储规则：默认为：storage_age(s)
-	StorageAge *durationpb.Duration `protobuf:"bytes,8,opt,name=storage_age,json=storageAge,proto3" json:"storage_age,omitempty"`
-	// storage_counter 存储：n个 或 有效期storage_age(默认：2592000s = 30天)
-	// 存储规则：默认为：storage_age
-	StorageCounter uint32 `protobuf:"varint,7,opt,name=storage_counter,json=storageCounter,proto3" json:"storage_counter,omitempty"`
-}
-
-func (x *Infrastructure_Log_File) Reset() {
-	*x = Infrastructure_Log_File{}
+	// default_rule 未配置 operations 的操作的规则；未配置时不限流
+	DefaultRule *Setting_RateLimit_Rule `protobuf:"bytes,2,opt,name=default_rule,json=defaultRule,proto3" json:"default_rule,omitempty"`
+	// operations 操作的规则；key 为 operation；例：/saas.api.ping.servicev1.SrvPingV1/Ping
+	Operations map[string]*Setting_RateLimit_Rule `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// trusted_proxies 信任的代理：CIDR 或 ip；对端地址为信任的代理时使用 X-Forwarded-For；未配置时使用对端地址
+	TrustedProxies []string `protobuf:"bytes,5,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"`
+	// redis_breaker_backoff redis 失败后跳过 redis(使用进程内限流)的时间，连续失败时加倍；默认 1s；修改后重启生效
+	RedisBreakerBackoff *durationpb.Duration `protobuf:"bytes,6,opt,name=redis_breaker_backoff,json=redisBreakerBackoff,proto3" json:"redis_breaker_backoff,omitempty"`
+	// redis_breaker_max_backoff 跳过 redis 的最长时间；默认 30s；修改后重启生效
+	RedisBreakerMaxBackoff *durationpb.Duration `protobuf:"bytes,7,opt,name=redis_breaker_max_backoff,json=redisBreakerMaxBackoff,proto3" json:"redis_breaker_max_backoff,omitempty"`
+}
+
+func (x *Setting_RateLimit) Reset() {
+	*x = Setting_RateLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[18]
+		mi := &file_api_config_config_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Infrastructure_Log_File) String() string {
+func (x *Setting_RateLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Infrastructure_Log_File) ProtoMessage() {}
+func (*Setting_RateLimit) ProtoMessage() {}
 
-func (x *Infrastructure_Log_File) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[18]
+func (x *Setting_RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1691,94 +3083,81 @@ func (x *Infrastructure_Log_File) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Infrastructure_Log_File.ProtoReflect.Descriptor instead.
-func (*Infrastructure_Log_File) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{3, 0, 1}
+// Deprecated: Use Setting_RateLimit.ProtoReflect.Descriptor instead.
+func (*Setting_RateLimit) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 3}
 }
 
-func (x *Infrastructure_Log_File) GetEnable() bool {
+func (x *Setting_RateLimit) GetEnable() bool {
 	if x != nil {
 		return x.Enable
 	}
 	return false
 }
 
-func (x *Infrastructure_Log_File) GetLevel() string {
-	if x != nil {
-		return x.Level
-	}
-	return ""
-}
-
-func (x *Infrastructure_Log_File) GetDir() string {
-	if x != nil {
-		return x.Dir
-	}
-	return ""
-}
-
-func (x *Infrastructure_Log_File) GetFilename() string {
+func (x *Setting_RateLimit) GetDefaultRule() *Setting_RateLimit_Rule {
 	if x != nil {
-		return x.Filename
+		return x.DefaultRule
 	}
-	return ""
+	return nil
 }
 
-func (x *Infrastructure_Log_File) GetRotateTime() *durationpb.Duration {
+func (x *Setting_RateLimit) GetOperations() map[string]*Setting_RateLimit_Rule {
 	if x != nil {
-		return x.RotateTime
+		return x.Operations
 	}
 	return nil
 }
 
-func (x *Infrastructure_Log_File) GetRotateSize() int64 {
+func (x *Setting_RateLimit) GetTrustedProxies() []string {
 	if x != nil {
-		return x.RotateSize
+		return x.TrustedProxies
 	}
-	return 0
+	return nil
 }
 
-func (x *Infrastructure_Log_File) GetStorageAge() *durationpb.Duration {
+func (x *Setting_RateLimit) GetRedisBreakerBackoff() *durationpb.Duration {
 	if x != nil {
-		return x.StorageAge
+		return x.RedisBreakerBackoff
 	}
 	return nil
 }
 
-func (x *Infrastructure_Log_File) GetStorageCounter() uint32 {
+func (x *Setting_RateLimit) GetRedisBreakerMaxBackoff() *durationpb.Duration {
 	if x != nil {
-		return x.StorageCounter
+		return x.RedisBreakerMaxBackoff
 	}
-	return 0
+	return nil
 }
 
-// Captcha 验证码
-type Setting_Captcha struct {
+// EncryptSecret ...
+type Setting_EncryptSecret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CaptchaLen uint32               `protobuf:"varint,1,opt,name=captcha_len,json=captchaLen,proto3" json:"captcha_len,omitempty"` // 验证码长度
-	CaptchaTtl *durationpb.Duration `protobuf:"bytes,2,opt,name=captcha_ttl,json=captchaTtl,proto3" json:"captcha_ttl,omitempty"`  // 验证码有效时间(s)
+	TransferEncrypt *Setting_EncryptSecret_TransferEncrypt `protobuf:"bytes,1,opt,name=transfer_encrypt,json=transferEncrypt,proto3" json:"transfer_encrypt,omitempty"`
+	ServiceEncrypt  *Setting_EncryptSecret_ServiceEncrypt  `protobuf:"bytes,2,opt,name=service_encrypt,json=serviceEncrypt,proto3" json:"service_encrypt,omitempty"`
+	TokenEncrypt    *Setting_EncryptSecret_TokenEncrypt    `protobuf:"bytes,3,opt,name=token_encrypt,json=tokenEncrypt,proto3" json:"token_encrypt,omitempty"`
 }
 
-func (x *Setting_Captcha) Reset() {
-	*x = Setting_Captcha{}
+func (x *Setting_EncryptSecret) Reset() {
+	*x = Setting_EncryptSecret{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[19]
+		mi := &file_api_config_config_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Setting_Captcha) String() string {
+func (x *Setting_EncryptSecret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting_Captcha) ProtoMessage() {}
+func (*Setting_EncryptSecret) ProtoMessage() {}
 
-func (x *Setting_Captcha) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[19]
+func (x *Setting_EncryptSecret) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1789,58 +3168,67 @@ func (x *Setting_Captcha) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting_Captcha.ProtoReflect.Descriptor instead.
-func (*Setting_Captcha) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use Setting_EncryptSecret.ProtoReflect.Descriptor instead.
+func (*Setting_EncryptSecret) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 4}
 }
 
-func (x *Setting_Captcha) GetCaptchaLen() uint32 {
+func (x *Setting_EncryptSecret) GetTransferEncrypt() *Setting_EncryptSecret_TransferEncrypt {
 	if x != nil {
-		return x.CaptchaLen
+		return x.TransferEncrypt
 	}
-	return 0
+	return nil
 }
 
-func (x *Setting_Captcha) GetCaptchaTtl() *durationpb.Duration {
+func (x *Setting_EncryptSecret) GetServiceEncrypt() *Setting_EncryptSecret_ServiceEncrypt {
 	if x != nil {
-		return x.CaptchaTtl
+		return x.ServiceEncrypt
 	}
 	return nil
 }
 
-// Login 登录
-type Setting_Login struct {
+func (x *Setting_EncryptSecret) GetTokenEncrypt() *Setting_EncryptSecret_TokenEncrypt {
+	if x != nil {
+		return x.TokenEncrypt
+	}
+	return nil
+}
+
+// Rule 限流规则
+type Setting_RateLimit_Rule struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// 密码连续输错N次后短暂锁定账号
-	PasswordErrSerialTimes uint32 `protobuf:"varint,1,opt,name=password_err_serial_times,json=passwordErrSerialTimes,proto3" json:"password_err_serial_times,omitempty"`
-	// N分钟内连续输出密码(s)
-	PasswordErrSerialDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=password_err_serial_duration,json=passwordErrSerialDuration,proto3" json:"password_err_serial_duration,omitempty"`
-	// 密码连续错误后,锁定N分钟后重试(s)
-	PasswordErrLockDuration *durationpb.Duration `protobuf:"bytes,3,opt,name=password_err_lock_duration,json=passwordErrLockDuration,proto3" json:"password_err_lock_duration,omitempty"`
-	// 当日密码错误上限
-	PasswordErrDailyLimitTimes uint32 `protobuf:"varint,4,opt,name=password_err_daily_limit_times,json=passwordErrDailyLimitTimes,proto3" json:"password_err_daily_limit_times,omitempty"`
+	// algorithm 算法：fixed_window、sliding_window、token_bucket；默认 fixed_window
+	Algorithm string `protobuf:"bytes,1,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// limit 窗口内允许的请求数；令牌桶为每个窗口补充的令牌数；0 时不限流
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// window 窗口；默认 1s
+	Window *durationpb.Duration `protobuf:"bytes,3,opt,name=window,proto3" json:"window,omitempty"`
+	// burst 令牌桶的容量；默认 limit
+	Burst uint32 `protobuf:"varint,4,opt,name=burst,proto3" json:"burst,omitempty"`
+	// key_by 限流维度：ip、user(认证的用户)、tenant(认证的租户；需 middlewareutil.WithRateLimitTenant)；默认 ip；缺失时使用 ip
+	KeyBy string `protobuf:"bytes,5,opt,name=key_by,json=keyBy,proto3" json:"key_by,omitempty"`
 }
 
-func (x *Setting_Login) Reset() {
-	*x = Setting_Login{}
+func (x *Setting_RateLimit_Rule) Reset() {
+	*x = Setting_RateLimit_Rule{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[20]
+		mi := &file_api_config_config_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Setting_Login) String() string {
+func (x *Setting_RateLimit_Rule) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting_Login) ProtoMessage() {}
+func (*Setting_RateLimit_Rule) ProtoMessage() {}
 
-func (x *Setting_Login) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[20]
+func (x *Setting_RateLimit_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1851,67 +3239,76 @@ func (x *Setting_Login) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting_Login.ProtoReflect.Descriptor instead.
-func (*Setting_Login) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{4, 1}
+// Deprecated: Use Setting_RateLimit_Rule.ProtoReflect.Descriptor instead.
+func (*Setting_RateLimit_Rule) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 3, 0}
 }
 
-func (x *Setting_Login) GetPasswordErrSerialTimes() uint32 {
+func (x *Setting_RateLimit_Rule) GetAlgorithm() string {
 	if x != nil {
-		return x.PasswordErrSerialTimes
+		return x.Algorithm
 	}
-	return 0
+	return ""
 }
 
-func (x *Setting_Login) GetPasswordErrSerialDuration() *durationpb.Duration {
+func (x *Setting_RateLimit_Rule) GetLimit() uint32 {
 	if x != nil {
-		return x.PasswordErrSerialDuration
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *Setting_Login) GetPasswordErrLockDuration() *durationpb.Duration {
+func (x *Setting_RateLimit_Rule) GetWindow() *durationpb.Duration {
 	if x != nil {
-		return x.PasswordErrLockDuration
+		return x.Window
 	}
 	return nil
 }
 
-func (x *Setting_Login) GetPasswordErrDailyLimitTimes() uint32 {
+func (x *Setting_RateLimit_Rule) GetBurst() uint32 {
 	if x != nil {
-		return x.PasswordErrDailyLimitTimes
+		return x.Burst
 	}
 	return 0
 }
 
-// EncryptSecret ...
-type Setting_EncryptSecret struct {
+func (x *Setting_RateLimit_Rule) GetKeyBy() string {
+	if x != nil {
+		return x.KeyBy
+	}
+	return ""
+}
+
+// TransferEncrypt 非对称加密传输,主要用于密码传递等,防止传递过程中明文信息被log,导致泄露；
+// setuputil.Engine.GetTransferCipher；middlewareutil.NewTransferDecryptMiddleware
+type Setting_EncryptSecret_TransferEncrypt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransferEncrypt *Setting_EncryptSecret_TransferEncrypt `protobuf:"bytes,1,opt,name=transfer_encrypt,json=transferEncrypt,proto3" json:"transfer_encrypt,omitempty"`
-	ServiceEncrypt  *Setting_EncryptSecret_ServiceEncrypt  `protobuf:"bytes,2,opt,name=service_encrypt,json=serviceEncrypt,proto3" json:"service_encrypt,omitempty"`
-	TokenEncrypt    *Setting_EncryptSecret_TokenEncrypt    `protobuf:"bytes,3,opt,name=token_encrypt,json=tokenEncrypt,proto3" json:"token_encrypt,omitempty"`
+	// public_key RSA 公钥(PEM)：PKIX 或 PKCS#1；至少 2048 位
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// private_key RSA 私钥(PEM)：PKCS#8 或 PKCS#1；需与 public_key 匹配
+	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
 }
 
-func (x *Setting_EncryptSecret) Reset() {
-	*x = Setting_EncryptSecret{}
+func (x *Setting_EncryptSecret_TransferEncrypt) Reset() {
+	*x = Setting_EncryptSecret_TransferEncrypt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[21]
+		mi := &file_api_config_config_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Setting_EncryptSecret) String() string {
+func (x *Setting_EncryptSecret_TransferEncrypt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting_EncryptSecret) ProtoMessage() {}
+func (*Setting_EncryptSecret_TransferEncrypt) ProtoMessage() {}
 
-func (x *Setting_EncryptSecret) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[21]
+func (x *Setting_EncryptSecret_TransferEncrypt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1922,59 +3319,61 @@ func (x *Setting_EncryptSecret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting_EncryptSecret.ProtoReflect.Descriptor instead.
-func (*Setting_EncryptSecret) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{4, 2}
-}
-
-func (x *Setting_EncryptSecret) GetTransferEncrypt() *Setting_EncryptSecret_TransferEncrypt {
-	if x != nil {
-		return x.TransferEncrypt
-	}
-	return nil
+// Deprecated: Use Setting_EncryptSecret_TransferEncrypt.ProtoReflect.Descriptor instead.
+func (*Setting_EncryptSecret_TransferEncrypt) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 4, 0}
 }
 
-func (x *Setting_EncryptSecret) GetServiceEncrypt() *Setting_EncryptSecret_ServiceEncrypt {
+func (x *Setting_EncryptSecret_TransferEncrypt) GetPublicKey() string {
 	if x != nil {
-		return x.ServiceEncrypt
+		return x.PublicKey
 	}
-	return nil
+	return ""
 }
 
-func (x *Setting_EncryptSecret) GetTokenEncrypt() *Setting_EncryptSecret_TokenEncrypt {
+func (x *Setting_EncryptSecret_TransferEncrypt) GetPrivateKey() string {
 	if x != nil {
-		return x.TokenEncrypt
+		return x.PrivateKey
 	}
-	return nil
+	return ""
 }
 
-// TransferEncrypt 非对称加密传输,主要用于密码传递等,防止传递过程中明文信息被log,导致泄露
-type Setting_EncryptSecret_TransferEncrypt struct {
+// ServiceEncrypt 非对称加密传输,主要用于服务请求鉴权,服务间的鉴权；
+// clientutil 的链接签名请求；middlewareutil.NewServiceVerifyMiddleware 验证签名
+type Setting_EncryptSecret_ServiceEncrypt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKey  string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// public_key 本服务的公钥(PEM)：RSA、ECDSA、Ed25519；信任本服务其他副本的请求
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// private_key 本服务的私钥(PEM)：PKCS#8、PKCS#1、SEC 1；签名请求
 	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// enable 启用服务间请求签名：clientutil 的链接签名请求
+	Enable bool `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	// trusted_public_keys 信任的服务公钥(PEM)；key 为调用方的服务名称 app.server_name
+	TrustedPublicKeys map[string]string `protobuf:"bytes,4,rep,name=trusted_public_keys,json=trustedPublicKeys,proto3" json:"trusted_public_keys,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// replay_window 时间戳的有效时间，有效时间内拒绝重复的请求；默认 5m
+	ReplayWindow *durationpb.Duration `protobuf:"bytes,5,opt,name=replay_window,json=replayWindow,proto3" json:"replay_window,omitempty"`
 }
 
-func (x *Setting_EncryptSecret_TransferEncrypt) Reset() {
-	*x = Setting_EncryptSecret_TransferEncrypt{}
+func (x *Setting_EncryptSecret_ServiceEncrypt) Reset() {
+	*x = Setting_EncryptSecret_ServiceEncrypt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[22]
+		mi := &file_api_config_config_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Setting_EncryptSecret_TransferEncrypt) String() string {
+func (x *Setting_EncryptSecret_ServiceEncrypt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting_EncryptSecret_TransferEncrypt) ProtoMessage() {}
+func (*Setting_EncryptSecret_ServiceEncrypt) ProtoMessage() {}
 
-func (x *Setting_EncryptSecret_TransferEncrypt) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[22]
+func (x *Setting_EncryptSecret_ServiceEncrypt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1985,52 +3384,77 @@ func (x *Setting_EncryptSecret_TransferEncrypt) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting_EncryptSecret_TransferEncrypt.ProtoReflect.Descriptor instead.
-func (*Setting_EncryptSecret_TransferEncrypt) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{4, 2, 0}
+// Deprecated: Use Setting_EncryptSecret_ServiceEncrypt.ProtoReflect.Descriptor instead.
+func (*Setting_EncryptSecret_ServiceEncrypt) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 4, 1}
 }
 
-func (x *Setting_EncryptSecret_TransferEncrypt) GetPublicKey() string {
+func (x *Setting_EncryptSecret_ServiceEncrypt) GetPublicKey() string {
 	if x != nil {
 		return x.PublicKey
 	}
 	return ""
 }
 
-func (x *Setting_EncryptSecret_TransferEncrypt) GetPrivateKey() string {
+func (x *Setting_EncryptSecret_ServiceEncrypt) GetPrivateKey() string {
 	if x != nil {
 		return x.PrivateKey
 	}
 	return ""
 }
 
-// ServiceEncrypt 非对称加密传输,主要用于服务请求鉴权,服务间的鉴权
-type Setting_EncryptSecret_ServiceEncrypt struct {
+func (x *Setting_EncryptSecret_ServiceEncrypt) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *Setting_EncryptSecret_ServiceEncrypt) GetTrustedPublicKeys() map[string]string {
+	if x != nil {
+		return x.TrustedPublicKeys
+	}
+	return nil
+}
+
+func (x *Setting_EncryptSecret_ServiceEncrypt) GetReplayWindow() *durationpb.Duration {
+	if x != nil {
+		return x.ReplayWindow
+	}
+	return nil
+}
+
+// TokenEncrypt token
+type Setting_EncryptSecret_TokenEncrypt struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKey  string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	PrivateKey string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// sign_key 旧版本的签名密钥；无 kid；用于验证不携带 kid 的 token，keys 中无生效的密钥时用于签发
+	SignKey string `protobuf:"bytes,1,opt,name=sign_key,json=signKey,proto3" json:"sign_key,omitempty"`
+	// refresh_key 旧版本的加密密钥；与 sign_key 对应
+	RefreshKey string `protobuf:"bytes,2,opt,name=refresh_key,json=refreshKey,proto3" json:"refresh_key,omitempty"`
+	// keys 密钥集合；使用生效时间最晚的密钥签发，验证时接受所有未退役的密钥；监听配置变更，无需重启
+	Keys []*Setting_EncryptSecret_TokenEncrypt_Key `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
 }
 
-func (x *Setting_EncryptSecret_ServiceEncrypt) Reset() {
-	*x = Setting_EncryptSecret_ServiceEncrypt{}
+func (x *Setting_EncryptSecret_TokenEncrypt) Reset() {
+	*x = Setting_EncryptSecret_TokenEncrypt{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[23]
+		mi := &file_api_config_config_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Setting_EncryptSecret_ServiceEncrypt) String() string {
+func (x *Setting_EncryptSecret_TokenEncrypt) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting_EncryptSecret_ServiceEncrypt) ProtoMessage() {}
+func (*Setting_EncryptSecret_TokenEncrypt) ProtoMessage() {}
 
-func (x *Setting_EncryptSecret_ServiceEncrypt) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[23]
+func (x *Setting_EncryptSecret_TokenEncrypt) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2041,52 +3465,67 @@ func (x *Setting_EncryptSecret_ServiceEncrypt) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting_EncryptSecret_ServiceEncrypt.ProtoReflect.Descriptor instead.
-func (*Setting_EncryptSecret_ServiceEncrypt) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{4, 2, 1}
+// Deprecated: Use Setting_EncryptSecret_TokenEncrypt.ProtoReflect.Descriptor instead.
+func (*Setting_EncryptSecret_TokenEncrypt) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 4, 2}
 }
 
-func (x *Setting_EncryptSecret_ServiceEncrypt) GetPublicKey() string {
+func (x *Setting_EncryptSecret_TokenEncrypt) GetSignKey() string {
 	if x != nil {
-		return x.PublicKey
+		return x.SignKey
 	}
 	return ""
 }
 
-func (x *Setting_EncryptSecret_ServiceEncrypt) GetPrivateKey() string {
+func (x *Setting_EncryptSecret_TokenEncrypt) GetRefreshKey() string {
 	if x != nil {
-		return x.PrivateKey
+		return x.RefreshKey
 	}
 	return ""
 }
 
-// TokenEncrypt token
-type Setting_EncryptSecret_TokenEncrypt struct {
+func (x *Setting_EncryptSecret_TokenEncrypt) GetKeys() []*Setting_EncryptSecret_TokenEncrypt_Key {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// Key 签名密钥；轮换密钥时先添加新密钥，待旧 token 过期后再设置旧密钥的 retire_at
+type Setting_EncryptSecret_TokenEncrypt_Key struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SignKey    string `protobuf:"bytes,1,opt,name=sign_key,json=signKey,proto3" json:"sign_key,omitempty"`
-	RefreshKey string `protobuf:"bytes,2,opt,name=refresh_key,json=refreshKey,proto3" json:"refresh_key,omitempty"`
+	// kid 密钥ID；签发的 token 头部携带 kid；不能包含 "."
+	Kid string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// sign_key 访问令牌的签名密钥(HS256)
+	SignKey string `protobuf:"bytes,2,opt,name=sign_key,json=signKey,proto3" json:"sign_key,omitempty"`
+	// refresh_key 刷新令牌的加密密钥(AES-CBC)；长度：16、24、32
+	RefreshKey string `protobuf:"bytes,3,opt,name=refresh_key,json=refreshKey,proto3" json:"refresh_key,omitempty"`
+	// not_before 生效时间；生效后用于签发新 token；未配置时立即生效
+	NotBefore *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	// retire_at 退役时间；退役后不再签发与验证；未配置时不退役
+	RetireAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=retire_at,json=retireAt,proto3" json:"retire_at,omitempty"`
 }
 
-func (x *Setting_EncryptSecret_TokenEncrypt) Reset() {
-	*x = Setting_EncryptSecret_TokenEncrypt{}
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) Reset() {
+	*x = Setting_EncryptSecret_TokenEncrypt_Key{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[24]
+		mi := &file_api_config_config_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Setting_EncryptSecret_TokenEncrypt) String() string {
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Setting_EncryptSecret_TokenEncrypt) ProtoMessage() {}
+func (*Setting_EncryptSecret_TokenEncrypt_Key) ProtoMessage() {}
 
-func (x *Setting_EncryptSecret_TokenEncrypt) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[24]
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) ProtoReflect() protoreflect.Message {
+	mi := &file_api_config_config_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2097,25 +3536,46 @@ func (x *Setting_EncryptSecret_TokenEncrypt) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Setting_EncryptSecret_TokenEncrypt.ProtoReflect.Descriptor instead.
-func (*Setting_EncryptSecret_TokenEncrypt) Descriptor() ([]byte, []int) {
-	return file_api_config_config_proto_rawDescGZIP(), []int{4, 2, 2}
+// Deprecated: Use Setting_EncryptSecret_TokenEncrypt_Key.ProtoReflect.Descriptor instead.
+func (*Setting_EncryptSecret_TokenEncrypt_Key) Descriptor() ([]byte, []int) {
+	return file_api_config_config_proto_rawDescGZIP(), []int{4, 4, 2, 0}
 }
 
-func (x *Setting_EncryptSecret_TokenEncrypt) GetSignKey() string {
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) GetSignKey() string {
 	if x != nil {
 		return x.SignKey
 	}
 	return ""
 }
 
-func (x *Setting_EncryptSecret_TokenEncrypt) GetRefreshKey() string {
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) GetRefreshKey() string {
 	if x != nil {
 		return x.RefreshKey
 	}
 	return ""
 }
 
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) GetNotBefore() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotBefore
+	}
+	return nil
+}
+
+func (x *Setting_EncryptSecret_TokenEncrypt_Key) GetRetireAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RetireAt
+	}
+	return nil
+}
+
 type ClientApi_Endpoint struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2130,7 +3590,7 @@ type ClientApi_Endpoint struct {
 func (x *ClientApi_Endpoint) Reset() {
 	*x = ClientApi_Endpoint{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_config_config_proto_msgTypes[25]
+		mi := &file_api_config_config_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2143,7 +3603,7 @@ func (x *ClientApi_Endpoint) String() string {
 func (*ClientApi_Endpoint) ProtoMessage() {}
 
 func (x *ClientApi_Endpoint) ProtoReflect() protoreflect.Message {
-	mi := &file_api_config_config_proto_msgTypes[25]
+	mi := &file_api_config_config_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2195,396 +3655,832 @@ var file_api_config_config_proto_rawDesc = []byte{
 	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
 	0x67, 0x73, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xc4, 0x02, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70,
-	0x12, 0x2e, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x03, 0x61, 0x70, 0x70,
-	0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0e, 0x69, 0x6e, 0x66,
-	0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x27, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72,
-	0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x66, 0x72,
-	0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73, 0x65,
-	0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x61,
-	0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x61, 0x70, 0x69, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x61, 0x61,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x52, 0x09,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x22, 0xe2, 0x02, 0x0a, 0x03, 0x41, 0x70,
-	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x45, 0x6e, 0x76, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x68,
-	0x74, 0x74, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x70, 0x63,
-	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x08, 0x6d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x61,
-	0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x84,
-	0x03, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x74, 0x74,
-	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x04, 0x68,
-	0x74, 0x74, 0x70, 0x12, 0x38, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xc4, 0x02, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61,
+	0x70, 0x12, 0x2e, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x52, 0x03, 0x61, 0x70,
+	0x70, 0x12, 0x37, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e,
 	0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x1a, 0x81, 0x01,
-	0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18,
-	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x07,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x1a, 0x81, 0x01, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
-	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x8d, 0x1d, 0x0a, 0x0e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73,
-	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
-	0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x4c,
-	0x6f, 0x67, 0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x43, 0x0a, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70,
-	0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
-	0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e,
-	0x4d, 0x79, 0x53, 0x51, 0x4c, 0x52, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x12, 0x40, 0x0a, 0x04,
-	0x70, 0x73, 0x71, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x61, 0x61,
-	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x75, 0x72, 0x65, 0x2e, 0x50, 0x53, 0x51, 0x4c, 0x52, 0x04, 0x70, 0x73, 0x71, 0x6c, 0x12, 0x43,
-	0x0a, 0x05, 0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
-	0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65,
-	0x64, 0x69, 0x73, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74, 0x6d, 0x71, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
-	0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x52,
-	0x61, 0x62, 0x62, 0x69, 0x74, 0x6d, 0x71, 0x52, 0x08, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74, 0x6d,
-	0x71, 0x12, 0x46, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72,
-	0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75,
-	0x6c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x12, 0x46, 0x0a, 0x06, 0x6a, 0x61, 0x65,
-	0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x61, 0x61, 0x73,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
-	0x72, 0x65, 0x2e, 0x4a, 0x61, 0x65, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6a, 0x61, 0x65, 0x67, 0x65,
-	0x72, 0x12, 0x4f, 0x0a, 0x09, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x65, 0x72, 0x52, 0x06, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x0e, 0x69, 0x6e,
+	0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66,
+	0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x52, 0x0e, 0x69, 0x6e, 0x66,
+	0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73,
+	0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x07,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x41, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x61, 0x70, 0x69, 0x18, 0x32, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x61,
+	0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x52,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x41, 0x70, 0x69, 0x22, 0xe2, 0x02, 0x0a, 0x03, 0x41,
+	0x70, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x65, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x45, 0x6e, 0x76, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e,
+	0x68, 0x74, 0x74, 0x70, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x67, 0x72, 0x70,
+	0x63, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73,
+	0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x41, 0x70, 0x70, 0x2e, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xf1, 0x05, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x12, 0x38, 0x0a, 0x04, 0x67, 0x72, 0x70, 0x63, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x04, 0x67, 0x72, 0x70, 0x63, 0x12, 0x41,
+	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x3b, 0x0a, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x52, 0x05, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x1a, 0x81,
+	0x01, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x33, 0x0a,
+	0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x1a, 0x81, 0x01, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a,
+	0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64,
+	0x72, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x60, 0x0a, 0x07, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a,
+	0x10, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x01, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x1a, 0x88, 0x01, 0x0a, 0x05, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
+	0x69, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x49, 0x70, 0x73, 0x22, 0xc9, 0x40, 0x0a, 0x0e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x03, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e,
+	0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x4c, 0x6f, 0x67,
+	0x52, 0x03, 0x6c, 0x6f, 0x67, 0x12, 0x43, 0x0a, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49,
-	0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x53, 0x6e,
-	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x09, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61,
-	0x6b, 0x65, 0x1a, 0xfa, 0x03, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x4d, 0x0a, 0x07, 0x63, 0x6f,
-	0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73, 0x61,
+	0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x4d, 0x79,
+	0x53, 0x51, 0x4c, 0x52, 0x05, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x12, 0x40, 0x0a, 0x04, 0x70, 0x73,
+	0x71, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x50, 0x53, 0x51, 0x4c, 0x52, 0x04, 0x70, 0x73, 0x71, 0x6c, 0x12, 0x43, 0x0a, 0x05,
+	0x72, 0x65, 0x64, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x61,
 	0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f,
 	0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x75, 0x72, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
-	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x66, 0x69, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x72, 0x65, 0x64, 0x69,
+	0x73, 0x12, 0x4c, 0x0a, 0x08, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74, 0x6d, 0x71, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e,
+	0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x52, 0x61, 0x62,
+	0x62, 0x69, 0x74, 0x6d, 0x71, 0x52, 0x08, 0x72, 0x61, 0x62, 0x62, 0x69, 0x74, 0x6d, 0x71, 0x12,
+	0x46, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x12, 0x46, 0x0a, 0x06, 0x6a, 0x61, 0x65, 0x67, 0x65,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65,
-	0x2e, 0x4c, 0x6f, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x1a,
-	0x37, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x1a, 0xa4, 0x02, 0x0a, 0x04, 0x46, 0x69, 0x6c,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76,
-	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12,
-	0x10, 0x0a, 0x03, 0x64, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69,
-	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a,
-	0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x2e, 0x4a, 0x61, 0x65, 0x67, 0x65, 0x72, 0x52, 0x06, 0x6a, 0x61, 0x65, 0x67, 0x65, 0x72, 0x12,
+	0x4f, 0x0a, 0x09, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66,
+	0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x53, 0x6e, 0x6f, 0x77,
+	0x66, 0x6c, 0x61, 0x6b, 0x65, 0x52, 0x09, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65,
+	0x12, 0x64, 0x0a, 0x0f, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x61, 0x61, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75,
+	0x72, 0x65, 0x2e, 0x4d, 0x79, 0x73, 0x71, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x6d, 0x79, 0x73, 0x71, 0x6c, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x0e, 0x70, 0x73, 0x71, 0x6c, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a,
+	0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x50, 0x73, 0x71, 0x6c, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x70, 0x73, 0x71, 0x6c,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x64, 0x0a, 0x0f, 0x72, 0x65, 0x64,
+	0x69, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66,
+	0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69,
+	0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x72, 0x65, 0x64, 0x69, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12,
+	0x40, 0x0a, 0x04, 0x6f, 0x74, 0x6c, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x4f, 0x74, 0x6c, 0x70, 0x52, 0x04, 0x6f, 0x74, 0x6c,
+	0x70, 0x12, 0x40, 0x0a, 0x04, 0x65, 0x74, 0x63, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x45, 0x74, 0x63, 0x64, 0x52, 0x04, 0x65,
+	0x74, 0x63, 0x64, 0x1a, 0xb5, 0x04, 0x0a, 0x03, 0x4c, 0x6f, 0x67, 0x12, 0x4d, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x73,
+	0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x65, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x12, 0x44, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x1a, 0x4f, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x1a, 0xa4, 0x02, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x64,
+	0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x69, 0x72, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x72, 0x6f, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x41,
+	0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x1a, 0xe6, 0x06, 0x0a, 0x05,
+	0x4d, 0x79, 0x53, 0x51, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x6e, 0x12,
+	0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x77, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x66, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x66, 0x75, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x4d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61,
+	0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x5c, 0x0a, 0x1d, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x1a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63,
+	0x61, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6c, 0x73,
+	0x43, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73,
+	0x43, 0x65, 0x72, 0x74, 0x50, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6c,
+	0x73, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x1a, 0xc5, 0x07, 0x0a, 0x05, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02,
+	0x64, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x64, 0x62, 0x12, 0x3c, 0x0a, 0x0c,
+	0x64, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72,
-	0x6f, 0x74, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x6f, 0x74,
-	0x61, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a, 0x0b, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64,
+	0x69, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x61,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x41, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x1a,
-	0xbf, 0x03, 0x0a, 0x05, 0x4d, 0x79, 0x53, 0x51, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x73, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66,
+	0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x77, 0x54, 0x68, 0x72, 0x65,
-	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x5f,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x6f,
-	0x67, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f,
-	0x67, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x66, 0x75, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0e, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72,
-	0x66, 0x75, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6c, 0x65,
-	0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d,
-	0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x45,
-	0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x66,
-	0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61,
-	0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f,
-	0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
-	0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x4c,
+	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b,
+	0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63,
+	0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x69, 0x6e, 0x49, 0x64, 0x6c, 0x65, 0x12,
+	0x46, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49,
+	0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11,
+	0x73, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x65,
+	0x6c, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x65, 0x6e,
+	0x74, 0x69, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x74, 0x69, 0x6e, 0x65, 0x6c, 0x50, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x46,
+	0x72, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6c,
+	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54,
+	0x6c, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73,
+	0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x6c, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x0a,
+	0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c,
+	0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b,
+	0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x1a, 0xe5, 0x06, 0x0a,
+	0x04, 0x50, 0x53, 0x51, 0x4c, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x6e, 0x12,
+	0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x77, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x66, 0x75, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0e, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x66, 0x75, 0x6c, 0x12,
+	0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x4d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d,
-	0x65, 0x1a, 0xc0, 0x04, 0x0a, 0x05, 0x52, 0x65, 0x64, 0x69, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x62, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x64, 0x62, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x69, 0x61,
-	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x6c,
-	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61,
+	0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x5c, 0x0a, 0x1d, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x5f, 0x68, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x1a, 0x72, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1d,
+	0x0a, 0x0a, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x6c, 0x73, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x54, 0x6c, 0x73, 0x12, 0x30, 0x0a,
+	0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x6c, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x6c, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63,
+	0x61, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6c, 0x73,
+	0x43, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73,
+	0x43, 0x65, 0x72, 0x74, 0x50, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6c,
+	0x73, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x2b, 0x0a, 0x11, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x1a, 0x84, 0x07, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74,
+	0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61,
+	0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x77, 0x61,
+	0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3e, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x54, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61,
-	0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
-	0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x45, 0x0a,
-	0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x66, 0x65, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x66, 0x65,
-	0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78,
-	0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
-	0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e,
-	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x69, 0x6e, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x46, 0x0a, 0x12,
-	0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x1a, 0xbe, 0x03, 0x0a, 0x04, 0x50, 0x53, 0x51, 0x4c, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65,
-	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x64, 0x73, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x73, 0x6c, 0x6f, 0x77, 0x5f,
-	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x77, 0x61, 0x69, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x48, 0x74, 0x74, 0x70, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x75,
+	0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75,
+	0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12,
+	0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x70, 0x65, 0x6d,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x50, 0x65, 0x6d,
+	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x6d,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50,
+	0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65,
+	0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x50,
+	0x65, 0x6d, 0x12, 0x32, 0x0a, 0x15, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x3b, 0x0a, 0x1a, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x70, 0x61, 0x74, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x5e, 0x0a, 0x1e, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x66, 0x0a, 0x22, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f,
+	0x64, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x72, 0x69, 0x74, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x6c, 0x6f, 0x77,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x67,
-	0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x27,
-	0x0a, 0x0f, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x66, 0x75,
-	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x43,
-	0x6f, 0x6c, 0x6f, 0x72, 0x66, 0x75, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x67, 0x67, 0x65,
-	0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6c,
-	0x6f, 0x67, 0x67, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
-	0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61,
-	0x78, 0x4c, 0x69, 0x66, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
-	0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c, 0x65, 0x12, 0x46, 0x0a,
-	0x12, 0x63, 0x6f, 0x6e, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x6e, 0x4d, 0x61, 0x78, 0x49, 0x64, 0x6c,
-	0x65, 0x54, 0x69, 0x6d, 0x65, 0x1a, 0xcb, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61,
-	0x74, 0x68, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
-	0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x64, 0x61, 0x74, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x77,
-	0x61, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x77, 0x61, 0x69, 0x74, 0x54,
-	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d,
-	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x74, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x74,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x74,
-	0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x48, 0x74, 0x74, 0x70, 0x42, 0x61, 0x73,
-	0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61,
-	0x75, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1f, 0x72, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x79, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x43, 0x72,
+	0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x1a, 0xdf, 0x03, 0x0a, 0x04,
+	0x45, 0x74, 0x63, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x12, 0x3c, 0x0a, 0x0c, 0x64, 0x69, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
 	0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69,
-	0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
 	0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x70, 0x65,
-	0x6d, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x50, 0x65,
-	0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65,
-	0x6d, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74,
-	0x50, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70,
-	0x65, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79,
-	0x50, 0x65, 0x6d, 0x1a, 0xa5, 0x01, 0x0a, 0x06, 0x4a, 0x61, 0x65, 0x67, 0x65, 0x72, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f,
-	0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x48, 0x74, 0x74, 0x70, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0xb5, 0x01, 0x0a, 0x08,
-	0x52, 0x61, 0x62, 0x62, 0x69, 0x74, 0x6d, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62,
+	0x66, 0x79, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x70, 0x65, 0x6d,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x50, 0x65, 0x6d,
+	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50,
+	0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65,
+	0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x50,
+	0x65, 0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x74,
+	0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x54, 0x74, 0x6c, 0x12,
+	0x2c, 0x0a, 0x12, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x72, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x52, 0x65, 0x74, 0x72, 0x79, 0x1a, 0xa5, 0x01,
+	0x0a, 0x06, 0x4a, 0x61, 0x65, 0x67, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62,
 	0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75,
-	0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x70, 0x65,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x50, 0x65,
-	0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c, 0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74,
-	0x50, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70,
-	0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79,
-	0x50, 0x65, 0x6d, 0x1a, 0xcf, 0x01, 0x0a, 0x09, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b,
-	0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x69,
-	0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77,
-	0x69, 0x74, 0x68, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x14,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x14,
 	0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f,
-	0x61, 0x75, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68,
+	0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68,
 	0x48, 0x74, 0x74, 0x70, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a,
-	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x8d, 0x0b, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
-	0x67, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x5f, 0x73, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x67, 0x72,
-	0x61, 0x74, 0x65, 0x5f, 0x64, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e,
-	0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x44, 0x62, 0x12, 0x30, 0x0a,
-	0x14, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
-	0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12,
-	0x42, 0x0a, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x28, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74,
-	0x63, 0x68, 0x61, 0x12, 0x3c, 0x0a, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74,
-	0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69,
-	0x6e, 0x12, 0x55, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x73, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x61, 0x61, 0x73,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x66, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74,
-	0x63, 0x68, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x6c,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68,
-	0x61, 0x4c, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f,
-	0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x1a, 0x9b, 0x06, 0x0a, 0x04, 0x4f, 0x74, 0x6c, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x19,
+	0x0a, 0x08, 0x75, 0x72, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x75, 0x72, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x53, 0x0a, 0x07, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x73, 0x61, 0x61,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x2e, 0x4f, 0x74, 0x6c, 0x70, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x1c, 0x0a, 0x0a,
+	0x74, 0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c,
+	0x73, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b,
+	0x74, 0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33,
+	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x12, 0x28, 0x0a,
+	0x0d, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x52,
+	0x61, 0x74, 0x69, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x75, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x5f, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49,
+	0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x4f, 0x74,
+	0x6c, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x72, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x45, 0x0a, 0x17, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x72, 0x5f, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x1a, 0xe5, 0x06, 0x0a, 0x08, 0x52, 0x61, 0x62, 0x62, 0x69, 0x74, 0x6d, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6c,
+	0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x6c, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x0a, 0x74,
+	0x6c, 0x73, 0x5f, 0x63, 0x61, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x6c, 0x73, 0x43, 0x61, 0x50, 0x65, 0x6d, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x6c, 0x73,
+	0x5f, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x74, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x65, 0x6d, 0x12, 0x1e, 0x0a, 0x0b, 0x74,
+	0x6c, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x50, 0x65, 0x6d, 0x12, 0x2a, 0x0a, 0x11, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x50,
+	0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x73, 0x12, 0x55, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x17, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x15, 0x72, 0x65,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x6f, 0x66, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x54, 0x74, 0x6c,
-	0x1a, 0xba, 0x02, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61,
-	0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x1c, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x45, 0x72, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x56, 0x0a, 0x1a, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x65, 0x72,
-	0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x17, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x4c, 0x6f, 0x63,
-	0x6b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1e, 0x70, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x1a, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x44, 0x61,
-	0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x1a, 0xb5, 0x04,
-	0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
-	0x69, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x73, 0x61, 0x61, 0x73,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x66, 0x0a, 0x0f, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d,
+	0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x30, 0x0a, 0x14, 0x69, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72,
+	0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x75,
+	0x6d, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x32,
+	0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x63,
+	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x12, 0x5e, 0x0a, 0x1e, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x63,
+	0x6b, 0x6f, 0x66, 0x66, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x6f,
+	0x66, 0x66, 0x12, 0x56, 0x0a, 0x1a, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x17, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x4d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12, 0x4f, 0x0a, 0x16, 0x63, 0x6f,
+	0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x5f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x72, 0x44,
+	0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x8d, 0x03, 0x0a, 0x09,
+	0x53, 0x6e, 0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x25, 0x0a,
+	0x0e, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x74, 0x68, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x14, 0x77, 0x69, 0x74, 0x68, 0x5f, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x11, 0x77, 0x69, 0x74, 0x68, 0x48, 0x74, 0x74, 0x70, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x36, 0x0a,
+	0x09, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6c, 0x65, 0x61,
+	0x73, 0x65, 0x54, 0x74, 0x6c, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x75, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x70, 0x0a, 0x13, 0x4d,
+	0x79, 0x73, 0x71, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49, 0x6e,
+	0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x4d, 0x79, 0x53,
+	0x51, 0x4c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6e, 0x0a,
+	0x12, 0x50, 0x73, 0x71, 0x6c, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x42, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x49,
+	0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x50, 0x53,
+	0x51, 0x4c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x70, 0x0a,
+	0x13, 0x52, 0x65, 0x64, 0x69, 0x73, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+	0x49, 0x6e, 0x66, 0x72, 0x61, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x2e, 0x52,
+	0x65, 0x64, 0x69, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x83, 0x1b, 0x0a, 0x07, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x17, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x17, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x6e,
+	0x6f, 0x77, 0x66, 0x6c, 0x61, 0x6b, 0x65, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x6e, 0x6f, 0x77,
+	0x66, 0x6c, 0x61, 0x6b, 0x65, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x62,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x65, 0x44, 0x62, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x42, 0x0a, 0x07, 0x63, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x61, 0x61,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x52, 0x07, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x3c, 0x0a,
+	0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73,
+	0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x55, 0x0a, 0x0e, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
 	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65,
 	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x12, 0x60, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x61, 0x61, 0x73,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72,
-	0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
-	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x1a, 0x51, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
-	0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x50, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x4a, 0x0a, 0x0c, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67,
-	0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67,
-	0x6e, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72, 0x65,
-	0x73, 0x68, 0x4b, 0x65, 0x79, 0x22, 0xae, 0x02, 0x0a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x72, 0x65, 0x74, 0x52, 0x0d, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x75, 0x74,
+	0x62, 0x6f, 0x78, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x11, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x4f, 0x75, 0x74, 0x62, 0x6f, 0x78, 0x52, 0x65, 0x6c,
+	0x61, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x04, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0xa3, 0x03, 0x0a,
+	0x07, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x61, 0x70, 0x74,
+	0x63, 0x68, 0x61, 0x5f, 0x6c, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x4c, 0x65, 0x6e, 0x12, 0x3a, 0x0a, 0x0b, 0x63, 0x61, 0x70,
+	0x74, 0x63, 0x68, 0x61, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x54, 0x74, 0x6c, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x6e,
+	0x64, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6d, 0x61,
+	0x67, 0x65, 0x5f, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x69, 0x6d, 0x61, 0x67, 0x65, 0x57, 0x69, 0x64, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x28, 0x0a,
+	0x10, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x53, 0x65,
+	0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54,
+	0x74, 0x6c, 0x1a, 0xd6, 0x02, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x19,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x16, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x53, 0x65, 0x72, 0x69,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x1c, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x73, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x19, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x45, 0x72, 0x72, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x56, 0x0a, 0x1a, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f,
+	0x65, 0x72, 0x72, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x17, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72, 0x4c,
+	0x6f, 0x63, 0x6b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x1e, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x64, 0x61, 0x69, 0x6c,
+	0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x1a, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x45, 0x72, 0x72,
+	0x44, 0x61, 0x69, 0x6c, 0x79, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x1a, 0xa3, 0x01, 0x0a, 0x04,
+	0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x2b, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x74, 0x74,
+	0x6c, 0x12, 0x36, 0x0a, 0x17, 0x72, 0x65, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x64,
+	0x69, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x15, 0x72, 0x65, 0x64, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x64, 0x69, 0x73,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x66, 0x65, 0x6e,
+	0x63, 0x65, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x66, 0x65, 0x6e, 0x63, 0x65, 0x54, 0x74,
+	0x6c, 0x1a, 0xc3, 0x05, 0x0a, 0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x52, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0b,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x5a, 0x0a, 0x0a, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3a, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73,
+	0x12, 0x4d, 0x0a, 0x15, 0x72, 0x65, 0x64, 0x69, 0x73, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x72, 0x65, 0x64, 0x69,
+	0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x12,
+	0x54, 0x0a, 0x19, 0x72, 0x65, 0x64, 0x69, 0x73, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x16, 0x72,
+	0x65, 0x64, 0x69, 0x73, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x4d, 0x61, 0x78, 0x42, 0x61,
+	0x63, 0x6b, 0x6f, 0x66, 0x66, 0x1a, 0x9a, 0x01, 0x0a, 0x04, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x6b,
+	0x65, 0x79, 0x5f, 0x62, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79,
+	0x42, 0x79, 0x1a, 0x6e, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x45, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x4a, 0x04, 0x08, 0x04, 0x10, 0x05, 0x52, 0x0d, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0xfb, 0x08, 0x0a, 0x0d, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x69, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x12, 0x66, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e,
+	0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x52, 0x0e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x60, 0x0a, 0x0d,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x1a, 0x51,
+	0x0a, 0x0f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65,
+	0x79, 0x1a, 0xf5, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x84, 0x01, 0x0a,
+	0x13, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x54, 0x2e, 0x73, 0x61, 0x61,
+	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x2e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x11, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x73, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x1a, 0x44, 0x0a, 0x16, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xe9, 0x02, 0x0a, 0x0c, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69,
+	0x67, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x53, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x1a, 0xc7, 0x01, 0x0a, 0x03,
+	0x4b, 0x65, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x69, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x69, 0x67, 0x6e, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79,
+	0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x4b, 0x65,
+	0x79, 0x12, 0x39, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x09,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x72, 0x65, 0x74,
+	0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0xae, 0x02, 0x0a, 0x09, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
 	0x41, 0x70, 0x69, 0x12, 0x54, 0x0a, 0x0f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73,
 	0x61, 0x61, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63,
@@ -2603,14 +4499,14 @@ var file_api_config_config_proto_rawDesc = []byte{
 	0x68, 0x74, 0x74, 0x70, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x08, 0x68, 0x74, 0x74, 0x70, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x72, 0x70,
 	0x63, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x72,
-	0x70, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x70, 0x0a, 0x17, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61,
+	0x70, 0x63, 0x48, 0x6f, 0x73, 0x74, 0x42, 0x6b, 0x0a, 0x17, 0x73, 0x61, 0x61, 0x73, 0x2e, 0x61,
 	0x70, 0x69, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x73, 0x42, 0x14, 0x53, 0x61, 0x61, 0x73, 0x41, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x50, 0x01, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x50, 0x01, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
 	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6d, 0x79, 0x2d, 0x73, 0x61, 0x61, 0x73, 0x2d, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x73, 0x61, 0x61, 0x73, 0x2d, 0x61, 0x70, 0x69, 0x2d,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f, 0x61, 0x70, 0x69, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3b, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2625,35 +4521,51 @@ func file_api_config_config_proto_rawDescGZIP() []byte {
 	return file_api_config_config_proto_rawDescData
 }
 
-var file_api_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_api_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 41)
 var file_api_config_config_proto_goTypes = []interface{}{
-	(*Bootstrap)(nil),                             // 0: saas.api.config.configs.Bootstrap
-	(*App)(nil),                                   // 1: saas.api.config.configs.App
-	(*Server)(nil),                                // 2: saas.api.config.configs.Server
-	(*Infrastructure)(nil),                        // 3: saas.api.config.configs.Infrastructure
-	(*Setting)(nil),                               // 4: saas.api.config.configs.Setting
-	(*ClientApi)(nil),                             // 5: saas.api.config.configs.ClientApi
-	nil,                                           // 6: saas.api.config.configs.App.MetadataEntry
-	(*Server_HTTP)(nil),                           // 7: saas.api.config.configs.Server.HTTP
-	(*Server_GRPC)(nil),                           // 8: saas.api.config.configs.Server.GRPC
-	(*Infrastructure_Log)(nil),                    // 9: saas.api.config.configs.Infrastructure.Log
-	(*Infrastructure_MySQL)(nil),                  // 10: saas.api.config.configs.Infrastructure.MySQL
-	(*Infrastructure_Redis)(nil),                  // 11: saas.api.config.configs.Infrastructure.Redis
-	(*Infrastructure_PSQL)(nil),                   // 12: saas.api.config.configs.Infrastructure.PSQL
-	(*Infrastructure_Consul)(nil),                 // 13: saas.api.config.configs.Infrastructure.Consul
-	(*Infrastructure_Jaeger)(nil),                 // 14: saas.api.config.configs.Infrastructure.Jaeger
-	(*Infrastructure_Rabbitmq)(nil),               // 15: saas.api.config.configs.Infrastructure.Rabbitmq
-	(*Infrastructure_Snowflake)(nil),              // 16: saas.api.config.configs.Infrastructure.Snowflake
-	(*Infrastructure_Log_Console)(nil),            // 17: saas.api.config.configs.Infrastructure.Log.Console
-	(*Infrastructure_Log_File)(nil),               // 18: saas.api.config.configs.Infrastructure.Log.File
-	(*Setting_Captcha)(nil),                       // 19: saas.api.config.configs.Setting.Captcha
-	(*Setting_Login)(nil),                         // 20: saas.api.config.configs.Setting.Login
-	(*Setting_EncryptSecret)(nil),                 // 21: saas.api.config.configs.Setting.EncryptSecret
-	(*Setting_EncryptSecret_TransferEncrypt)(nil), // 22: saas.api.config.configs.Setting.EncryptSecret.TransferEncrypt
-	(*Setting_EncryptSecret_ServiceEncrypt)(nil),  // 23: saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt
-	(*Setting_EncryptSecret_TokenEncrypt)(nil),    // 24: saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt
-	(*ClientApi_Endpoint)(nil),                    // 25: saas.api.config.configs.ClientApi.Endpoint
-	(*durationpb.Duration)(nil),                   // 26: google.protobuf.Duration
+	(*Bootstrap)(nil),                  // 0: saas.api.config.configs.Bootstrap
+	(*App)(nil),                        // 1: saas.api.config.configs.App
+	(*Server)(nil),                     // 2: saas.api.config.configs.Server
+	(*Infrastructure)(nil),             // 3: saas.api.config.configs.Infrastructure
+	(*Setting)(nil),                    // 4: saas.api.config.configs.Setting
+	(*ClientApi)(nil),                  // 5: saas.api.config.configs.ClientApi
+	nil,                                // 6: saas.api.config.configs.App.MetadataEntry
+	(*Server_HTTP)(nil),                // 7: saas.api.config.configs.Server.HTTP
+	(*Server_GRPC)(nil),                // 8: saas.api.config.configs.Server.GRPC
+	(*Server_Metrics)(nil),             // 9: saas.api.config.configs.Server.Metrics
+	(*Server_Admin)(nil),               // 10: saas.api.config.configs.Server.Admin
+	(*Infrastructure_Log)(nil),         // 11: saas.api.config.configs.Infrastructure.Log
+	(*Infrastructure_MySQL)(nil),       // 12: saas.api.config.configs.Infrastructure.MySQL
+	(*Infrastructure_Redis)(nil),       // 13: saas.api.config.configs.Infrastructure.Redis
+	(*Infrastructure_PSQL)(nil),        // 14: saas.api.config.configs.Infrastructure.PSQL
+	(*Infrastructure_Consul)(nil),      // 15: saas.api.config.configs.Infrastructure.Consul
+	(*Infrastructure_Etcd)(nil),        // 16: saas.api.config.configs.Infrastructure.Etcd
+	(*Infrastructure_Jaeger)(nil),      // 17: saas.api.config.configs.Infrastructure.Jaeger
+	(*Infrastructure_Otlp)(nil),        // 18: saas.api.config.configs.Infrastructure.Otlp
+	(*Infrastructure_Rabbitmq)(nil),    // 19: saas.api.config.configs.Infrastructure.Rabbitmq
+	(*Infrastructure_Snowflake)(nil),   // 20: saas.api.config.configs.Infrastructure.Snowflake
+	nil,                                // 21: saas.api.config.configs.Infrastructure.MysqlInstancesEntry
+	nil,                                // 22: saas.api.config.configs.Infrastructure.PsqlInstancesEntry
+	nil,                                // 23: saas.api.config.configs.Infrastructure.RedisInstancesEntry
+	(*Infrastructure_Log_Console)(nil), // 24: saas.api.config.configs.Infrastructure.Log.Console
+	(*Infrastructure_Log_File)(nil),    // 25: saas.api.config.configs.Infrastructure.Log.File
+	nil,                                // 26: saas.api.config.configs.Infrastructure.Otlp.HeadersEntry
+	nil,                                // 27: saas.api.config.configs.Infrastructure.Otlp.ResourceAttributesEntry
+	(*Setting_Captcha)(nil),            // 28: saas.api.config.configs.Setting.Captcha
+	(*Setting_Login)(nil),              // 29: saas.api.config.configs.Setting.Login
+	(*Setting_Lock)(nil),               // 30: saas.api.config.configs.Setting.Lock
+	(*Setting_RateLimit)(nil),          // 31: saas.api.config.configs.Setting.RateLimit
+	(*Setting_EncryptSecret)(nil),      // 32: saas.api.config.configs.Setting.EncryptSecret
+	(*Setting_RateLimit_Rule)(nil),     // 33: saas.api.config.configs.Setting.RateLimit.Rule
+	nil,                                // 34: saas.api.config.configs.Setting.RateLimit.OperationsEntry
+	(*Setting_EncryptSecret_TransferEncrypt)(nil), // 35: saas.api.config.configs.Setting.EncryptSecret.TransferEncrypt
+	(*Setting_EncryptSecret_ServiceEncrypt)(nil),  // 36: saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt
+	(*Setting_EncryptSecret_TokenEncrypt)(nil),    // 37: saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt
+	nil, // 38: saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt.TrustedPublicKeysEntry
+	(*Setting_EncryptSecret_TokenEncrypt_Key)(nil), // 39: saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt.Key
+	(*ClientApi_Endpoint)(nil),                     // 40: saas.api.config.configs.ClientApi.Endpoint
+	(*durationpb.Duration)(nil),                    // 41: google.protobuf.Duration
+	(*timestamppb.Timestamp)(nil),                  // 42: google.protobuf.Timestamp
 }
 var file_api_config_config_proto_depIdxs = []int32{
 	1,  // 0: saas.api.config.configs.Bootstrap.app:type_name -> saas.api.config.configs.App
@@ -2664,48 +4576,92 @@ var file_api_config_config_proto_depIdxs = []int32{
 	6,  // 5: saas.api.config.configs.App.metadata:type_name -> saas.api.config.configs.App.MetadataEntry
 	7,  // 6: saas.api.config.configs.Server.http:type_name -> saas.api.config.configs.Server.HTTP
 	8,  // 7: saas.api.config.configs.Server.grpc:type_name -> saas.api.config.configs.Server.GRPC
-	9,  // 8: saas.api.config.configs.Infrastructure.log:type_name -> saas.api.config.configs.Infrastructure.Log
-	10, // 9: saas.api.config.configs.Infrastructure.mysql:type_name -> saas.api.config.configs.Infrastructure.MySQL
-	12, // 10: saas.api.config.configs.Infrastructure.psql:type_name -> saas.api.config.configs.Infrastructure.PSQL
-	11, // 11: saas.api.config.configs.Infrastructure.redis:type_name -> saas.api.config.configs.Infrastructure.Redis
-	15, // 12: saas.api.config.configs.Infrastructure.rabbitmq:type_name -> saas.api.config.configs.Infrastructure.Rabbitmq
-	13, // 13: saas.api.config.configs.Infrastructure.consul:type_name -> saas.api.config.configs.Infrastructure.Consul
-	14, // 14: saas.api.config.configs.Infrastructure.jaeger:type_name -> saas.api.config.configs.Infrastructure.Jaeger
-	16, // 15: saas.api.config.configs.Infrastructure.snowflake:type_name -> saas.api.config.configs.Infrastructure.Snowflake
-	19, // 16: saas.api.config.configs.Setting.captcha:type_name -> saas.api.config.configs.Setting.Captcha
-	20, // 17: saas.api.config.configs.Setting.login:type_name -> saas.api.config.configs.Setting.Login
-	21, // 18: saas.api.config.configs.Setting.encrypt_secret:type_name -> saas.api.config.configs.Setting.EncryptSecret
-	25, // 19: saas.api.config.configs.ClientApi.cluster_service:type_name -> saas.api.config.configs.ClientApi.Endpoint
-	25, // 20: saas.api.config.configs.ClientApi.third_party:type_name -> saas.api.config.configs.ClientApi.Endpoint
-	26, // 21: saas.api.config.configs.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	26, // 22: saas.api.config.configs.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	17, // 23: saas.api.config.configs.Infrastructure.Log.console:type_name -> saas.api.config.configs.Infrastructure.Log.Console
-	18, // 24: saas.api.config.configs.Infrastructure.Log.file:type_name -> saas.api.config.configs.Infrastructure.Log.File
-	26, // 25: saas.api.config.configs.Infrastructure.MySQL.slow_threshold:type_name -> google.protobuf.Duration
-	26, // 26: saas.api.config.configs.Infrastructure.MySQL.conn_max_lifetime:type_name -> google.protobuf.Duration
-	26, // 27: saas.api.config.configs.Infrastructure.MySQL.conn_max_idle_time:type_name -> google.protobuf.Duration
-	26, // 28: saas.api.config.configs.Infrastructure.Redis.dial_timeout:type_name -> google.protobuf.Duration
-	26, // 29: saas.api.config.configs.Infrastructure.Redis.read_timeout:type_name -> google.protobuf.Duration
-	26, // 30: saas.api.config.configs.Infrastructure.Redis.write_timeout:type_name -> google.protobuf.Duration
-	26, // 31: saas.api.config.configs.Infrastructure.Redis.conn_max_lifetime:type_name -> google.protobuf.Duration
-	26, // 32: saas.api.config.configs.Infrastructure.Redis.conn_max_idle_time:type_name -> google.protobuf.Duration
-	26, // 33: saas.api.config.configs.Infrastructure.PSQL.slow_threshold:type_name -> google.protobuf.Duration
-	26, // 34: saas.api.config.configs.Infrastructure.PSQL.conn_max_lifetime:type_name -> google.protobuf.Duration
-	26, // 35: saas.api.config.configs.Infrastructure.PSQL.conn_max_idle_time:type_name -> google.protobuf.Duration
-	26, // 36: saas.api.config.configs.Infrastructure.Consul.wait_time:type_name -> google.protobuf.Duration
-	26, // 37: saas.api.config.configs.Infrastructure.Log.File.rotate_time:type_name -> google.protobuf.Duration
-	26, // 38: saas.api.config.configs.Infrastructure.Log.File.storage_age:type_name -> google.protobuf.Duration
-	26, // 39: saas.api.config.configs.Setting.Captcha.captcha_ttl:type_name -> google.protobuf.Duration
-	26, // 40: saas.api.config.configs.Setting.Login.password_err_serial_duration:type_name -> google.protobuf.Duration
-	26, // 41: saas.api.config.configs.Setting.Login.password_err_lock_duration:type_name -> google.protobuf.Duration
-	22, // 42: saas.api.config.configs.Setting.EncryptSecret.transfer_encrypt:type_name -> saas.api.config.configs.Setting.EncryptSecret.TransferEncrypt
-	23, // 43: saas.api.config.configs.Setting.EncryptSecret.service_encrypt:type_name -> saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt
-	24, // 44: saas.api.config.configs.Setting.EncryptSecret.token_encrypt:type_name -> saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt
-	45, // [45:45] is the sub-list for method output_type
-	45, // [45:45] is the sub-list for method input_type
-	45, // [45:45] is the sub-list for extension type_name
-	45, // [45:45] is the sub-list for extension extendee
-	0,  // [0:45] is the sub-list for field type_name
+	9,  // 8: saas.api.config.configs.Server.metrics:type_name -> saas.api.config.configs.Server.Metrics
+	10, // 9: saas.api.config.configs.Server.admin:type_name -> saas.api.config.configs.Server.Admin
+	11, // 10: saas.api.config.configs.Infrastructure.log:type_name -> saas.api.config.configs.Infrastructure.Log
+	12, // 11: saas.api.config.configs.Infrastructure.mysql:type_name -> saas.api.config.configs.Infrastructure.MySQL
+	14, // 12: saas.api.config.configs.Infrastructure.psql:type_name -> saas.api.config.configs.Infrastructure.PSQL
+	13, // 13: saas.api.config.configs.Infrastructure.redis:type_name -> saas.api.config.configs.Infrastructure.Redis
+	19, // 14: saas.api.config.configs.Infrastructure.rabbitmq:type_name -> saas.api.config.configs.Infrastructure.Rabbitmq
+	15, // 15: saas.api.config.configs.Infrastructure.consul:type_name -> saas.api.config.configs.Infrastructure.Consul
+	17, // 16: saas.api.config.configs.Infrastructure.jaeger:type_name -> saas.api.config.configs.Infrastructure.Jaeger
+	20, // 17: saas.api.config.configs.Infrastructure.snowflake:type_name -> saas.api.config.configs.Infrastructure.Snowflake
+	21, // 18: saas.api.config.configs.Infrastructure.mysql_instances:type_name -> saas.api.config.configs.Infrastructure.MysqlInstancesEntry
+	22, // 19: saas.api.config.configs.Infrastructure.psql_instances:type_name -> saas.api.config.configs.Infrastructure.PsqlInstancesEntry
+	23, // 20: saas.api.config.configs.Infrastructure.redis_instances:type_name -> saas.api.config.configs.Infrastructure.RedisInstancesEntry
+	18, // 21: saas.api.config.configs.Infrastructure.otlp:type_name -> saas.api.config.configs.Infrastructure.Otlp
+	16, // 22: saas.api.config.configs.Infrastructure.etcd:type_name -> saas.api.config.configs.Infrastructure.Etcd
+	28, // 23: saas.api.config.configs.Setting.captcha:type_name -> saas.api.config.configs.Setting.Captcha
+	29, // 24: saas.api.config.configs.Setting.login:type_name -> saas.api.config.configs.Setting.Login
+	32, // 25: saas.api.config.configs.Setting.encrypt_secret:type_name -> saas.api.config.configs.Setting.EncryptSecret
+	30, // 26: saas.api.config.configs.Setting.lock:type_name -> saas.api.config.configs.Setting.Lock
+	31, // 27: saas.api.config.configs.Setting.rate_limit:type_name -> saas.api.config.configs.Setting.RateLimit
+	40, // 28: saas.api.config.configs.ClientApi.cluster_service:type_name -> saas.api.config.configs.ClientApi.Endpoint
+	40, // 29: saas.api.config.configs.ClientApi.third_party:type_name -> saas.api.config.configs.ClientApi.Endpoint
+	41, // 30: saas.api.config.configs.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	41, // 31: saas.api.config.configs.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	24, // 32: saas.api.config.configs.Infrastructure.Log.console:type_name -> saas.api.config.configs.Infrastructure.Log.Console
+	25, // 33: saas.api.config.configs.Infrastructure.Log.file:type_name -> saas.api.config.configs.Infrastructure.Log.File
+	41, // 34: saas.api.config.configs.Infrastructure.MySQL.slow_threshold:type_name -> google.protobuf.Duration
+	41, // 35: saas.api.config.configs.Infrastructure.MySQL.conn_max_lifetime:type_name -> google.protobuf.Duration
+	41, // 36: saas.api.config.configs.Infrastructure.MySQL.conn_max_idle_time:type_name -> google.protobuf.Duration
+	41, // 37: saas.api.config.configs.Infrastructure.MySQL.replica_health_check_interval:type_name -> google.protobuf.Duration
+	41, // 38: saas.api.config.configs.Infrastructure.Redis.dial_timeout:type_name -> google.protobuf.Duration
+	41, // 39: saas.api.config.configs.Infrastructure.Redis.read_timeout:type_name -> google.protobuf.Duration
+	41, // 40: saas.api.config.configs.Infrastructure.Redis.write_timeout:type_name -> google.protobuf.Duration
+	41, // 41: saas.api.config.configs.Infrastructure.Redis.conn_max_lifetime:type_name -> google.protobuf.Duration
+	41, // 42: saas.api.config.configs.Infrastructure.Redis.conn_max_idle_time:type_name -> google.protobuf.Duration
+	41, // 43: saas.api.config.configs.Infrastructure.PSQL.slow_threshold:type_name -> google.protobuf.Duration
+	41, // 44: saas.api.config.configs.Infrastructure.PSQL.conn_max_lifetime:type_name -> google.protobuf.Duration
+	41, // 45: saas.api.config.configs.Infrastructure.PSQL.conn_max_idle_time:type_name -> google.protobuf.Duration
+	41, // 46: saas.api.config.configs.Infrastructure.PSQL.replica_health_check_interval:type_name -> google.protobuf.Duration
+	41, // 47: saas.api.config.configs.Infrastructure.Consul.wait_time:type_name -> google.protobuf.Duration
+	41, // 48: saas.api.config.configs.Infrastructure.Consul.registry_health_check_interval:type_name -> google.protobuf.Duration
+	41, // 49: saas.api.config.configs.Infrastructure.Consul.registry_deregister_critical_after:type_name -> google.protobuf.Duration
+	41, // 50: saas.api.config.configs.Infrastructure.Etcd.dial_timeout:type_name -> google.protobuf.Duration
+	41, // 51: saas.api.config.configs.Infrastructure.Etcd.registry_ttl:type_name -> google.protobuf.Duration
+	26, // 52: saas.api.config.configs.Infrastructure.Otlp.headers:type_name -> saas.api.config.configs.Infrastructure.Otlp.HeadersEntry
+	41, // 53: saas.api.config.configs.Infrastructure.Otlp.timeout:type_name -> google.protobuf.Duration
+	27, // 54: saas.api.config.configs.Infrastructure.Otlp.resource_attributes:type_name -> saas.api.config.configs.Infrastructure.Otlp.ResourceAttributesEntry
+	41, // 55: saas.api.config.configs.Infrastructure.Rabbitmq.reconnect_initial_backoff:type_name -> google.protobuf.Duration
+	41, // 56: saas.api.config.configs.Infrastructure.Rabbitmq.reconnect_max_backoff:type_name -> google.protobuf.Duration
+	41, // 57: saas.api.config.configs.Infrastructure.Rabbitmq.consumer_retry_initial_backoff:type_name -> google.protobuf.Duration
+	41, // 58: saas.api.config.configs.Infrastructure.Rabbitmq.consumer_retry_max_backoff:type_name -> google.protobuf.Duration
+	41, // 59: saas.api.config.configs.Infrastructure.Rabbitmq.consumer_drain_timeout:type_name -> google.protobuf.Duration
+	41, // 60: saas.api.config.configs.Infrastructure.Snowflake.lease_ttl:type_name -> google.protobuf.Duration
+	41, // 61: saas.api.config.configs.Infrastructure.Snowflake.renew_interval:type_name -> google.protobuf.Duration
+	41, // 62: saas.api.config.configs.Infrastructure.Snowflake.startup_timeout:type_name -> google.protobuf.Duration
+	12, // 63: saas.api.config.configs.Infrastructure.MysqlInstancesEntry.value:type_name -> saas.api.config.configs.Infrastructure.MySQL
+	14, // 64: saas.api.config.configs.Infrastructure.PsqlInstancesEntry.value:type_name -> saas.api.config.configs.Infrastructure.PSQL
+	13, // 65: saas.api.config.configs.Infrastructure.RedisInstancesEntry.value:type_name -> saas.api.config.configs.Infrastructure.Redis
+	41, // 66: saas.api.config.configs.Infrastructure.Log.File.rotate_time:type_name -> google.protobuf.Duration
+	41, // 67: saas.api.config.configs.Infrastructure.Log.File.storage_age:type_name -> google.protobuf.Duration
+	41, // 68: saas.api.config.configs.Setting.Captcha.captcha_ttl:type_name -> google.protobuf.Duration
+	41, // 69: saas.api.config.configs.Setting.Captcha.resend_interval:type_name -> google.protobuf.Duration
+	41, // 70: saas.api.config.configs.Setting.Captcha.token_ttl:type_name -> google.protobuf.Duration
+	41, // 71: saas.api.config.configs.Setting.Login.password_err_serial_duration:type_name -> google.protobuf.Duration
+	41, // 72: saas.api.config.configs.Setting.Login.password_err_lock_duration:type_name -> google.protobuf.Duration
+	41, // 73: saas.api.config.configs.Setting.Lock.ttl:type_name -> google.protobuf.Duration
+	41, // 74: saas.api.config.configs.Setting.Lock.fence_ttl:type_name -> google.protobuf.Duration
+	33, // 75: saas.api.config.configs.Setting.RateLimit.default_rule:type_name -> saas.api.config.configs.Setting.RateLimit.Rule
+	34, // 76: saas.api.config.configs.Setting.RateLimit.operations:type_name -> saas.api.config.configs.Setting.RateLimit.OperationsEntry
+	41, // 77: saas.api.config.configs.Setting.RateLimit.redis_breaker_backoff:type_name -> google.protobuf.Duration
+	41, // 78: saas.api.config.configs.Setting.RateLimit.redis_breaker_max_backoff:type_name -> google.protobuf.Duration
+	35, // 79: saas.api.config.configs.Setting.EncryptSecret.transfer_encrypt:type_name -> saas.api.config.configs.Setting.EncryptSecret.TransferEncrypt
+	36, // 80: saas.api.config.configs.Setting.EncryptSecret.service_encrypt:type_name -> saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt
+	37, // 81: saas.api.config.configs.Setting.EncryptSecret.token_encrypt:type_name -> saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt
+	41, // 82: saas.api.config.configs.Setting.RateLimit.Rule.window:type_name -> google.protobuf.Duration
+	33, // 83: saas.api.config.configs.Setting.RateLimit.OperationsEntry.value:type_name -> saas.api.config.configs.Setting.RateLimit.Rule
+	38, // 84: saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt.trusted_public_keys:type_name -> saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt.TrustedPublicKeysEntry
+	41, // 85: saas.api.config.configs.Setting.EncryptSecret.ServiceEncrypt.replay_window:type_name -> google.protobuf.Duration
+	39, // 86: saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt.keys:type_name -> saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt.Key
+	42, // 87: saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt.Key.not_before:type_name -> google.protobuf.Timestamp
+	42, // 88: saas.api.config.configs.Setting.EncryptSecret.TokenEncrypt.Key.retire_at:type_name -> google.protobuf.Timestamp
+	89, // [89:89] is the sub-list for method output_type
+	89, // [89:89] is the sub-list for method input_type
+	89, // [89:89] is the sub-list for extension type_name
+	89, // [89:89] is the sub-list for extension extendee
+	0,  // [0:89] is the sub-list for field type_name
 }
 
 func init() { file_api_config_config_proto_init() }
@@ -2811,7 +4767,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Log); i {
+			switch v := v.(*Server_Metrics); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2823,7 +4779,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_MySQL); i {
+			switch v := v.(*Server_Admin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2835,7 +4791,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Redis); i {
+			switch v := v.(*Infrastructure_Log); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2847,7 +4803,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_PSQL); i {
+			switch v := v.(*Infrastructure_MySQL); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2859,7 +4815,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Consul); i {
+			switch v := v.(*Infrastructure_Redis); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2871,7 +4827,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Jaeger); i {
+			switch v := v.(*Infrastructure_PSQL); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2883,7 +4839,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Rabbitmq); i {
+			switch v := v.(*Infrastructure_Consul); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2895,7 +4851,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Snowflake); i {
+			switch v := v.(*Infrastructure_Etcd); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2907,7 +4863,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Log_Console); i {
+			switch v := v.(*Infrastructure_Jaeger); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2919,7 +4875,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Infrastructure_Log_File); i {
+			switch v := v.(*Infrastructure_Otlp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2931,7 +4887,7 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Setting_Captcha); i {
+			switch v := v.(*Infrastructure_Rabbitmq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2943,6 +4899,54 @@ func file_api_config_config_proto_init() {
 			}
 		}
 		file_api_config_config_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Infrastructure_Snowflake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Infrastructure_Log_Console); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Infrastructure_Log_File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting_Captcha); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Setting_Login); i {
 			case 0:
 				return &v.state
@@ -2954,7 +4958,31 @@ func file_api_config_config_proto_init() {
 				return nil
 			}
 		}
-		file_api_config_config_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_api_config_config_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting_Lock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting_RateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Setting_EncryptSecret); i {
 			case 0:
 				return &v.state
@@ -2966,7 +4994,19 @@ func file_api_config_config_proto_init() {
 				return nil
 			}
 		}
-		file_api_config_config_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_api_config_config_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting_RateLimit_Rule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Setting_EncryptSecret_TransferEncrypt); i {
 			case 0:
 				return &v.state
@@ -2978,7 +5018,7 @@ func file_api_config_config_proto_init() {
 				return nil
 			}
 		}
-		file_api_config_config_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_api_config_config_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Setting_EncryptSecret_ServiceEncrypt); i {
 			case 0:
 				return &v.state
@@ -2990,7 +5030,7 @@ func file_api_config_config_proto_init() {
 				return nil
 			}
 		}
-		file_api_config_config_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_api_config_config_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Setting_EncryptSecret_TokenEncrypt); i {
 			case 0:
 				return &v.state
@@ -3002,7 +5042,19 @@ func file_api_config_config_proto_init() {
 				return nil
 			}
 		}
-		file_api_config_config_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_api_config_config_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Setting_EncryptSecret_TokenEncrypt_Key); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_config_config_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClientApi_Endpoint); i {
 			case 0:
 				return &v.state
@@ -3015,13 +5067,14 @@ func file_api_config_config_proto_init() {
 			}
 		}
 	}
+	file_api_config_config_proto_msgTypes[18].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_config_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   41,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
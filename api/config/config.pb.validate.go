@@ -466,6 +466,64 @@ func (m *Server) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetMetrics()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Metrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Metrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetrics()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "Metrics",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAdmin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Admin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ServerValidationError{
+					field:  "Admin",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAdmin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ServerValidationError{
+				field:  "Admin",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ServerMultiError(errors)
 	}
@@ -797,6 +855,202 @@ func (m *Infrastructure) validate(all bool) error {
 		}
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetMysqlInstances()))
+		i := 0
+		for key := range m.GetMysqlInstances() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetMysqlInstances()[key]
+			_ = val
+
+			// no validation rules for MysqlInstances[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InfrastructureValidationError{
+							field:  fmt.Sprintf("MysqlInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InfrastructureValidationError{
+							field:  fmt.Sprintf("MysqlInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InfrastructureValidationError{
+						field:  fmt.Sprintf("MysqlInstances[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetPsqlInstances()))
+		i := 0
+		for key := range m.GetPsqlInstances() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetPsqlInstances()[key]
+			_ = val
+
+			// no validation rules for PsqlInstances[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InfrastructureValidationError{
+							field:  fmt.Sprintf("PsqlInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InfrastructureValidationError{
+							field:  fmt.Sprintf("PsqlInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InfrastructureValidationError{
+						field:  fmt.Sprintf("PsqlInstances[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetRedisInstances()))
+		i := 0
+		for key := range m.GetRedisInstances() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetRedisInstances()[key]
+			_ = val
+
+			// no validation rules for RedisInstances[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InfrastructureValidationError{
+							field:  fmt.Sprintf("RedisInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InfrastructureValidationError{
+							field:  fmt.Sprintf("RedisInstances[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InfrastructureValidationError{
+						field:  fmt.Sprintf("RedisInstances[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOtlp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InfrastructureValidationError{
+					field:  "Otlp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InfrastructureValidationError{
+					field:  "Otlp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOtlp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InfrastructureValidationError{
+				field:  "Otlp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEtcd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InfrastructureValidationError{
+					field:  "Etcd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InfrastructureValidationError{
+					field:  "Etcd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEtcd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InfrastructureValidationError{
+				field:  "Etcd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return InfrastructureMultiError(errors)
 	}
@@ -991,6 +1245,68 @@ func (m *Setting) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for EnableOutboxRelay
+
+	// no validation rules for ServiceRegistryType
+
+	if all {
+		switch v := interface{}(m.GetLock()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettingValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettingValidationError{
+					field:  "Lock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLock()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettingValidationError{
+				field:  "Lock",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRateLimit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SettingValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SettingValidationError{
+					field:  "RateLimit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SettingValidationError{
+				field:  "RateLimit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return SettingMultiError(errors)
 	}
@@ -1503,100 +1819,46 @@ var _ interface {
 	ErrorName() string
 } = Server_GRPCValidationError{}
 
-// Validate checks the field values on Infrastructure_Log with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_Log) Validate() error {
+// Validate checks the field values on Server_Metrics with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_Metrics) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_Log with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// Infrastructure_LogMultiError, or nil if none found.
-func (m *Infrastructure_Log) ValidateAll() error {
+// ValidateAll checks the field values on Server_Metrics with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_MetricsMultiError,
+// or nil if none found.
+func (m *Server_Metrics) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_Log) validate(all bool) error {
+func (m *Server_Metrics) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetConsole()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_LogValidationError{
-					field:  "Console",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_LogValidationError{
-					field:  "Console",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConsole()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Infrastructure_LogValidationError{
-				field:  "Console",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Enable
 
-	if all {
-		switch v := interface{}(m.GetFile()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_LogValidationError{
-					field:  "File",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_LogValidationError{
-					field:  "File",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Infrastructure_LogValidationError{
-				field:  "File",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Path
 
 	if len(errors) > 0 {
-		return Infrastructure_LogMultiError(errors)
+		return Server_MetricsMultiError(errors)
 	}
 
 	return nil
 }
 
-// Infrastructure_LogMultiError is an error wrapping multiple validation errors
-// returned by Infrastructure_Log.ValidateAll() if the designated constraints
+// Server_MetricsMultiError is an error wrapping multiple validation errors
+// returned by Server_Metrics.ValidateAll() if the designated constraints
 // aren't met.
-type Infrastructure_LogMultiError []error
+type Server_MetricsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Infrastructure_LogMultiError) Error() string {
+func (m Server_MetricsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1605,11 +1867,11 @@ func (m Infrastructure_LogMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Infrastructure_LogMultiError) AllErrors() []error { return m }
+func (m Server_MetricsMultiError) AllErrors() []error { return m }
 
-// Infrastructure_LogValidationError is the validation error returned by
-// Infrastructure_Log.Validate if the designated constraints aren't met.
-type Infrastructure_LogValidationError struct {
+// Server_MetricsValidationError is the validation error returned by
+// Server_Metrics.Validate if the designated constraints aren't met.
+type Server_MetricsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1617,24 +1879,22 @@ type Infrastructure_LogValidationError struct {
 }
 
 // Field function returns field value.
-func (e Infrastructure_LogValidationError) Field() string { return e.field }
+func (e Server_MetricsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Infrastructure_LogValidationError) Reason() string { return e.reason }
+func (e Server_MetricsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Infrastructure_LogValidationError) Cause() error { return e.cause }
+func (e Server_MetricsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Infrastructure_LogValidationError) Key() bool { return e.key }
+func (e Server_MetricsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Infrastructure_LogValidationError) ErrorName() string {
-	return "Infrastructure_LogValidationError"
-}
+func (e Server_MetricsValidationError) ErrorName() string { return "Server_MetricsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Infrastructure_LogValidationError) Error() string {
+func (e Server_MetricsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1646,14 +1906,14 @@ func (e Infrastructure_LogValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInfrastructure_Log.%s: %s%s",
+		"invalid %sServer_Metrics.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Infrastructure_LogValidationError{}
+var _ error = Server_MetricsValidationError{}
 
 var _ interface {
 	Field() string
@@ -1661,24 +1921,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Infrastructure_LogValidationError{}
+} = Server_MetricsValidationError{}
 
-// Validate checks the field values on Infrastructure_MySQL with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_MySQL) Validate() error {
+// Validate checks the field values on Server_Admin with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Server_Admin) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_MySQL with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// Infrastructure_MySQLMultiError, or nil if none found.
-func (m *Infrastructure_MySQL) ValidateAll() error {
+// ValidateAll checks the field values on Server_Admin with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Server_AdminMultiError, or
+// nil if none found.
+func (m *Server_Admin) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_MySQL) validate(all bool) error {
+func (m *Server_Admin) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1687,119 +1947,185 @@ func (m *Infrastructure_MySQL) validate(all bool) error {
 
 	// no validation rules for Enable
 
-	// no validation rules for Dsn
+	// no validation rules for Path
 
-	if all {
-		switch v := interface{}(m.GetSlowThreshold()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_MySQLValidationError{
-					field:  "SlowThreshold",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_MySQLValidationError{
-					field:  "SlowThreshold",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSlowThreshold()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Infrastructure_MySQLValidationError{
-				field:  "SlowThreshold",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return Server_AdminMultiError(errors)
 	}
 
-	// no validation rules for LoggerEnable
+	return nil
+}
 
-	// no validation rules for LoggerColorful
+// Server_AdminMultiError is an error wrapping multiple validation errors
+// returned by Server_Admin.ValidateAll() if the designated constraints aren't met.
+type Server_AdminMultiError []error
 
-	// no validation rules for LoggerLevel
+// Error returns a concatenation of all the error messages it wraps.
+func (m Server_AdminMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for ConnMaxActive
+// AllErrors returns a list of validation violation errors.
+func (m Server_AdminMultiError) AllErrors() []error { return m }
+
+// Server_AdminValidationError is the validation error returned by
+// Server_Admin.Validate if the designated constraints aren't met.
+type Server_AdminValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Server_AdminValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Server_AdminValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Server_AdminValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Server_AdminValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Server_AdminValidationError) ErrorName() string { return "Server_AdminValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Server_AdminValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServer_Admin.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Server_AdminValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Server_AdminValidationError{}
+
+// Validate checks the field values on Infrastructure_Log with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Infrastructure_Log) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Infrastructure_Log with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Infrastructure_LogMultiError, or nil if none found.
+func (m *Infrastructure_Log) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Infrastructure_Log) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetConnMaxLifetime()).(type) {
+		switch v := interface{}(m.GetConsole()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_MySQLValidationError{
-					field:  "ConnMaxLifetime",
+				errors = append(errors, Infrastructure_LogValidationError{
+					field:  "Console",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_MySQLValidationError{
-					field:  "ConnMaxLifetime",
+				errors = append(errors, Infrastructure_LogValidationError{
+					field:  "Console",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetConnMaxLifetime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetConsole()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Infrastructure_MySQLValidationError{
-				field:  "ConnMaxLifetime",
+			return Infrastructure_LogValidationError{
+				field:  "Console",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for ConnMaxIdle
-
 	if all {
-		switch v := interface{}(m.GetConnMaxIdleTime()).(type) {
+		switch v := interface{}(m.GetFile()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_MySQLValidationError{
-					field:  "ConnMaxIdleTime",
+				errors = append(errors, Infrastructure_LogValidationError{
+					field:  "File",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_MySQLValidationError{
-					field:  "ConnMaxIdleTime",
+				errors = append(errors, Infrastructure_LogValidationError{
+					field:  "File",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetConnMaxIdleTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Infrastructure_MySQLValidationError{
-				field:  "ConnMaxIdleTime",
+			return Infrastructure_LogValidationError{
+				field:  "File",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for FieldSchema
+
 	if len(errors) > 0 {
-		return Infrastructure_MySQLMultiError(errors)
+		return Infrastructure_LogMultiError(errors)
 	}
 
 	return nil
 }
 
-// Infrastructure_MySQLMultiError is an error wrapping multiple validation
-// errors returned by Infrastructure_MySQL.ValidateAll() if the designated
-// constraints aren't met.
-type Infrastructure_MySQLMultiError []error
+// Infrastructure_LogMultiError is an error wrapping multiple validation errors
+// returned by Infrastructure_Log.ValidateAll() if the designated constraints
+// aren't met.
+type Infrastructure_LogMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Infrastructure_MySQLMultiError) Error() string {
+func (m Infrastructure_LogMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1808,11 +2134,11 @@ func (m Infrastructure_MySQLMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Infrastructure_MySQLMultiError) AllErrors() []error { return m }
+func (m Infrastructure_LogMultiError) AllErrors() []error { return m }
 
-// Infrastructure_MySQLValidationError is the validation error returned by
-// Infrastructure_MySQL.Validate if the designated constraints aren't met.
-type Infrastructure_MySQLValidationError struct {
+// Infrastructure_LogValidationError is the validation error returned by
+// Infrastructure_Log.Validate if the designated constraints aren't met.
+type Infrastructure_LogValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1820,24 +2146,24 @@ type Infrastructure_MySQLValidationError struct {
 }
 
 // Field function returns field value.
-func (e Infrastructure_MySQLValidationError) Field() string { return e.field }
+func (e Infrastructure_LogValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Infrastructure_MySQLValidationError) Reason() string { return e.reason }
+func (e Infrastructure_LogValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Infrastructure_MySQLValidationError) Cause() error { return e.cause }
+func (e Infrastructure_LogValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Infrastructure_MySQLValidationError) Key() bool { return e.key }
+func (e Infrastructure_LogValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Infrastructure_MySQLValidationError) ErrorName() string {
-	return "Infrastructure_MySQLValidationError"
+func (e Infrastructure_LogValidationError) ErrorName() string {
+	return "Infrastructure_LogValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Infrastructure_MySQLValidationError) Error() string {
+func (e Infrastructure_LogValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1849,14 +2175,14 @@ func (e Infrastructure_MySQLValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInfrastructure_MySQL.%s: %s%s",
+		"invalid %sInfrastructure_Log.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Infrastructure_MySQLValidationError{}
+var _ error = Infrastructure_LogValidationError{}
 
 var _ interface {
 	Field() string
@@ -1864,24 +2190,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Infrastructure_MySQLValidationError{}
+} = Infrastructure_LogValidationError{}
 
-// Validate checks the field values on Infrastructure_Redis with the rules
+// Validate checks the field values on Infrastructure_MySQL with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_Redis) Validate() error {
+func (m *Infrastructure_MySQL) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_Redis with the rules
+// ValidateAll checks the field values on Infrastructure_MySQL with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// Infrastructure_RedisMultiError, or nil if none found.
-func (m *Infrastructure_Redis) ValidateAll() error {
+// Infrastructure_MySQLMultiError, or nil if none found.
+func (m *Infrastructure_MySQL) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_Redis) validate(all bool) error {
+func (m *Infrastructure_MySQL) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1890,35 +2216,283 @@ func (m *Infrastructure_Redis) validate(all bool) error {
 
 	// no validation rules for Enable
 
-	// no validation rules for Username
-
-	// no validation rules for Password
-
-	// no validation rules for Db
+	// no validation rules for Dsn
 
 	if all {
-		switch v := interface{}(m.GetDialTimeout()).(type) {
+		switch v := interface{}(m.GetSlowThreshold()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_RedisValidationError{
-					field:  "DialTimeout",
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "SlowThreshold",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_RedisValidationError{
-					field:  "DialTimeout",
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "SlowThreshold",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetDialTimeout()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetSlowThreshold()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Infrastructure_RedisValidationError{
-				field:  "DialTimeout",
+			return Infrastructure_MySQLValidationError{
+				field:  "SlowThreshold",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LoggerEnable
+
+	// no validation rules for LoggerColorful
+
+	// no validation rules for LoggerLevel
+
+	// no validation rules for ConnMaxActive
+
+	if all {
+		switch v := interface{}(m.GetConnMaxLifetime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "ConnMaxLifetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "ConnMaxLifetime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnMaxLifetime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_MySQLValidationError{
+				field:  "ConnMaxLifetime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ConnMaxIdle
+
+	if all {
+		switch v := interface{}(m.GetConnMaxIdleTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "ConnMaxIdleTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "ConnMaxIdleTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConnMaxIdleTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_MySQLValidationError{
+				field:  "ConnMaxIdleTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReplicaPolicy
+
+	if all {
+		switch v := interface{}(m.GetReplicaHealthCheckInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "ReplicaHealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_MySQLValidationError{
+					field:  "ReplicaHealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplicaHealthCheckInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_MySQLValidationError{
+				field:  "ReplicaHealthCheckInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EnableTls
+
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for TlsServerName
+
+	// no validation rules for TlsCaPem
+
+	// no validation rules for TlsCertPem
+
+	// no validation rules for TlsKeyPem
+
+	// no validation rules for PasswordProvider
+
+	if len(errors) > 0 {
+		return Infrastructure_MySQLMultiError(errors)
+	}
+
+	return nil
+}
+
+// Infrastructure_MySQLMultiError is an error wrapping multiple validation
+// errors returned by Infrastructure_MySQL.ValidateAll() if the designated
+// constraints aren't met.
+type Infrastructure_MySQLMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Infrastructure_MySQLMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Infrastructure_MySQLMultiError) AllErrors() []error { return m }
+
+// Infrastructure_MySQLValidationError is the validation error returned by
+// Infrastructure_MySQL.Validate if the designated constraints aren't met.
+type Infrastructure_MySQLValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Infrastructure_MySQLValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Infrastructure_MySQLValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Infrastructure_MySQLValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Infrastructure_MySQLValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Infrastructure_MySQLValidationError) ErrorName() string {
+	return "Infrastructure_MySQLValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Infrastructure_MySQLValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInfrastructure_MySQL.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Infrastructure_MySQLValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Infrastructure_MySQLValidationError{}
+
+// Validate checks the field values on Infrastructure_Redis with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Infrastructure_Redis) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Infrastructure_Redis with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Infrastructure_RedisMultiError, or nil if none found.
+func (m *Infrastructure_Redis) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Infrastructure_Redis) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for Db
+
+	if all {
+		switch v := interface{}(m.GetDialTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_RedisValidationError{
+					field:  "DialTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_RedisValidationError{
+					field:  "DialTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDialTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_RedisValidationError{
+				field:  "DialTimeout",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -2047,6 +2621,28 @@ func (m *Infrastructure_Redis) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Mode
+
+	// no validation rules for MasterName
+
+	// no validation rules for SentinelUsername
+
+	// no validation rules for SentinelPassword
+
+	// no validation rules for ReadFrom
+
+	// no validation rules for EnableTls
+
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for TlsServerName
+
+	// no validation rules for TlsCaPem
+
+	// no validation rules for TlsCertPem
+
+	// no validation rules for TlsKeyPem
+
 	if len(errors) > 0 {
 		return Infrastructure_RedisMultiError(errors)
 	}
@@ -2250,12 +2846,57 @@ func (m *Infrastructure_PSQL) validate(all bool) error {
 		}
 	}
 
-	if len(errors) > 0 {
-		return Infrastructure_PSQLMultiError(errors)
-	}
-
-	return nil
-}
+	// no validation rules for ReplicaPolicy
+
+	if all {
+		switch v := interface{}(m.GetReplicaHealthCheckInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_PSQLValidationError{
+					field:  "ReplicaHealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_PSQLValidationError{
+					field:  "ReplicaHealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplicaHealthCheckInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_PSQLValidationError{
+				field:  "ReplicaHealthCheckInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for EnableTls
+
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for TlsServerName
+
+	// no validation rules for TlsCaPem
+
+	// no validation rules for TlsCertPem
+
+	// no validation rules for TlsKeyPem
+
+	// no validation rules for PasswordProvider
+
+	if len(errors) > 0 {
+		return Infrastructure_PSQLMultiError(errors)
+	}
+
+	return nil
+}
 
 // Infrastructure_PSQLMultiError is an error wrapping multiple validation
 // errors returned by Infrastructure_PSQL.ValidateAll() if the designated
@@ -2413,6 +3054,68 @@ func (m *Infrastructure_Consul) validate(all bool) error {
 
 	// no validation rules for TlsKeyPem
 
+	// no validation rules for RegistryHealthCheck
+
+	// no validation rules for RegistryHealthCheckPath
+
+	if all {
+		switch v := interface{}(m.GetRegistryHealthCheckInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_ConsulValidationError{
+					field:  "RegistryHealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_ConsulValidationError{
+					field:  "RegistryHealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistryHealthCheckInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_ConsulValidationError{
+				field:  "RegistryHealthCheckInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRegistryDeregisterCriticalAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_ConsulValidationError{
+					field:  "RegistryDeregisterCriticalAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_ConsulValidationError{
+					field:  "RegistryDeregisterCriticalAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistryDeregisterCriticalAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_ConsulValidationError{
+				field:  "RegistryDeregisterCriticalAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return Infrastructure_ConsulMultiError(errors)
 	}
@@ -2493,22 +3196,22 @@ var _ interface {
 	ErrorName() string
 } = Infrastructure_ConsulValidationError{}
 
-// Validate checks the field values on Infrastructure_Jaeger with the rules
+// Validate checks the field values on Infrastructure_Etcd with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_Jaeger) Validate() error {
+func (m *Infrastructure_Etcd) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_Jaeger with the rules
+// ValidateAll checks the field values on Infrastructure_Etcd with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// Infrastructure_JaegerMultiError, or nil if none found.
-func (m *Infrastructure_Jaeger) ValidateAll() error {
+// Infrastructure_EtcdMultiError, or nil if none found.
+func (m *Infrastructure_Etcd) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_Jaeger) validate(all bool) error {
+func (m *Infrastructure_Etcd) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2517,28 +3220,94 @@ func (m *Infrastructure_Jaeger) validate(all bool) error {
 
 	// no validation rules for Enable
 
-	// no validation rules for Endpoint
-
-	// no validation rules for WithHttpBasicAuth
-
 	// no validation rules for Username
 
 	// no validation rules for Password
 
+	if all {
+		switch v := interface{}(m.GetDialTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_EtcdValidationError{
+					field:  "DialTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_EtcdValidationError{
+					field:  "DialTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDialTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_EtcdValidationError{
+				field:  "DialTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for TlsCaPem
+
+	// no validation rules for TlsCertPem
+
+	// no validation rules for TlsKeyPem
+
+	// no validation rules for RegistryNamespace
+
+	if all {
+		switch v := interface{}(m.GetRegistryTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_EtcdValidationError{
+					field:  "RegistryTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_EtcdValidationError{
+					field:  "RegistryTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRegistryTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_EtcdValidationError{
+				field:  "RegistryTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RegistryMaxRetry
+
 	if len(errors) > 0 {
-		return Infrastructure_JaegerMultiError(errors)
+		return Infrastructure_EtcdMultiError(errors)
 	}
 
 	return nil
 }
 
-// Infrastructure_JaegerMultiError is an error wrapping multiple validation
-// errors returned by Infrastructure_Jaeger.ValidateAll() if the designated
+// Infrastructure_EtcdMultiError is an error wrapping multiple validation
+// errors returned by Infrastructure_Etcd.ValidateAll() if the designated
 // constraints aren't met.
-type Infrastructure_JaegerMultiError []error
+type Infrastructure_EtcdMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Infrastructure_JaegerMultiError) Error() string {
+func (m Infrastructure_EtcdMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2547,11 +3316,11 @@ func (m Infrastructure_JaegerMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Infrastructure_JaegerMultiError) AllErrors() []error { return m }
+func (m Infrastructure_EtcdMultiError) AllErrors() []error { return m }
 
-// Infrastructure_JaegerValidationError is the validation error returned by
-// Infrastructure_Jaeger.Validate if the designated constraints aren't met.
-type Infrastructure_JaegerValidationError struct {
+// Infrastructure_EtcdValidationError is the validation error returned by
+// Infrastructure_Etcd.Validate if the designated constraints aren't met.
+type Infrastructure_EtcdValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2559,24 +3328,24 @@ type Infrastructure_JaegerValidationError struct {
 }
 
 // Field function returns field value.
-func (e Infrastructure_JaegerValidationError) Field() string { return e.field }
+func (e Infrastructure_EtcdValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Infrastructure_JaegerValidationError) Reason() string { return e.reason }
+func (e Infrastructure_EtcdValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Infrastructure_JaegerValidationError) Cause() error { return e.cause }
+func (e Infrastructure_EtcdValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Infrastructure_JaegerValidationError) Key() bool { return e.key }
+func (e Infrastructure_EtcdValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Infrastructure_JaegerValidationError) ErrorName() string {
-	return "Infrastructure_JaegerValidationError"
+func (e Infrastructure_EtcdValidationError) ErrorName() string {
+	return "Infrastructure_EtcdValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Infrastructure_JaegerValidationError) Error() string {
+func (e Infrastructure_EtcdValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2588,14 +3357,14 @@ func (e Infrastructure_JaegerValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInfrastructure_Jaeger.%s: %s%s",
+		"invalid %sInfrastructure_Etcd.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Infrastructure_JaegerValidationError{}
+var _ error = Infrastructure_EtcdValidationError{}
 
 var _ interface {
 	Field() string
@@ -2603,24 +3372,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Infrastructure_JaegerValidationError{}
+} = Infrastructure_EtcdValidationError{}
 
-// Validate checks the field values on Infrastructure_Rabbitmq with the rules
+// Validate checks the field values on Infrastructure_Jaeger with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_Rabbitmq) Validate() error {
+func (m *Infrastructure_Jaeger) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_Rabbitmq with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on Infrastructure_Jaeger with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// Infrastructure_RabbitmqMultiError, or nil if none found.
-func (m *Infrastructure_Rabbitmq) ValidateAll() error {
+// Infrastructure_JaegerMultiError, or nil if none found.
+func (m *Infrastructure_Jaeger) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_Rabbitmq) validate(all bool) error {
+func (m *Infrastructure_Jaeger) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2629,30 +3398,28 @@ func (m *Infrastructure_Rabbitmq) validate(all bool) error {
 
 	// no validation rules for Enable
 
-	// no validation rules for Url
-
-	// no validation rules for TlsAddress
+	// no validation rules for Endpoint
 
-	// no validation rules for TlsCaPem
+	// no validation rules for WithHttpBasicAuth
 
-	// no validation rules for TlsCertPem
+	// no validation rules for Username
 
-	// no validation rules for TlsKeyPem
+	// no validation rules for Password
 
 	if len(errors) > 0 {
-		return Infrastructure_RabbitmqMultiError(errors)
+		return Infrastructure_JaegerMultiError(errors)
 	}
 
 	return nil
 }
 
-// Infrastructure_RabbitmqMultiError is an error wrapping multiple validation
-// errors returned by Infrastructure_Rabbitmq.ValidateAll() if the designated
+// Infrastructure_JaegerMultiError is an error wrapping multiple validation
+// errors returned by Infrastructure_Jaeger.ValidateAll() if the designated
 // constraints aren't met.
-type Infrastructure_RabbitmqMultiError []error
+type Infrastructure_JaegerMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Infrastructure_RabbitmqMultiError) Error() string {
+func (m Infrastructure_JaegerMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2661,11 +3428,11 @@ func (m Infrastructure_RabbitmqMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Infrastructure_RabbitmqMultiError) AllErrors() []error { return m }
+func (m Infrastructure_JaegerMultiError) AllErrors() []error { return m }
 
-// Infrastructure_RabbitmqValidationError is the validation error returned by
-// Infrastructure_Rabbitmq.Validate if the designated constraints aren't met.
-type Infrastructure_RabbitmqValidationError struct {
+// Infrastructure_JaegerValidationError is the validation error returned by
+// Infrastructure_Jaeger.Validate if the designated constraints aren't met.
+type Infrastructure_JaegerValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2673,24 +3440,24 @@ type Infrastructure_RabbitmqValidationError struct {
 }
 
 // Field function returns field value.
-func (e Infrastructure_RabbitmqValidationError) Field() string { return e.field }
+func (e Infrastructure_JaegerValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Infrastructure_RabbitmqValidationError) Reason() string { return e.reason }
+func (e Infrastructure_JaegerValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Infrastructure_RabbitmqValidationError) Cause() error { return e.cause }
+func (e Infrastructure_JaegerValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Infrastructure_RabbitmqValidationError) Key() bool { return e.key }
+func (e Infrastructure_JaegerValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Infrastructure_RabbitmqValidationError) ErrorName() string {
-	return "Infrastructure_RabbitmqValidationError"
+func (e Infrastructure_JaegerValidationError) ErrorName() string {
+	return "Infrastructure_JaegerValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Infrastructure_RabbitmqValidationError) Error() string {
+func (e Infrastructure_JaegerValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2702,14 +3469,14 @@ func (e Infrastructure_RabbitmqValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInfrastructure_Rabbitmq.%s: %s%s",
+		"invalid %sInfrastructure_Jaeger.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Infrastructure_RabbitmqValidationError{}
+var _ error = Infrastructure_JaegerValidationError{}
 
 var _ interface {
 	Field() string
@@ -2717,24 +3484,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Infrastructure_RabbitmqValidationError{}
+} = Infrastructure_JaegerValidationError{}
 
-// Validate checks the field values on Infrastructure_Snowflake with the rules
+// Validate checks the field values on Infrastructure_Otlp with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_Snowflake) Validate() error {
+func (m *Infrastructure_Otlp) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_Snowflake with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on Infrastructure_Otlp with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// Infrastructure_SnowflakeMultiError, or nil if none found.
-func (m *Infrastructure_Snowflake) ValidateAll() error {
+// Infrastructure_OtlpMultiError, or nil if none found.
+func (m *Infrastructure_Otlp) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_Snowflake) validate(all bool) error {
+func (m *Infrastructure_Otlp) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2745,28 +3512,75 @@ func (m *Infrastructure_Snowflake) validate(all bool) error {
 
 	// no validation rules for Endpoint
 
-	// no validation rules for WithDiscovery
+	// no validation rules for Protocol
 
-	// no validation rules for WithHttpBasicAuth
+	// no validation rules for UrlPath
 
-	// no validation rules for Username
+	// no validation rules for Headers
 
-	// no validation rules for Password
+	// no validation rules for Insecure
 
-	if len(errors) > 0 {
-		return Infrastructure_SnowflakeMultiError(errors)
-	}
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for TlsCaPem
+
+	// no validation rules for TlsCertPem
+
+	// no validation rules for TlsKeyPem
+
+	// no validation rules for Compression
+
+	if all {
+		switch v := interface{}(m.GetTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_OtlpValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_OtlpValidationError{
+					field:  "Timeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_OtlpValidationError{
+				field:  "Timeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Sampler
+
+	// no validation rules for ResourceAttributes
+
+	if m.SamplerRatio != nil {
+		// no validation rules for SamplerRatio
+	}
+
+	if len(errors) > 0 {
+		return Infrastructure_OtlpMultiError(errors)
+	}
 
 	return nil
 }
 
-// Infrastructure_SnowflakeMultiError is an error wrapping multiple validation
-// errors returned by Infrastructure_Snowflake.ValidateAll() if the designated
+// Infrastructure_OtlpMultiError is an error wrapping multiple validation
+// errors returned by Infrastructure_Otlp.ValidateAll() if the designated
 // constraints aren't met.
-type Infrastructure_SnowflakeMultiError []error
+type Infrastructure_OtlpMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Infrastructure_SnowflakeMultiError) Error() string {
+func (m Infrastructure_OtlpMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2775,11 +3589,11 @@ func (m Infrastructure_SnowflakeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Infrastructure_SnowflakeMultiError) AllErrors() []error { return m }
+func (m Infrastructure_OtlpMultiError) AllErrors() []error { return m }
 
-// Infrastructure_SnowflakeValidationError is the validation error returned by
-// Infrastructure_Snowflake.Validate if the designated constraints aren't met.
-type Infrastructure_SnowflakeValidationError struct {
+// Infrastructure_OtlpValidationError is the validation error returned by
+// Infrastructure_Otlp.Validate if the designated constraints aren't met.
+type Infrastructure_OtlpValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2787,24 +3601,24 @@ type Infrastructure_SnowflakeValidationError struct {
 }
 
 // Field function returns field value.
-func (e Infrastructure_SnowflakeValidationError) Field() string { return e.field }
+func (e Infrastructure_OtlpValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Infrastructure_SnowflakeValidationError) Reason() string { return e.reason }
+func (e Infrastructure_OtlpValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Infrastructure_SnowflakeValidationError) Cause() error { return e.cause }
+func (e Infrastructure_OtlpValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Infrastructure_SnowflakeValidationError) Key() bool { return e.key }
+func (e Infrastructure_OtlpValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Infrastructure_SnowflakeValidationError) ErrorName() string {
-	return "Infrastructure_SnowflakeValidationError"
+func (e Infrastructure_OtlpValidationError) ErrorName() string {
+	return "Infrastructure_OtlpValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Infrastructure_SnowflakeValidationError) Error() string {
+func (e Infrastructure_OtlpValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2816,14 +3630,14 @@ func (e Infrastructure_SnowflakeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInfrastructure_Snowflake.%s: %s%s",
+		"invalid %sInfrastructure_Otlp.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Infrastructure_SnowflakeValidationError{}
+var _ error = Infrastructure_OtlpValidationError{}
 
 var _ interface {
 	Field() string
@@ -2831,48 +3645,893 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Infrastructure_SnowflakeValidationError{}
+} = Infrastructure_OtlpValidationError{}
 
-// Validate checks the field values on Infrastructure_Log_Console with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on Infrastructure_Rabbitmq with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_Log_Console) Validate() error {
+func (m *Infrastructure_Rabbitmq) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_Log_Console with the
+// ValidateAll checks the field values on Infrastructure_Rabbitmq with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// Infrastructure_Log_ConsoleMultiError, or nil if none found.
-func (m *Infrastructure_Log_Console) ValidateAll() error {
+// Infrastructure_RabbitmqMultiError, or nil if none found.
+func (m *Infrastructure_Rabbitmq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Infrastructure_Rabbitmq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	// no validation rules for Url
+
+	// no validation rules for TlsAddress
+
+	// no validation rules for TlsCaPem
+
+	// no validation rules for TlsCertPem
+
+	// no validation rules for TlsKeyPem
+
+	// no validation rules for ChannelPoolSize
+
+	// no validation rules for EnablePublisherConfirms
+
+	if all {
+		switch v := interface{}(m.GetReconnectInitialBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ReconnectInitialBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ReconnectInitialBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReconnectInitialBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_RabbitmqValidationError{
+				field:  "ReconnectInitialBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReconnectMaxBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ReconnectMaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ReconnectMaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReconnectMaxBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_RabbitmqValidationError{
+				field:  "ReconnectMaxBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InsecureSkipVerify
+
+	// no validation rules for ConsumerConcurrency
+
+	// no validation rules for ConsumerMaxAttempts
+
+	if all {
+		switch v := interface{}(m.GetConsumerRetryInitialBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ConsumerRetryInitialBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ConsumerRetryInitialBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsumerRetryInitialBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_RabbitmqValidationError{
+				field:  "ConsumerRetryInitialBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsumerRetryMaxBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ConsumerRetryMaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ConsumerRetryMaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsumerRetryMaxBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_RabbitmqValidationError{
+				field:  "ConsumerRetryMaxBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConsumerDrainTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ConsumerDrainTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_RabbitmqValidationError{
+					field:  "ConsumerDrainTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConsumerDrainTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_RabbitmqValidationError{
+				field:  "ConsumerDrainTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Infrastructure_RabbitmqMultiError(errors)
+	}
+
+	return nil
+}
+
+// Infrastructure_RabbitmqMultiError is an error wrapping multiple validation
+// errors returned by Infrastructure_Rabbitmq.ValidateAll() if the designated
+// constraints aren't met.
+type Infrastructure_RabbitmqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Infrastructure_RabbitmqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Infrastructure_RabbitmqMultiError) AllErrors() []error { return m }
+
+// Infrastructure_RabbitmqValidationError is the validation error returned by
+// Infrastructure_Rabbitmq.Validate if the designated constraints aren't met.
+type Infrastructure_RabbitmqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Infrastructure_RabbitmqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Infrastructure_RabbitmqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Infrastructure_RabbitmqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Infrastructure_RabbitmqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Infrastructure_RabbitmqValidationError) ErrorName() string {
+	return "Infrastructure_RabbitmqValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Infrastructure_RabbitmqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInfrastructure_Rabbitmq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Infrastructure_RabbitmqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Infrastructure_RabbitmqValidationError{}
+
+// Validate checks the field values on Infrastructure_Snowflake with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Infrastructure_Snowflake) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Infrastructure_Snowflake with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Infrastructure_SnowflakeMultiError, or nil if none found.
+func (m *Infrastructure_Snowflake) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Infrastructure_Snowflake) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	// no validation rules for Endpoint
+
+	// no validation rules for WithDiscovery
+
+	// no validation rules for WithHttpBasicAuth
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	if all {
+		switch v := interface{}(m.GetLeaseTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_SnowflakeValidationError{
+					field:  "LeaseTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_SnowflakeValidationError{
+					field:  "LeaseTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaseTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_SnowflakeValidationError{
+				field:  "LeaseTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRenewInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_SnowflakeValidationError{
+					field:  "RenewInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_SnowflakeValidationError{
+					field:  "RenewInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRenewInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_SnowflakeValidationError{
+				field:  "RenewInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartupTimeout()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_SnowflakeValidationError{
+					field:  "StartupTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_SnowflakeValidationError{
+					field:  "StartupTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartupTimeout()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_SnowflakeValidationError{
+				field:  "StartupTimeout",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Infrastructure_SnowflakeMultiError(errors)
+	}
+
+	return nil
+}
+
+// Infrastructure_SnowflakeMultiError is an error wrapping multiple validation
+// errors returned by Infrastructure_Snowflake.ValidateAll() if the designated
+// constraints aren't met.
+type Infrastructure_SnowflakeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Infrastructure_SnowflakeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Infrastructure_SnowflakeMultiError) AllErrors() []error { return m }
+
+// Infrastructure_SnowflakeValidationError is the validation error returned by
+// Infrastructure_Snowflake.Validate if the designated constraints aren't met.
+type Infrastructure_SnowflakeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Infrastructure_SnowflakeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Infrastructure_SnowflakeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Infrastructure_SnowflakeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Infrastructure_SnowflakeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Infrastructure_SnowflakeValidationError) ErrorName() string {
+	return "Infrastructure_SnowflakeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Infrastructure_SnowflakeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInfrastructure_Snowflake.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Infrastructure_SnowflakeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Infrastructure_SnowflakeValidationError{}
+
+// Validate checks the field values on Infrastructure_Log_Console with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Infrastructure_Log_Console) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Infrastructure_Log_Console with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Infrastructure_Log_ConsoleMultiError, or nil if none found.
+func (m *Infrastructure_Log_Console) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Infrastructure_Log_Console) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	// no validation rules for Level
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return Infrastructure_Log_ConsoleMultiError(errors)
+	}
+
+	return nil
+}
+
+// Infrastructure_Log_ConsoleMultiError is an error wrapping multiple
+// validation errors returned by Infrastructure_Log_Console.ValidateAll() if
+// the designated constraints aren't met.
+type Infrastructure_Log_ConsoleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Infrastructure_Log_ConsoleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Infrastructure_Log_ConsoleMultiError) AllErrors() []error { return m }
+
+// Infrastructure_Log_ConsoleValidationError is the validation error returned
+// by Infrastructure_Log_Console.Validate if the designated constraints aren't met.
+type Infrastructure_Log_ConsoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Infrastructure_Log_ConsoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Infrastructure_Log_ConsoleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Infrastructure_Log_ConsoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Infrastructure_Log_ConsoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Infrastructure_Log_ConsoleValidationError) ErrorName() string {
+	return "Infrastructure_Log_ConsoleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Infrastructure_Log_ConsoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInfrastructure_Log_Console.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Infrastructure_Log_ConsoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Infrastructure_Log_ConsoleValidationError{}
+
+// Validate checks the field values on Infrastructure_Log_File with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Infrastructure_Log_File) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Infrastructure_Log_File with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Infrastructure_Log_FileMultiError, or nil if none found.
+func (m *Infrastructure_Log_File) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Infrastructure_Log_File) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enable
+
+	// no validation rules for Level
+
+	// no validation rules for Dir
+
+	// no validation rules for Filename
+
+	if all {
+		switch v := interface{}(m.GetRotateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_Log_FileValidationError{
+					field:  "RotateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_Log_FileValidationError{
+					field:  "RotateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRotateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_Log_FileValidationError{
+				field:  "RotateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RotateSize
+
+	if all {
+		switch v := interface{}(m.GetStorageAge()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Infrastructure_Log_FileValidationError{
+					field:  "StorageAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Infrastructure_Log_FileValidationError{
+					field:  "StorageAge",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageAge()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Infrastructure_Log_FileValidationError{
+				field:  "StorageAge",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for StorageCounter
+
+	if len(errors) > 0 {
+		return Infrastructure_Log_FileMultiError(errors)
+	}
+
+	return nil
+}
+
+// Infrastructure_Log_FileMultiError is an error wrapping multiple validation
+// errors returned by Infrastructure_Log_File.ValidateAll() if the designated
+// constraints aren't met.
+type Infrastructure_Log_FileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Infrastructure_Log_FileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Infrastructure_Log_FileMultiError) AllErrors() []error { return m }
+
+// Infrastructure_Log_FileValidationError is the validation error returned by
+// Infrastructure_Log_File.Validate if the designated constraints aren't met.
+type Infrastructure_Log_FileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Infrastructure_Log_FileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Infrastructure_Log_FileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Infrastructure_Log_FileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Infrastructure_Log_FileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Infrastructure_Log_FileValidationError) ErrorName() string {
+	return "Infrastructure_Log_FileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Infrastructure_Log_FileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInfrastructure_Log_File.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Infrastructure_Log_FileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Infrastructure_Log_FileValidationError{}
+
+// Validate checks the field values on Setting_Captcha with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Setting_Captcha) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Setting_Captcha with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Setting_CaptchaMultiError, or nil if none found.
+func (m *Setting_Captcha) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_Log_Console) validate(all bool) error {
+func (m *Setting_Captcha) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Enable
+	// no validation rules for CaptchaLen
 
-	// no validation rules for Level
+	if all {
+		switch v := interface{}(m.GetCaptchaTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_CaptchaValidationError{
+					field:  "CaptchaTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_CaptchaValidationError{
+					field:  "CaptchaTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCaptchaTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_CaptchaValidationError{
+				field:  "CaptchaTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxVerifyAttempts
+
+	if all {
+		switch v := interface{}(m.GetResendInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_CaptchaValidationError{
+					field:  "ResendInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_CaptchaValidationError{
+					field:  "ResendInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResendInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_CaptchaValidationError{
+				field:  "ResendInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ImageWidth
+
+	// no validation rules for ImageHeight
+
+	// no validation rules for ImageSendLimit
+
+	// no validation rules for TokenSecret
+
+	if all {
+		switch v := interface{}(m.GetTokenTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_CaptchaValidationError{
+					field:  "TokenTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_CaptchaValidationError{
+					field:  "TokenTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTokenTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_CaptchaValidationError{
+				field:  "TokenTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return Infrastructure_Log_ConsoleMultiError(errors)
+		return Setting_CaptchaMultiError(errors)
 	}
 
 	return nil
 }
 
-// Infrastructure_Log_ConsoleMultiError is an error wrapping multiple
-// validation errors returned by Infrastructure_Log_Console.ValidateAll() if
-// the designated constraints aren't met.
-type Infrastructure_Log_ConsoleMultiError []error
+// Setting_CaptchaMultiError is an error wrapping multiple validation errors
+// returned by Setting_Captcha.ValidateAll() if the designated constraints
+// aren't met.
+type Setting_CaptchaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Infrastructure_Log_ConsoleMultiError) Error() string {
+func (m Setting_CaptchaMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2881,11 +4540,11 @@ func (m Infrastructure_Log_ConsoleMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Infrastructure_Log_ConsoleMultiError) AllErrors() []error { return m }
+func (m Setting_CaptchaMultiError) AllErrors() []error { return m }
 
-// Infrastructure_Log_ConsoleValidationError is the validation error returned
-// by Infrastructure_Log_Console.Validate if the designated constraints aren't met.
-type Infrastructure_Log_ConsoleValidationError struct {
+// Setting_CaptchaValidationError is the validation error returned by
+// Setting_Captcha.Validate if the designated constraints aren't met.
+type Setting_CaptchaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2893,24 +4552,22 @@ type Infrastructure_Log_ConsoleValidationError struct {
 }
 
 // Field function returns field value.
-func (e Infrastructure_Log_ConsoleValidationError) Field() string { return e.field }
+func (e Setting_CaptchaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Infrastructure_Log_ConsoleValidationError) Reason() string { return e.reason }
+func (e Setting_CaptchaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Infrastructure_Log_ConsoleValidationError) Cause() error { return e.cause }
+func (e Setting_CaptchaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Infrastructure_Log_ConsoleValidationError) Key() bool { return e.key }
+func (e Setting_CaptchaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Infrastructure_Log_ConsoleValidationError) ErrorName() string {
-	return "Infrastructure_Log_ConsoleValidationError"
-}
+func (e Setting_CaptchaValidationError) ErrorName() string { return "Setting_CaptchaValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Infrastructure_Log_ConsoleValidationError) Error() string {
+func (e Setting_CaptchaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2922,14 +4579,14 @@ func (e Infrastructure_Log_ConsoleValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInfrastructure_Log_Console.%s: %s%s",
+		"invalid %sSetting_Captcha.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Infrastructure_Log_ConsoleValidationError{}
+var _ error = Setting_CaptchaValidationError{}
 
 var _ interface {
 	Field() string
@@ -2937,114 +4594,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Infrastructure_Log_ConsoleValidationError{}
+} = Setting_CaptchaValidationError{}
 
-// Validate checks the field values on Infrastructure_Log_File with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *Infrastructure_Log_File) Validate() error {
+// Validate checks the field values on Setting_Login with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Setting_Login) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Infrastructure_Log_File with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// Infrastructure_Log_FileMultiError, or nil if none found.
-func (m *Infrastructure_Log_File) ValidateAll() error {
+// ValidateAll checks the field values on Setting_Login with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Setting_LoginMultiError, or
+// nil if none found.
+func (m *Setting_Login) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Infrastructure_Log_File) validate(all bool) error {
+func (m *Setting_Login) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Enable
-
-	// no validation rules for Level
-
-	// no validation rules for Dir
-
-	// no validation rules for Filename
+	// no validation rules for PasswordErrSerialTimes
 
 	if all {
-		switch v := interface{}(m.GetRotateTime()).(type) {
+		switch v := interface{}(m.GetPasswordErrSerialDuration()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_Log_FileValidationError{
-					field:  "RotateTime",
+				errors = append(errors, Setting_LoginValidationError{
+					field:  "PasswordErrSerialDuration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_Log_FileValidationError{
-					field:  "RotateTime",
+				errors = append(errors, Setting_LoginValidationError{
+					field:  "PasswordErrSerialDuration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRotateTime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPasswordErrSerialDuration()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Infrastructure_Log_FileValidationError{
-				field:  "RotateTime",
+			return Setting_LoginValidationError{
+				field:  "PasswordErrSerialDuration",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for RotateSize
-
 	if all {
-		switch v := interface{}(m.GetStorageAge()).(type) {
+		switch v := interface{}(m.GetPasswordErrLockDuration()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Infrastructure_Log_FileValidationError{
-					field:  "StorageAge",
+				errors = append(errors, Setting_LoginValidationError{
+					field:  "PasswordErrLockDuration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Infrastructure_Log_FileValidationError{
-					field:  "StorageAge",
+				errors = append(errors, Setting_LoginValidationError{
+					field:  "PasswordErrLockDuration",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStorageAge()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPasswordErrLockDuration()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Infrastructure_Log_FileValidationError{
-				field:  "StorageAge",
+			return Setting_LoginValidationError{
+				field:  "PasswordErrLockDuration",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for StorageCounter
+	// no validation rules for PasswordErrDailyLimitTimes
+
+	// no validation rules for Timezone
 
 	if len(errors) > 0 {
-		return Infrastructure_Log_FileMultiError(errors)
+		return Setting_LoginMultiError(errors)
 	}
 
 	return nil
 }
 
-// Infrastructure_Log_FileMultiError is an error wrapping multiple validation
-// errors returned by Infrastructure_Log_File.ValidateAll() if the designated
-// constraints aren't met.
-type Infrastructure_Log_FileMultiError []error
+// Setting_LoginMultiError is an error wrapping multiple validation errors
+// returned by Setting_Login.ValidateAll() if the designated constraints
+// aren't met.
+type Setting_LoginMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Infrastructure_Log_FileMultiError) Error() string {
+func (m Setting_LoginMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3053,11 +4704,11 @@ func (m Infrastructure_Log_FileMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Infrastructure_Log_FileMultiError) AllErrors() []error { return m }
+func (m Setting_LoginMultiError) AllErrors() []error { return m }
 
-// Infrastructure_Log_FileValidationError is the validation error returned by
-// Infrastructure_Log_File.Validate if the designated constraints aren't met.
-type Infrastructure_Log_FileValidationError struct {
+// Setting_LoginValidationError is the validation error returned by
+// Setting_Login.Validate if the designated constraints aren't met.
+type Setting_LoginValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3065,24 +4716,22 @@ type Infrastructure_Log_FileValidationError struct {
 }
 
 // Field function returns field value.
-func (e Infrastructure_Log_FileValidationError) Field() string { return e.field }
+func (e Setting_LoginValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Infrastructure_Log_FileValidationError) Reason() string { return e.reason }
+func (e Setting_LoginValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Infrastructure_Log_FileValidationError) Cause() error { return e.cause }
+func (e Setting_LoginValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Infrastructure_Log_FileValidationError) Key() bool { return e.key }
+func (e Setting_LoginValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Infrastructure_Log_FileValidationError) ErrorName() string {
-	return "Infrastructure_Log_FileValidationError"
-}
+func (e Setting_LoginValidationError) ErrorName() string { return "Setting_LoginValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Infrastructure_Log_FileValidationError) Error() string {
+func (e Setting_LoginValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3094,14 +4743,14 @@ func (e Infrastructure_Log_FileValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInfrastructure_Log_File.%s: %s%s",
+		"invalid %sSetting_Login.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Infrastructure_Log_FileValidationError{}
+var _ error = Setting_LoginValidationError{}
 
 var _ interface {
 	Field() string
@@ -3109,55 +4758,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Infrastructure_Log_FileValidationError{}
+} = Setting_LoginValidationError{}
 
-// Validate checks the field values on Setting_Captcha with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *Setting_Captcha) Validate() error {
+// Validate checks the field values on Setting_Lock with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Setting_Lock) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Setting_Captcha with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// Setting_CaptchaMultiError, or nil if none found.
-func (m *Setting_Captcha) ValidateAll() error {
+// ValidateAll checks the field values on Setting_Lock with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Setting_LockMultiError, or
+// nil if none found.
+func (m *Setting_Lock) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Setting_Captcha) validate(all bool) error {
+func (m *Setting_Lock) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
-	var errors []error
-
-	// no validation rules for CaptchaLen
-
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_LockValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_LockValidationError{
+					field:  "Ttl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_LockValidationError{
+				field:  "Ttl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if all {
-		switch v := interface{}(m.GetCaptchaTtl()).(type) {
+		switch v := interface{}(m.GetFenceTtl()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Setting_CaptchaValidationError{
-					field:  "CaptchaTtl",
+				errors = append(errors, Setting_LockValidationError{
+					field:  "FenceTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Setting_CaptchaValidationError{
-					field:  "CaptchaTtl",
+				errors = append(errors, Setting_LockValidationError{
+					field:  "FenceTtl",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCaptchaTtl()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetFenceTtl()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Setting_CaptchaValidationError{
-				field:  "CaptchaTtl",
+			return Setting_LockValidationError{
+				field:  "FenceTtl",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -3165,19 +4841,18 @@ func (m *Setting_Captcha) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return Setting_CaptchaMultiError(errors)
+		return Setting_LockMultiError(errors)
 	}
 
 	return nil
 }
 
-// Setting_CaptchaMultiError is an error wrapping multiple validation errors
-// returned by Setting_Captcha.ValidateAll() if the designated constraints
-// aren't met.
-type Setting_CaptchaMultiError []error
+// Setting_LockMultiError is an error wrapping multiple validation errors
+// returned by Setting_Lock.ValidateAll() if the designated constraints aren't met.
+type Setting_LockMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Setting_CaptchaMultiError) Error() string {
+func (m Setting_LockMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3186,11 +4861,11 @@ func (m Setting_CaptchaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Setting_CaptchaMultiError) AllErrors() []error { return m }
+func (m Setting_LockMultiError) AllErrors() []error { return m }
 
-// Setting_CaptchaValidationError is the validation error returned by
-// Setting_Captcha.Validate if the designated constraints aren't met.
-type Setting_CaptchaValidationError struct {
+// Setting_LockValidationError is the validation error returned by
+// Setting_Lock.Validate if the designated constraints aren't met.
+type Setting_LockValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3198,22 +4873,22 @@ type Setting_CaptchaValidationError struct {
 }
 
 // Field function returns field value.
-func (e Setting_CaptchaValidationError) Field() string { return e.field }
+func (e Setting_LockValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Setting_CaptchaValidationError) Reason() string { return e.reason }
+func (e Setting_LockValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Setting_CaptchaValidationError) Cause() error { return e.cause }
+func (e Setting_LockValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Setting_CaptchaValidationError) Key() bool { return e.key }
+func (e Setting_LockValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Setting_CaptchaValidationError) ErrorName() string { return "Setting_CaptchaValidationError" }
+func (e Setting_LockValidationError) ErrorName() string { return "Setting_LockValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Setting_CaptchaValidationError) Error() string {
+func (e Setting_LockValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3225,14 +4900,14 @@ func (e Setting_CaptchaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetting_Captcha.%s: %s%s",
+		"invalid %sSetting_Lock.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Setting_CaptchaValidationError{}
+var _ error = Setting_LockValidationError{}
 
 var _ interface {
 	Field() string
@@ -3240,106 +4915,179 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Setting_CaptchaValidationError{}
+} = Setting_LockValidationError{}
 
-// Validate checks the field values on Setting_Login with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Setting_Login) Validate() error {
+// Validate checks the field values on Setting_RateLimit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Setting_RateLimit) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Setting_Login with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Setting_LoginMultiError, or
-// nil if none found.
-func (m *Setting_Login) ValidateAll() error {
+// ValidateAll checks the field values on Setting_RateLimit with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Setting_RateLimitMultiError, or nil if none found.
+func (m *Setting_RateLimit) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Setting_Login) validate(all bool) error {
+func (m *Setting_RateLimit) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PasswordErrSerialTimes
+	// no validation rules for Enable
 
 	if all {
-		switch v := interface{}(m.GetPasswordErrSerialDuration()).(type) {
+		switch v := interface{}(m.GetDefaultRule()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Setting_LoginValidationError{
-					field:  "PasswordErrSerialDuration",
+				errors = append(errors, Setting_RateLimitValidationError{
+					field:  "DefaultRule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Setting_LoginValidationError{
-					field:  "PasswordErrSerialDuration",
+				errors = append(errors, Setting_RateLimitValidationError{
+					field:  "DefaultRule",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPasswordErrSerialDuration()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetDefaultRule()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Setting_LoginValidationError{
-				field:  "PasswordErrSerialDuration",
+			return Setting_RateLimitValidationError{
+				field:  "DefaultRule",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	{
+		sorted_keys := make([]string, len(m.GetOperations()))
+		i := 0
+		for key := range m.GetOperations() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetOperations()[key]
+			_ = val
+
+			// no validation rules for Operations[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, Setting_RateLimitValidationError{
+							field:  fmt.Sprintf("Operations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, Setting_RateLimitValidationError{
+							field:  fmt.Sprintf("Operations[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return Setting_RateLimitValidationError{
+						field:  fmt.Sprintf("Operations[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
 	if all {
-		switch v := interface{}(m.GetPasswordErrLockDuration()).(type) {
+		switch v := interface{}(m.GetRedisBreakerBackoff()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Setting_LoginValidationError{
-					field:  "PasswordErrLockDuration",
+				errors = append(errors, Setting_RateLimitValidationError{
+					field:  "RedisBreakerBackoff",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Setting_LoginValidationError{
-					field:  "PasswordErrLockDuration",
+				errors = append(errors, Setting_RateLimitValidationError{
+					field:  "RedisBreakerBackoff",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPasswordErrLockDuration()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRedisBreakerBackoff()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Setting_LoginValidationError{
-				field:  "PasswordErrLockDuration",
+			return Setting_RateLimitValidationError{
+				field:  "RedisBreakerBackoff",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for PasswordErrDailyLimitTimes
+	if all {
+		switch v := interface{}(m.GetRedisBreakerMaxBackoff()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_RateLimitValidationError{
+					field:  "RedisBreakerMaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_RateLimitValidationError{
+					field:  "RedisBreakerMaxBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRedisBreakerMaxBackoff()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_RateLimitValidationError{
+				field:  "RedisBreakerMaxBackoff",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return Setting_LoginMultiError(errors)
+		return Setting_RateLimitMultiError(errors)
 	}
 
 	return nil
 }
 
-// Setting_LoginMultiError is an error wrapping multiple validation errors
-// returned by Setting_Login.ValidateAll() if the designated constraints
+// Setting_RateLimitMultiError is an error wrapping multiple validation errors
+// returned by Setting_RateLimit.ValidateAll() if the designated constraints
 // aren't met.
-type Setting_LoginMultiError []error
+type Setting_RateLimitMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Setting_LoginMultiError) Error() string {
+func (m Setting_RateLimitMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3348,11 +5096,11 @@ func (m Setting_LoginMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Setting_LoginMultiError) AllErrors() []error { return m }
+func (m Setting_RateLimitMultiError) AllErrors() []error { return m }
 
-// Setting_LoginValidationError is the validation error returned by
-// Setting_Login.Validate if the designated constraints aren't met.
-type Setting_LoginValidationError struct {
+// Setting_RateLimitValidationError is the validation error returned by
+// Setting_RateLimit.Validate if the designated constraints aren't met.
+type Setting_RateLimitValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3360,22 +5108,24 @@ type Setting_LoginValidationError struct {
 }
 
 // Field function returns field value.
-func (e Setting_LoginValidationError) Field() string { return e.field }
+func (e Setting_RateLimitValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Setting_LoginValidationError) Reason() string { return e.reason }
+func (e Setting_RateLimitValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Setting_LoginValidationError) Cause() error { return e.cause }
+func (e Setting_RateLimitValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Setting_LoginValidationError) Key() bool { return e.key }
+func (e Setting_RateLimitValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Setting_LoginValidationError) ErrorName() string { return "Setting_LoginValidationError" }
+func (e Setting_RateLimitValidationError) ErrorName() string {
+	return "Setting_RateLimitValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e Setting_LoginValidationError) Error() string {
+func (e Setting_RateLimitValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3387,14 +5137,14 @@ func (e Setting_LoginValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetting_Login.%s: %s%s",
+		"invalid %sSetting_RateLimit.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Setting_LoginValidationError{}
+var _ error = Setting_RateLimitValidationError{}
 
 var _ interface {
 	Field() string
@@ -3402,7 +5152,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Setting_LoginValidationError{}
+} = Setting_RateLimitValidationError{}
 
 // Validate checks the field values on Setting_EncryptSecret with the rules
 // defined in the proto definition for this message. If any rules are
@@ -3455,78 +5205,217 @@ func (m *Setting_EncryptSecret) validate(all bool) error {
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetServiceEncrypt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Setting_EncryptSecretValidationError{
-					field:  "ServiceEncrypt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, Setting_EncryptSecretValidationError{
-					field:  "ServiceEncrypt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetServiceEncrypt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Setting_EncryptSecretValidationError{
-				field:  "ServiceEncrypt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if all {
+		switch v := interface{}(m.GetServiceEncrypt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_EncryptSecretValidationError{
+					field:  "ServiceEncrypt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_EncryptSecretValidationError{
+					field:  "ServiceEncrypt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServiceEncrypt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_EncryptSecretValidationError{
+				field:  "ServiceEncrypt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTokenEncrypt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_EncryptSecretValidationError{
+					field:  "TokenEncrypt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_EncryptSecretValidationError{
+					field:  "TokenEncrypt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTokenEncrypt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_EncryptSecretValidationError{
+				field:  "TokenEncrypt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Setting_EncryptSecretMultiError(errors)
+	}
+
+	return nil
+}
+
+// Setting_EncryptSecretMultiError is an error wrapping multiple validation
+// errors returned by Setting_EncryptSecret.ValidateAll() if the designated
+// constraints aren't met.
+type Setting_EncryptSecretMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Setting_EncryptSecretMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Setting_EncryptSecretMultiError) AllErrors() []error { return m }
+
+// Setting_EncryptSecretValidationError is the validation error returned by
+// Setting_EncryptSecret.Validate if the designated constraints aren't met.
+type Setting_EncryptSecretValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Setting_EncryptSecretValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Setting_EncryptSecretValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Setting_EncryptSecretValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Setting_EncryptSecretValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Setting_EncryptSecretValidationError) ErrorName() string {
+	return "Setting_EncryptSecretValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Setting_EncryptSecretValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetting_EncryptSecret.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Setting_EncryptSecretValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Setting_EncryptSecretValidationError{}
+
+// Validate checks the field values on Setting_RateLimit_Rule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Setting_RateLimit_Rule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Setting_RateLimit_Rule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Setting_RateLimit_RuleMultiError, or nil if none found.
+func (m *Setting_RateLimit_Rule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Setting_RateLimit_Rule) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Algorithm
+
+	// no validation rules for Limit
+
 	if all {
-		switch v := interface{}(m.GetTokenEncrypt()).(type) {
+		switch v := interface{}(m.GetWindow()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Setting_EncryptSecretValidationError{
-					field:  "TokenEncrypt",
+				errors = append(errors, Setting_RateLimit_RuleValidationError{
+					field:  "Window",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Setting_EncryptSecretValidationError{
-					field:  "TokenEncrypt",
+				errors = append(errors, Setting_RateLimit_RuleValidationError{
+					field:  "Window",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTokenEncrypt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetWindow()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Setting_EncryptSecretValidationError{
-				field:  "TokenEncrypt",
+			return Setting_RateLimit_RuleValidationError{
+				field:  "Window",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Burst
+
+	// no validation rules for KeyBy
+
 	if len(errors) > 0 {
-		return Setting_EncryptSecretMultiError(errors)
+		return Setting_RateLimit_RuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// Setting_EncryptSecretMultiError is an error wrapping multiple validation
-// errors returned by Setting_EncryptSecret.ValidateAll() if the designated
+// Setting_RateLimit_RuleMultiError is an error wrapping multiple validation
+// errors returned by Setting_RateLimit_Rule.ValidateAll() if the designated
 // constraints aren't met.
-type Setting_EncryptSecretMultiError []error
+type Setting_RateLimit_RuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Setting_EncryptSecretMultiError) Error() string {
+func (m Setting_RateLimit_RuleMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3535,11 +5424,11 @@ func (m Setting_EncryptSecretMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Setting_EncryptSecretMultiError) AllErrors() []error { return m }
+func (m Setting_RateLimit_RuleMultiError) AllErrors() []error { return m }
 
-// Setting_EncryptSecretValidationError is the validation error returned by
-// Setting_EncryptSecret.Validate if the designated constraints aren't met.
-type Setting_EncryptSecretValidationError struct {
+// Setting_RateLimit_RuleValidationError is the validation error returned by
+// Setting_RateLimit_Rule.Validate if the designated constraints aren't met.
+type Setting_RateLimit_RuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3547,24 +5436,24 @@ type Setting_EncryptSecretValidationError struct {
 }
 
 // Field function returns field value.
-func (e Setting_EncryptSecretValidationError) Field() string { return e.field }
+func (e Setting_RateLimit_RuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Setting_EncryptSecretValidationError) Reason() string { return e.reason }
+func (e Setting_RateLimit_RuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Setting_EncryptSecretValidationError) Cause() error { return e.cause }
+func (e Setting_RateLimit_RuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Setting_EncryptSecretValidationError) Key() bool { return e.key }
+func (e Setting_RateLimit_RuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Setting_EncryptSecretValidationError) ErrorName() string {
-	return "Setting_EncryptSecretValidationError"
+func (e Setting_RateLimit_RuleValidationError) ErrorName() string {
+	return "Setting_RateLimit_RuleValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e Setting_EncryptSecretValidationError) Error() string {
+func (e Setting_RateLimit_RuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3576,14 +5465,14 @@ func (e Setting_EncryptSecretValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSetting_EncryptSecret.%s: %s%s",
+		"invalid %sSetting_RateLimit_Rule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Setting_EncryptSecretValidationError{}
+var _ error = Setting_RateLimit_RuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -3591,7 +5480,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Setting_EncryptSecretValidationError{}
+} = Setting_RateLimit_RuleValidationError{}
 
 // Validate checks the field values on Setting_EncryptSecret_TransferEncrypt
 // with the rules defined in the proto definition for this message. If any
@@ -3729,6 +5618,39 @@ func (m *Setting_EncryptSecret_ServiceEncrypt) validate(all bool) error {
 
 	// no validation rules for PrivateKey
 
+	// no validation rules for Enable
+
+	// no validation rules for TrustedPublicKeys
+
+	if all {
+		switch v := interface{}(m.GetReplayWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_EncryptSecret_ServiceEncryptValidationError{
+					field:  "ReplayWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_EncryptSecret_ServiceEncryptValidationError{
+					field:  "ReplayWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplayWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_EncryptSecret_ServiceEncryptValidationError{
+				field:  "ReplayWindow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return Setting_EncryptSecret_ServiceEncryptMultiError(errors)
 	}
@@ -3838,6 +5760,40 @@ func (m *Setting_EncryptSecret_TokenEncrypt) validate(all bool) error {
 
 	// no validation rules for RefreshKey
 
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Setting_EncryptSecret_TokenEncryptValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Setting_EncryptSecret_TokenEncryptValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Setting_EncryptSecret_TokenEncryptValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return Setting_EncryptSecret_TokenEncryptMultiError(errors)
 	}
@@ -3920,6 +5876,176 @@ var _ interface {
 	ErrorName() string
 } = Setting_EncryptSecret_TokenEncryptValidationError{}
 
+// Validate checks the field values on Setting_EncryptSecret_TokenEncrypt_Key
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *Setting_EncryptSecret_TokenEncrypt_Key) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// Setting_EncryptSecret_TokenEncrypt_Key with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// Setting_EncryptSecret_TokenEncrypt_KeyMultiError, or nil if none found.
+func (m *Setting_EncryptSecret_TokenEncrypt_Key) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Setting_EncryptSecret_TokenEncrypt_Key) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kid
+
+	// no validation rules for SignKey
+
+	// no validation rules for RefreshKey
+
+	if all {
+		switch v := interface{}(m.GetNotBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_EncryptSecret_TokenEncrypt_KeyValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_EncryptSecret_TokenEncrypt_KeyValidationError{
+					field:  "NotBefore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_EncryptSecret_TokenEncrypt_KeyValidationError{
+				field:  "NotBefore",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetireAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Setting_EncryptSecret_TokenEncrypt_KeyValidationError{
+					field:  "RetireAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Setting_EncryptSecret_TokenEncrypt_KeyValidationError{
+					field:  "RetireAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetireAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Setting_EncryptSecret_TokenEncrypt_KeyValidationError{
+				field:  "RetireAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Setting_EncryptSecret_TokenEncrypt_KeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// Setting_EncryptSecret_TokenEncrypt_KeyMultiError is an error wrapping
+// multiple validation errors returned by
+// Setting_EncryptSecret_TokenEncrypt_Key.ValidateAll() if the designated
+// constraints aren't met.
+type Setting_EncryptSecret_TokenEncrypt_KeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Setting_EncryptSecret_TokenEncrypt_KeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Setting_EncryptSecret_TokenEncrypt_KeyMultiError) AllErrors() []error { return m }
+
+// Setting_EncryptSecret_TokenEncrypt_KeyValidationError is the validation
+// error returned by Setting_EncryptSecret_TokenEncrypt_Key.Validate if the
+// designated constraints aren't met.
+type Setting_EncryptSecret_TokenEncrypt_KeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Setting_EncryptSecret_TokenEncrypt_KeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Setting_EncryptSecret_TokenEncrypt_KeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Setting_EncryptSecret_TokenEncrypt_KeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Setting_EncryptSecret_TokenEncrypt_KeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Setting_EncryptSecret_TokenEncrypt_KeyValidationError) ErrorName() string {
+	return "Setting_EncryptSecret_TokenEncrypt_KeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Setting_EncryptSecret_TokenEncrypt_KeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetting_EncryptSecret_TokenEncrypt_Key.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Setting_EncryptSecret_TokenEncrypt_KeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Setting_EncryptSecret_TokenEncrypt_KeyValidationError{}
+
 // Validate checks the field values on ClientApi_Endpoint with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -0,0 +1,33 @@
+# snowflake service
+SNOWFLAKE_V1_PROTO_SERVICE=$(shell cd $(PROJECT_PATH) && find api/snowflake-service/v1 -name "*.proto")
+#SNOWFLAKE_V1_PROTO_CONFIG=$(shell cd $(PROJECT_PATH) && find app/snowflake-service/internal/conf -name "*.proto")
+SNOWFLAKE_V1_PROTO_CONFIG=
+SNOWFLAKE_V1_PROTO_FILES=""
+ifneq ($(SNOWFLAKE_V1_PROTO_CONFIG), "")
+	SNOWFLAKE_V1_PROTO_FILES=$(SNOWFLAKE_V1_PROTO_SERVICE) $(SNOWFLAKE_V1_PROTO_CONFIG)
+else
+	SNOWFLAKE_V1_PROTO_FILES=$(SNOWFLAKE_V1_PROTO_SERVICE)
+endif
+.PHONY: protoc-snowflake-v1
+# protoc :-->: generate snowflake v1 server protobuf
+protoc-snowflake-v1:
+	@echo "# generate snowflake-service protobuf"
+	if [ "$(SNOWFLAKE_V1_PROTO_FILES)" != "" ]; then \
+		cd $(PROJECT_PATH); \
+		protoc \
+			--proto_path=. \
+			--proto_path=$(GOPATH)/src \
+			--proto_path=./third_party \
+			--go_out=paths=source_relative:. \
+			--go-grpc_out=paths=source_relative:. \
+			--go-http_out=paths=source_relative:. \
+			--go-errors_out=paths=source_relative:. \
+			--validate_out=paths=source_relative,lang=go:. \
+			--openapiv2_out . \
+			--openapiv2_opt logtostderr=true \
+			--openapiv2_opt allow_delete_body=true \
+			--openapiv2_opt json_names_for_fields=false \
+			--openapiv2_opt enums_as_ints=true \
+			--openapi_out=fq_schema_naming=true,enum_type=integer,default_response=true:. \
+			$(SNOWFLAKE_V1_PROTO_FILES) ; \
+	fi
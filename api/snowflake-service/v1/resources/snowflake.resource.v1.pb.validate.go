@@ -0,0 +1,906 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: api/snowflake-service/v1/resources/snowflake.resource.v1.proto
+
+package resourcev1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on SnowflakeNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SnowflakeNode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SnowflakeNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SnowflakeNodeMultiError, or
+// nil if none found.
+func (m *SnowflakeNode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SnowflakeNode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for InstanceId
+
+	// no validation rules for NodeId
+
+	if all {
+		switch v := interface{}(m.GetExpiredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SnowflakeNodeValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SnowflakeNodeValidationError{
+					field:  "ExpiredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SnowflakeNodeValidationError{
+				field:  "ExpiredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SnowflakeNodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// SnowflakeNodeMultiError is an error wrapping multiple validation errors
+// returned by SnowflakeNode.ValidateAll() if the designated constraints
+// aren't met.
+type SnowflakeNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SnowflakeNodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SnowflakeNodeMultiError) AllErrors() []error { return m }
+
+// SnowflakeNodeValidationError is the validation error returned by
+// SnowflakeNode.Validate if the designated constraints aren't met.
+type SnowflakeNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SnowflakeNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SnowflakeNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SnowflakeNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SnowflakeNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SnowflakeNodeValidationError) ErrorName() string { return "SnowflakeNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SnowflakeNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSnowflakeNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SnowflakeNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SnowflakeNodeValidationError{}
+
+// Validate checks the field values on GetNodeIdReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetNodeIdReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNodeIdReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetNodeIdReqMultiError, or
+// nil if none found.
+func (m *GetNodeIdReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNodeIdReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for InstanceId
+
+	// no validation rules for InstanceName
+
+	// no validation rules for Metadata
+
+	if all {
+		switch v := interface{}(m.GetLeaseTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNodeIdReqValidationError{
+					field:  "LeaseTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNodeIdReqValidationError{
+					field:  "LeaseTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaseTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNodeIdReqValidationError{
+				field:  "LeaseTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNodeIdReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNodeIdReqMultiError is an error wrapping multiple validation errors
+// returned by GetNodeIdReq.ValidateAll() if the designated constraints aren't met.
+type GetNodeIdReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNodeIdReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNodeIdReqMultiError) AllErrors() []error { return m }
+
+// GetNodeIdReqValidationError is the validation error returned by
+// GetNodeIdReq.Validate if the designated constraints aren't met.
+type GetNodeIdReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNodeIdReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNodeIdReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNodeIdReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNodeIdReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNodeIdReqValidationError) ErrorName() string { return "GetNodeIdReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetNodeIdReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNodeIdReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNodeIdReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNodeIdReqValidationError{}
+
+// Validate checks the field values on GetNodeIdResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *GetNodeIdResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNodeIdResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GetNodeIdRespMultiError, or
+// nil if none found.
+func (m *GetNodeIdResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNodeIdResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetNodeIdRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetNodeIdRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetNodeIdRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetNodeIdRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNodeIdRespMultiError is an error wrapping multiple validation errors
+// returned by GetNodeIdResp.ValidateAll() if the designated constraints
+// aren't met.
+type GetNodeIdRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNodeIdRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNodeIdRespMultiError) AllErrors() []error { return m }
+
+// GetNodeIdRespValidationError is the validation error returned by
+// GetNodeIdResp.Validate if the designated constraints aren't met.
+type GetNodeIdRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNodeIdRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNodeIdRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNodeIdRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNodeIdRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNodeIdRespValidationError) ErrorName() string { return "GetNodeIdRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetNodeIdRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNodeIdResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNodeIdRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNodeIdRespValidationError{}
+
+// Validate checks the field values on ExtendNodeIdReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExtendNodeIdReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendNodeIdReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendNodeIdReqMultiError, or nil if none found.
+func (m *ExtendNodeIdReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendNodeIdReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for InstanceId
+
+	// no validation rules for NodeId
+
+	if all {
+		switch v := interface{}(m.GetLeaseTtl()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendNodeIdReqValidationError{
+					field:  "LeaseTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendNodeIdReqValidationError{
+					field:  "LeaseTtl",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeaseTtl()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendNodeIdReqValidationError{
+				field:  "LeaseTtl",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExtendNodeIdReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendNodeIdReqMultiError is an error wrapping multiple validation errors
+// returned by ExtendNodeIdReq.ValidateAll() if the designated constraints
+// aren't met.
+type ExtendNodeIdReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendNodeIdReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendNodeIdReqMultiError) AllErrors() []error { return m }
+
+// ExtendNodeIdReqValidationError is the validation error returned by
+// ExtendNodeIdReq.Validate if the designated constraints aren't met.
+type ExtendNodeIdReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendNodeIdReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendNodeIdReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendNodeIdReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendNodeIdReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendNodeIdReqValidationError) ErrorName() string { return "ExtendNodeIdReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExtendNodeIdReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendNodeIdReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendNodeIdReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendNodeIdReqValidationError{}
+
+// Validate checks the field values on ExtendNodeIdResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExtendNodeIdResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendNodeIdResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendNodeIdRespMultiError, or nil if none found.
+func (m *ExtendNodeIdResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendNodeIdResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetData()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExtendNodeIdRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExtendNodeIdRespValidationError{
+					field:  "Data",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExtendNodeIdRespValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExtendNodeIdRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendNodeIdRespMultiError is an error wrapping multiple validation errors
+// returned by ExtendNodeIdResp.ValidateAll() if the designated constraints
+// aren't met.
+type ExtendNodeIdRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendNodeIdRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendNodeIdRespMultiError) AllErrors() []error { return m }
+
+// ExtendNodeIdRespValidationError is the validation error returned by
+// ExtendNodeIdResp.Validate if the designated constraints aren't met.
+type ExtendNodeIdRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendNodeIdRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendNodeIdRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendNodeIdRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendNodeIdRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendNodeIdRespValidationError) ErrorName() string { return "ExtendNodeIdRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExtendNodeIdRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendNodeIdResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendNodeIdRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendNodeIdRespValidationError{}
+
+// Validate checks the field values on ReleaseNodeIdReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseNodeIdReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseNodeIdReq with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseNodeIdReqMultiError, or nil if none found.
+func (m *ReleaseNodeIdReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseNodeIdReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for InstanceId
+
+	// no validation rules for NodeId
+
+	if len(errors) > 0 {
+		return ReleaseNodeIdReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseNodeIdReqMultiError is an error wrapping multiple validation errors
+// returned by ReleaseNodeIdReq.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseNodeIdReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseNodeIdReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseNodeIdReqMultiError) AllErrors() []error { return m }
+
+// ReleaseNodeIdReqValidationError is the validation error returned by
+// ReleaseNodeIdReq.Validate if the designated constraints aren't met.
+type ReleaseNodeIdReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseNodeIdReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseNodeIdReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseNodeIdReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseNodeIdReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseNodeIdReqValidationError) ErrorName() string { return "ReleaseNodeIdReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReleaseNodeIdReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseNodeIdReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseNodeIdReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseNodeIdReqValidationError{}
+
+// Validate checks the field values on ReleaseNodeIdResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ReleaseNodeIdResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReleaseNodeIdResp with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReleaseNodeIdRespMultiError, or nil if none found.
+func (m *ReleaseNodeIdResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReleaseNodeIdResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReleaseNodeIdRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReleaseNodeIdRespMultiError is an error wrapping multiple validation errors
+// returned by ReleaseNodeIdResp.ValidateAll() if the designated constraints
+// aren't met.
+type ReleaseNodeIdRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReleaseNodeIdRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReleaseNodeIdRespMultiError) AllErrors() []error { return m }
+
+// ReleaseNodeIdRespValidationError is the validation error returned by
+// ReleaseNodeIdResp.Validate if the designated constraints aren't met.
+type ReleaseNodeIdRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseNodeIdRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseNodeIdRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseNodeIdRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseNodeIdRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseNodeIdRespValidationError) ErrorName() string {
+	return "ReleaseNodeIdRespValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseNodeIdRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseNodeIdResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseNodeIdRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseNodeIdRespValidationError{}
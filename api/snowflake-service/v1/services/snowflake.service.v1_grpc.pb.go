@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.6
+// source: api/snowflake-service/v1/services/snowflake.service.v1.proto
+
+package servicev1
+
+import (
+	context "context"
+	resources "github.com/my-saas-platform/api-proto/api/snowflake-service/v1/resources"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	SrvSnowflakeV1_GetNodeId_FullMethodName     = "/saas.api.snowflake.servicev1.SrvSnowflakeV1/GetNodeId"
+	SrvSnowflakeV1_ExtendNodeId_FullMethodName  = "/saas.api.snowflake.servicev1.SrvSnowflakeV1/ExtendNodeId"
+	SrvSnowflakeV1_ReleaseNodeId_FullMethodName = "/saas.api.snowflake.servicev1.SrvSnowflakeV1/ReleaseNodeId"
+)
+
+// SrvSnowflakeV1Client is the client API for SrvSnowflakeV1 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SrvSnowflakeV1Client interface {
+	// GetNodeId 获取节点ID
+	GetNodeId(ctx context.Context, in *resources.GetNodeIdReq, opts ...grpc.CallOption) (*resources.GetNodeIdResp, error)
+	// ExtendNodeId 续期节点ID
+	ExtendNodeId(ctx context.Context, in *resources.ExtendNodeIdReq, opts ...grpc.CallOption) (*resources.ExtendNodeIdResp, error)
+	// ReleaseNodeId 释放节点ID
+	ReleaseNodeId(ctx context.Context, in *resources.ReleaseNodeIdReq, opts ...grpc.CallOption) (*resources.ReleaseNodeIdResp, error)
+}
+
+type srvSnowflakeV1Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSrvSnowflakeV1Client(cc grpc.ClientConnInterface) SrvSnowflakeV1Client {
+	return &srvSnowflakeV1Client{cc}
+}
+
+func (c *srvSnowflakeV1Client) GetNodeId(ctx context.Context, in *resources.GetNodeIdReq, opts ...grpc.CallOption) (*resources.GetNodeIdResp, error) {
+	out := new(resources.GetNodeIdResp)
+	err := c.cc.Invoke(ctx, SrvSnowflakeV1_GetNodeId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srvSnowflakeV1Client) ExtendNodeId(ctx context.Context, in *resources.ExtendNodeIdReq, opts ...grpc.CallOption) (*resources.ExtendNodeIdResp, error) {
+	out := new(resources.ExtendNodeIdResp)
+	err := c.cc.Invoke(ctx, SrvSnowflakeV1_ExtendNodeId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *srvSnowflakeV1Client) ReleaseNodeId(ctx context.Context, in *resources.ReleaseNodeIdReq, opts ...grpc.CallOption) (*resources.ReleaseNodeIdResp, error) {
+	out := new(resources.ReleaseNodeIdResp)
+	err := c.cc.Invoke(ctx, SrvSnowflakeV1_ReleaseNodeId_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SrvSnowflakeV1Server is the server API for SrvSnowflakeV1 service.
+// All implementations must embed UnimplementedSrvSnowflakeV1Server
+// for forward compatibility
+type SrvSnowflakeV1Server interface {
+	// GetNodeId 获取节点ID
+	GetNodeId(context.Context, *resources.GetNodeIdReq) (*resources.GetNodeIdResp, error)
+	// ExtendNodeId 续期节点ID
+	ExtendNodeId(context.Context, *resources.ExtendNodeIdReq) (*resources.ExtendNodeIdResp, error)
+	// ReleaseNodeId 释放节点ID
+	ReleaseNodeId(context.Context, *resources.ReleaseNodeIdReq) (*resources.ReleaseNodeIdResp, error)
+	mustEmbedUnimplementedSrvSnowflakeV1Server()
+}
+
+// UnimplementedSrvSnowflakeV1Server must be embedded to have forward compatible implementations.
+type UnimplementedSrvSnowflakeV1Server struct {
+}
+
+func (UnimplementedSrvSnowflakeV1Server) GetNodeId(context.Context, *resources.GetNodeIdReq) (*resources.GetNodeIdResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNodeId not implemented")
+}
+func (UnimplementedSrvSnowflakeV1Server) ExtendNodeId(context.Context, *resources.ExtendNodeIdReq) (*resources.ExtendNodeIdResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendNodeId not implemented")
+}
+func (UnimplementedSrvSnowflakeV1Server) ReleaseNodeId(context.Context, *resources.ReleaseNodeIdReq) (*resources.ReleaseNodeIdResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNodeId not implemented")
+}
+func (UnimplementedSrvSnowflakeV1Server) mustEmbedUnimplementedSrvSnowflakeV1Server() {}
+
+// UnsafeSrvSnowflakeV1Server may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SrvSnowflakeV1Server will
+// result in compilation errors.
+type UnsafeSrvSnowflakeV1Server interface {
+	mustEmbedUnimplementedSrvSnowflakeV1Server()
+}
+
+func RegisterSrvSnowflakeV1Server(s grpc.ServiceRegistrar, srv SrvSnowflakeV1Server) {
+	s.RegisterService(&SrvSnowflakeV1_ServiceDesc, srv)
+}
+
+func _SrvSnowflakeV1_GetNodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(resources.GetNodeIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrvSnowflakeV1Server).GetNodeId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrvSnowflakeV1_GetNodeId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrvSnowflakeV1Server).GetNodeId(ctx, req.(*resources.GetNodeIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrvSnowflakeV1_ExtendNodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(resources.ExtendNodeIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrvSnowflakeV1Server).ExtendNodeId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrvSnowflakeV1_ExtendNodeId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrvSnowflakeV1Server).ExtendNodeId(ctx, req.(*resources.ExtendNodeIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SrvSnowflakeV1_ReleaseNodeId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(resources.ReleaseNodeIdReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SrvSnowflakeV1Server).ReleaseNodeId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SrvSnowflakeV1_ReleaseNodeId_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SrvSnowflakeV1Server).ReleaseNodeId(ctx, req.(*resources.ReleaseNodeIdReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SrvSnowflakeV1_ServiceDesc is the grpc.ServiceDesc for SrvSnowflakeV1 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SrvSnowflakeV1_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "saas.api.snowflake.servicev1.SrvSnowflakeV1",
+	HandlerType: (*SrvSnowflakeV1Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetNodeId",
+			Handler:    _SrvSnowflakeV1_GetNodeId_Handler,
+		},
+		{
+			MethodName: "ExtendNodeId",
+			Handler:    _SrvSnowflakeV1_ExtendNodeId_Handler,
+		},
+		{
+			MethodName: "ReleaseNodeId",
+			Handler:    _SrvSnowflakeV1_ReleaseNodeId_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/snowflake-service/v1/services/snowflake.service.v1.proto",
+}
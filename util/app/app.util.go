@@ -28,6 +28,21 @@ func ConfigPath(appConfig *configs.App) string {
 	return appIdentifier(appConfig, _configPathSep)
 }
 
+// KeyPrefix 共享存储(如：redis)的键前缀；不含 app.ServerVersion，滚动发布时新旧版本共享锁、限流、验证码等
+// @result = app.ProjectName + ":" + app.ServerName + ":" + app.ServerEnv + ":"
+// 例：go-srv-saas:user-service:DEVELOP:
+func KeyPrefix(appConfig *configs.App) string {
+	var ss = make([]string, 0, 3)
+	if appConfig.ProjectName != "" {
+		ss = append(ss, appConfig.ProjectName)
+	}
+	if appConfig.ServerName != "" {
+		ss = append(ss, appConfig.ServerName)
+	}
+	ss = append(ss, apppkg.ParseEnv(appConfig.ServerEnv).String())
+	return strings.Join(ss, _appIDSep) + _appIDSep
+}
+
 // appIdentifier app 唯一标准
 // @result = app.ProjectName + "/" + app.ServerName + "/" + app.ServerEnv + "/" + app.ServerVersion
 // 例：go-srv-saas/DEVELOP/main/v1.0.0/user-service
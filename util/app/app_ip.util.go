@@ -0,0 +1,111 @@
+package apputil
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	// 代理转发的请求头
+	forwardedForHeader = "X-Forwarded-For"
+	realIPHeader       = "X-Real-Ip"
+)
+
+// ParseTrustedProxies 信任的代理：CIDR 或 ip；例：10.0.0.0/8、127.0.0.1
+func ParseTrustedProxies(proxies []string) ([]*net.IPNet, error) {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, pkgerrors.Errorf("invalid trusted proxy : %s", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, pkgerrors.Wrapf(err, "invalid trusted proxy : %s", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets, nil
+}
+
+// ClientIP 请求方的ip；对端地址为信任的代理时，使用 X-Forwarded-For(从右向左第一个非信任代理的地址)或 X-Real-Ip；
+// 未配置信任的代理时为 RemoteIP，不使用请求头
+func ClientIP(ctx context.Context, trustedProxies []*net.IPNet) string {
+	remoteIP := RemoteIP(ctx)
+	if len(trustedProxies) == 0 || !containsIP(trustedProxies, remoteIP) {
+		return remoteIP
+	}
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return remoteIP
+	}
+	forwarded := tr.RequestHeader().Values(forwardedForHeader)
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		ips := strings.Split(forwarded[i], ",")
+		for j := len(ips) - 1; j >= 0; j-- {
+			ip := strings.TrimSpace(ips[j])
+			if net.ParseIP(ip) == nil {
+				// 无效的地址：不再信任左侧的地址
+				return remoteIP
+			}
+			if !containsIP(trustedProxies, ip) {
+				return ip
+			}
+		}
+	}
+	if ip := strings.TrimSpace(tr.RequestHeader().Get(realIPHeader)); net.ParseIP(ip) != nil {
+		return ip
+	}
+	return remoteIP
+}
+
+// containsIP ...
+func containsIP(nets []*net.IPNet, ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range nets {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoteIP 连接的对端地址；http 为 Request.RemoteAddr，grpc 为 peer；不使用 X-Forwarded-For 等请求头
+func RemoteIP(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if ht, ok := tr.(http.Transporter); ok && ht.Request() != nil {
+			return hostIP(ht.Request().RemoteAddr)
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return hostIP(p.Addr.String())
+	}
+	return ""
+}
+
+// hostIP 去除端口
+func hostIP(addr string) string {
+	addr = strings.TrimSpace(addr)
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
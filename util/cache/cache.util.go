@@ -0,0 +1,501 @@
+package cacheutil
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultKeyPrefix redis键前缀
+	DefaultKeyPrefix = "cache:"
+	// DefaultTTL 新鲜期
+	DefaultTTL = 5 * time.Minute
+	// DefaultStaleTTL 过期副本的保留期；保留期内返回过期副本并在后台刷新
+	DefaultStaleTTL = time.Minute
+	// DefaultLocalSize 进程内 LRU 的容量
+	DefaultLocalSize = 10000
+	// DefaultLocalTTL 进程内缓存的有效期；失效广播丢失时的最长不一致时间
+	DefaultLocalTTL = time.Minute
+	// DefaultLoadTimeout 合并加载与后台刷新的超时时间
+	DefaultLoadTimeout = 10 * time.Second
+
+	// 缓存层级
+	TierLocal = "local"
+	TierRedis = "redis"
+
+	// 查询结果
+	ResultHit   = "hit"
+	ResultStale = "stale"
+	ResultMiss  = "miss"
+
+	// envelopeHeaderSize redis值的头部：新鲜期(unix ms)
+	envelopeHeaderSize = 8
+)
+
+// MetricsRecorder 指标；metricsutil.Metrics 实现
+type MetricsRecorder interface {
+	RecordCache(cache, tier, result string)
+}
+
+// options 可选项
+type options struct {
+	keyPrefix   string
+	codec       Codec
+	ttl         time.Duration
+	staleTTL    time.Duration
+	localSize   int
+	localTTL    time.Duration
+	loadTimeout time.Duration
+	metrics     MetricsRecorder
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithKeyPrefix redis键前缀；例：go-srv-saas:DEVELOP:user-service:cache:
+func WithKeyPrefix(keyPrefix string) Option {
+	return func(o *options) {
+		o.keyPrefix = keyPrefix
+	}
+}
+
+// WithCodec 序列化；默认 JSONCodec
+func WithCodec(codec Codec) Option {
+	return func(o *options) {
+		o.codec = codec
+	}
+}
+
+// WithTTL 新鲜期；默认 DefaultTTL
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// WithStaleTTL 过期副本的保留期；默认 DefaultStaleTTL；0 时不返回过期副本
+func WithStaleTTL(staleTTL time.Duration) Option {
+	return func(o *options) {
+		o.staleTTL = staleTTL
+	}
+}
+
+// WithLocalSize 进程内 LRU 的容量；默认 DefaultLocalSize；0 时仅使用 redis
+func WithLocalSize(localSize int) Option {
+	return func(o *options) {
+		o.localSize = localSize
+	}
+}
+
+// WithLocalTTL 进程内缓存的有效期；默认 DefaultLocalTTL
+func WithLocalTTL(localTTL time.Duration) Option {
+	return func(o *options) {
+		o.localTTL = localTTL
+	}
+}
+
+// WithLoadTimeout 合并加载与后台刷新的超时时间；默认 DefaultLoadTimeout；
+// 加载不随调用方取消，合并的其他调用方仍可获取结果
+func WithLoadTimeout(loadTimeout time.Duration) Option {
+	return func(o *options) {
+		o.loadTimeout = loadTimeout
+	}
+}
+
+// WithMetrics 指标；每个层级的命中、过期副本与未命中
+func WithMetrics(metrics MetricsRecorder) Option {
+	return func(o *options) {
+		o.metrics = metrics
+	}
+}
+
+// Stats 统计
+type Stats struct {
+	LocalHits   uint64
+	LocalStale  uint64
+	LocalMisses uint64
+	RedisHits   uint64
+	RedisStale  uint64
+	RedisMisses uint64
+	// Loads 调用加载函数的次数
+	Loads      uint64
+	LoadErrors uint64
+	// Invalidations 收到其他副本的失效广播的次数
+	Invalidations uint64
+}
+
+// stats 统计
+type stats struct {
+	localHits, localStale, localMisses atomic.Uint64
+	redisHits, redisStale, redisMisses atomic.Uint64
+	loads, loadErrors, invalidations   atomic.Uint64
+}
+
+// LoadFunc 加载函数；返回序列化前的值
+type LoadFunc func(ctx context.Context) (interface{}, error)
+
+// Cache 两级缓存：进程内 LRU + redis；
+// 加载合并(singleflight)，过期副本在后台刷新期间返回，失效通过 redis pub/sub 广播到全部副本
+type Cache struct {
+	name       string
+	redisCC    redis.UniversalClient
+	opts       *options
+	local      *localCache
+	group      singleflight.Group
+	refreshing sync.Map
+	stats      stats
+
+	// instanceID 当前副本；忽略自己的失效广播
+	instanceID string
+	pubsub     *redis.PubSub
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewCache 两级缓存；name 为缓存名称，用于redis键、失效广播的频道与指标
+func NewCache(name string, redisCC redis.UniversalClient, opts ...Option) (*Cache, error) {
+	cacheOpts := &options{
+		keyPrefix:   DefaultKeyPrefix,
+		codec:       JSONCodec{},
+		ttl:         DefaultTTL,
+		staleTTL:    DefaultStaleTTL,
+		localSize:   DefaultLocalSize,
+		localTTL:    DefaultLocalTTL,
+		loadTimeout: DefaultLoadTimeout,
+	}
+	for i := range opts {
+		opts[i](cacheOpts)
+	}
+	if name == "" {
+		return nil, pkgerrors.New("cache : name is required")
+	}
+	if cacheOpts.codec == nil {
+		cacheOpts.codec = JSONCodec{}
+	}
+	if cacheOpts.ttl <= 0 {
+		cacheOpts.ttl = DefaultTTL
+	}
+	if cacheOpts.staleTTL < 0 {
+		cacheOpts.staleTTL = 0
+	}
+	if cacheOpts.localTTL <= 0 {
+		cacheOpts.localTTL = DefaultLocalTTL
+	}
+	if cacheOpts.loadTimeout <= 0 {
+		cacheOpts.loadTimeout = DefaultLoadTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &Cache{
+		name:       name,
+		redisCC:    redisCC,
+		opts:       cacheOpts,
+		local:      newLocalCache(cacheOpts.localSize, cacheOpts.localTTL),
+		instanceID: uuid.NewString(),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+	if err := c.subscribe(); err != nil {
+		cancel()
+		return nil, err
+	}
+	return c, nil
+}
+
+// Name 缓存名称
+func (c *Cache) Name() string {
+	return c.name
+}
+
+// Stats 统计
+func (c *Cache) Stats() Stats {
+	return Stats{
+		LocalHits:     c.stats.localHits.Load(),
+		LocalStale:    c.stats.localStale.Load(),
+		LocalMisses:   c.stats.localMisses.Load(),
+		RedisHits:     c.stats.redisHits.Load(),
+		RedisStale:    c.stats.redisStale.Load(),
+		RedisMisses:   c.stats.redisMisses.Load(),
+		Loads:         c.stats.loads.Load(),
+		LoadErrors:    c.stats.loadErrors.Load(),
+		Invalidations: c.stats.invalidations.Load(),
+	}
+}
+
+// GetOrLoad 获取；未命中时调用 loader 并写入缓存，同一个键的并发加载合并为一次
+//
+//	user, err := cacheutil.GetOrLoad(ctx, c, "user:1", func(ctx context.Context) (*User, error) {...})
+func GetOrLoad[T any](ctx context.Context, c *Cache, key string, loader func(ctx context.Context) (T, error)) (T, error) {
+	var v T
+	data, err := c.getOrLoad(ctx, key, func(ctx context.Context) (interface{}, error) {
+		return loader(ctx)
+	})
+	if err != nil {
+		return v, err
+	}
+	err = c.opts.codec.Unmarshal(data, &v)
+	return v, err
+}
+
+// Get 获取；未命中时返回 redis.Nil；v 为指针
+func (c *Cache) Get(ctx context.Context, key string, v interface{}) error {
+	e, ok := c.lookup(ctx, key, nil)
+	if !ok {
+		return redis.Nil
+	}
+	return c.opts.codec.Unmarshal(e.data, v)
+}
+
+// Set 写入；并广播失效
+func (c *Cache) Set(ctx context.Context, key string, v interface{}) error {
+	data, err := c.opts.codec.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return c.store(ctx, key, data)
+}
+
+// Delete 删除；并广播失效
+func (c *Cache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	c.local.delete(keys...)
+	// 逐个删除；集群模式下键可能位于不同的槽
+	pipe := c.redisCC.Pipeline()
+	for i := range keys {
+		pipe.Del(ctx, c.redisKey(keys[i]))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	return c.publish(ctx, keys...)
+}
+
+// Close 取消订阅，并等待后台刷新结束
+func (c *Cache) Close() error {
+	c.cancel()
+	var err error
+	if c.pubsub != nil {
+		err = c.pubsub.Close()
+	}
+	c.wg.Wait()
+	return pkgerrors.WithStack(err)
+}
+
+// getOrLoad 合并加载使用不随调用方取消的 ctx(保留 ctx 的值)；
+// 调用方取消时直接返回，加载继续，合并的其他调用方仍可获取结果
+func (c *Cache) getOrLoad(ctx context.Context, key string, loader LoadFunc) ([]byte, error) {
+	if e, ok := c.lookup(ctx, key, loader); ok {
+		return e.data, nil
+	}
+	resultChan := c.group.DoChan(key, func() (interface{}, error) {
+		loadCtx, cancel := c.loadContext(ctx)
+		defer cancel()
+		return c.load(loadCtx, key, loader)
+	})
+	select {
+	case <-ctx.Done():
+		return nil, pkgerrors.WithStack(ctx.Err())
+	case result := <-resultChan:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.([]byte), nil
+	}
+}
+
+// loadContext 加载的 ctx：不随调用方取消，超时或缓存关闭时取消
+func (c *Cache) loadContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.opts.loadTimeout)
+	stop := context.AfterFunc(c.ctx, cancel)
+	return loadCtx, func() {
+		stop()
+		cancel()
+	}
+}
+
+// lookup 依次查询进程内与 redis；命中过期副本时在后台刷新(loader 不为 nil)
+func (c *Cache) lookup(ctx context.Context, key string, loader LoadFunc) (entry, bool) {
+	now := time.Now()
+	if e, ok := c.local.get(key, now); ok {
+		if e.isFresh(now) {
+			c.record(TierLocal, ResultHit)
+			return e, true
+		}
+		if loader != nil {
+			c.record(TierLocal, ResultStale)
+			c.refresh(ctx, key, loader)
+			return e, true
+		}
+	}
+	if c.local.size > 0 {
+		c.record(TierLocal, ResultMiss)
+	}
+
+	e, ok, err := c.getRemote(ctx, key)
+	if err != nil {
+		logpkg.Warnw(
+			"cache.name", c.name,
+			"cache.key", key,
+			"cache.error", err.Error(),
+		)
+	}
+	switch {
+	case !ok:
+		c.record(TierRedis, ResultMiss)
+		return entry{}, false
+	case e.isFresh(now):
+		c.record(TierRedis, ResultHit)
+	case loader != nil:
+		c.record(TierRedis, ResultStale)
+		c.refresh(ctx, key, loader)
+	default:
+		c.record(TierRedis, ResultMiss)
+		return entry{}, false
+	}
+	c.local.set(key, e, now)
+	return e, true
+}
+
+// load 调用加载函数并写入缓存
+func (c *Cache) load(ctx context.Context, key string, loader LoadFunc) ([]byte, error) {
+	c.stats.loads.Add(1)
+	v, err := loader(ctx)
+	if err != nil {
+		c.stats.loadErrors.Add(1)
+		return nil, err
+	}
+	data, err := c.opts.codec.Marshal(v)
+	if err != nil {
+		c.stats.loadErrors.Add(1)
+		return nil, err
+	}
+	if err = c.store(ctx, key, data); err != nil {
+		// 缓存不可用时仍返回加载的值
+		logpkg.Warnw(
+			"cache.name", c.name,
+			"cache.key", key,
+			"cache.error", err.Error(),
+		)
+	}
+	return data, nil
+}
+
+// refresh 后台刷新；同一个键同时仅一个刷新；不随调用方取消
+func (c *Cache) refresh(ctx context.Context, key string, loader LoadFunc) {
+	if _, loaded := c.refreshing.LoadOrStore(key, struct{}{}); loaded {
+		return
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.refreshing.Delete(key)
+
+		ctx, cancel := c.loadContext(ctx)
+		defer cancel()
+		_, err, _ := c.group.Do(key, func() (interface{}, error) {
+			// 其他副本已刷新
+			now := time.Now()
+			if e, ok, _ := c.getRemote(ctx, key); ok && e.isFresh(now) {
+				c.local.set(key, e, now)
+				return e.data, nil
+			}
+			return c.load(ctx, key, loader)
+		})
+		if err != nil && ctx.Err() == nil {
+			logpkg.Warnw(
+				"cache.name", c.name,
+				"cache.key", key,
+				"cache.status", "refresh failed",
+				"cache.error", err.Error(),
+			)
+		}
+	}()
+}
+
+// store 写入 redis 与进程内，并广播失效
+func (c *Cache) store(ctx context.Context, key string, data []byte) error {
+	now := time.Now()
+	e := entry{
+		data:       data,
+		freshUntil: now.Add(c.opts.ttl),
+		staleUntil: now.Add(c.opts.ttl + c.opts.staleTTL),
+	}
+	c.local.set(key, e, now)
+
+	envelope := make([]byte, envelopeHeaderSize+len(data))
+	binary.BigEndian.PutUint64(envelope, uint64(e.freshUntil.UnixMilli()))
+	copy(envelope[envelopeHeaderSize:], data)
+	if err := c.redisCC.Set(ctx, c.redisKey(key), envelope, c.opts.ttl+c.opts.staleTTL).Err(); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	return c.publish(ctx, key)
+}
+
+// getRemote ...
+func (c *Cache) getRemote(ctx context.Context, key string) (entry, bool, error) {
+	redisKey := c.redisKey(key)
+	pipe := c.redisCC.Pipeline()
+	getCmd := pipe.Get(ctx, redisKey)
+	ttlCmd := pipe.PTTL(ctx, redisKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		if pkgerrors.Is(err, redis.Nil) {
+			return entry{}, false, nil
+		}
+		return entry{}, false, pkgerrors.WithStack(err)
+	}
+	envelope, err := getCmd.Bytes()
+	if err != nil || len(envelope) < envelopeHeaderSize {
+		return entry{}, false, nil
+	}
+	now := time.Now()
+	e := entry{
+		data:       envelope[envelopeHeaderSize:],
+		freshUntil: time.UnixMilli(int64(binary.BigEndian.Uint64(envelope))),
+		staleUntil: now.Add(ttlCmd.Val()),
+	}
+	if ttlCmd.Val() <= 0 {
+		e.staleUntil = e.freshUntil
+	}
+	return e, true, nil
+}
+
+// redisKey ...
+func (c *Cache) redisKey(key string) string {
+	return c.opts.keyPrefix + c.name + ":" + key
+}
+
+// record 统计与指标
+func (c *Cache) record(tier, result string) {
+	var counter *atomic.Uint64
+	switch tier + result {
+	case TierLocal + ResultHit:
+		counter = &c.stats.localHits
+	case TierLocal + ResultStale:
+		counter = &c.stats.localStale
+	case TierLocal + ResultMiss:
+		counter = &c.stats.localMisses
+	case TierRedis + ResultHit:
+		counter = &c.stats.redisHits
+	case TierRedis + ResultStale:
+		counter = &c.stats.redisStale
+	default:
+		counter = &c.stats.redisMisses
+	}
+	counter.Add(1)
+	if c.opts.metrics != nil {
+		c.opts.metrics.RecordCache(c.name, tier, result)
+	}
+}
@@ -0,0 +1,251 @@
+package cacheutil
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+type testingUser struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type testingMetrics struct {
+	mutex  sync.Mutex
+	counts map[string]int
+}
+
+func (m *testingMetrics) RecordCache(cache, tier, result string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	if m.counts == nil {
+		m.counts = make(map[string]int)
+	}
+	m.counts[cache+":"+tier+":"+result]++
+}
+
+func (m *testingMetrics) count(key string) int {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.counts[key]
+}
+
+func newTestingCache(t *testing.T, server *miniredis.Miniredis, opts ...Option) *Cache {
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	opts = append([]Option{WithKeyPrefix("testing:cache:")}, opts...)
+	c, err := NewCache("user", client, opts...)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = c.Close()
+		_ = client.Close()
+	})
+	return c
+}
+
+// go test -v ./util/cache/ -count=1 -test.run=TestGetOrLoad
+func TestGetOrLoad(t *testing.T) {
+	server := miniredis.RunT(t)
+	metrics := &testingMetrics{}
+	c := newTestingCache(t, server, WithMetrics(metrics))
+	ctx := context.Background()
+
+	var loads atomic.Int64
+	release := make(chan struct{})
+	loader := func(ctx context.Context) (*testingUser, error) {
+		loads.Add(1)
+		<-release
+		return &testingUser{ID: 1, Name: "testing"}, nil
+	}
+
+	// 并发加载合并为一次
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			user, err := GetOrLoad(ctx, c, "1", loader)
+			require.NoError(t, err)
+			require.Equal(t, "testing", user.Name)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int64(1), loads.Load())
+	require.True(t, server.Exists("testing:cache:user:1"))
+	require.InDelta(t, DefaultTTL+DefaultStaleTTL, server.TTL("testing:cache:user:1"), float64(time.Second))
+
+	// 进程内命中
+	user, err := GetOrLoad(ctx, c, "1", loader)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), user.ID)
+	require.Equal(t, int64(1), loads.Load())
+	require.GreaterOrEqual(t, c.Stats().LocalHits, uint64(1))
+	require.GreaterOrEqual(t, metrics.count("user:local:hit"), 1)
+
+	// redis 命中
+	c.local.purge()
+	require.NoError(t, c.Get(ctx, "1", user))
+	require.Equal(t, uint64(1), c.Stats().RedisHits)
+	require.Equal(t, 1, metrics.count("user:redis:hit"))
+
+	// 未命中
+	require.ErrorIs(t, c.Get(ctx, "2", user), redis.Nil)
+
+	// 加载失败不写入缓存
+	_, err = GetOrLoad(ctx, c, "3", func(ctx context.Context) (*testingUser, error) {
+		return nil, pkgerrors.New("testing")
+	})
+	require.Error(t, err)
+	require.False(t, server.Exists("testing:cache:user:3"))
+	require.Equal(t, uint64(1), c.Stats().LoadErrors)
+}
+
+// go test -v ./util/cache/ -count=1 -test.run=TestGetOrLoad_Stale
+func TestGetOrLoad_Stale(t *testing.T) {
+	server := miniredis.RunT(t)
+	c := newTestingCache(t, server, WithTTL(50*time.Millisecond), WithStaleTTL(time.Minute))
+	ctx := context.Background()
+
+	var version atomic.Int64
+	release := make(chan struct{})
+	loader := func(ctx context.Context) (int64, error) {
+		v := version.Add(1)
+		if v > 1 {
+			<-release
+		}
+		return v, nil
+	}
+	v, err := GetOrLoad(ctx, c, "1", loader)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+
+	// 新鲜期后返回过期副本，并在后台刷新
+	time.Sleep(100 * time.Millisecond)
+	v, err = GetOrLoad(ctx, c, "1", loader)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+	v, err = GetOrLoad(ctx, c, "1", loader)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), v)
+	require.Equal(t, uint64(2), c.Stats().LocalStale)
+
+	close(release)
+	require.Eventually(t, func() bool {
+		v, err := GetOrLoad(ctx, c, "1", loader)
+		return err == nil && v == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, int64(2), version.Load())
+}
+
+// go test -v ./util/cache/ -count=1 -test.run=TestGetOrLoad_Cancel
+func TestGetOrLoad_Cancel(t *testing.T) {
+	server := miniredis.RunT(t)
+	c := newTestingCache(t, server)
+
+	var loads atomic.Int64
+	started := make(chan struct{})
+	release := make(chan struct{})
+	loader := func(ctx context.Context) (*testingUser, error) {
+		if loads.Add(1) == 1 {
+			close(started)
+		}
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		return &testingUser{ID: 1, Name: "user-1"}, nil
+	}
+
+	// 第一个调用方取消
+	firstCtx, cancel := context.WithCancel(context.Background())
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := GetOrLoad(firstCtx, c, "1", loader)
+		firstErr <- err
+	}()
+	<-started
+
+	// 第二个调用方合并到同一个加载
+	type result struct {
+		user *testingUser
+		err  error
+	}
+	second := make(chan result, 1)
+	go func() {
+		user, err := GetOrLoad(context.Background(), c, "1", loader)
+		second <- result{user: user, err: err}
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-firstErr, context.Canceled)
+	close(release)
+	r := <-second
+	require.NoError(t, r.err)
+	require.Equal(t, "user-1", r.user.Name)
+	require.Equal(t, int64(1), loads.Load())
+	require.True(t, server.Exists("testing:cache:user:1"))
+}
+
+// go test -v ./util/cache/ -count=1 -test.run=TestCache_Invalidate
+func TestCache_Invalidate(t *testing.T) {
+	server := miniredis.RunT(t)
+	c1 := newTestingCache(t, server)
+	c2 := newTestingCache(t, server)
+	ctx := context.Background()
+
+	require.NoError(t, c1.Set(ctx, "1", &testingUser{ID: 1, Name: "v1"}))
+	user := &testingUser{}
+	require.NoError(t, c2.Get(ctx, "1", user))
+	require.Equal(t, "v1", user.Name)
+	require.Equal(t, 1, c2.local.len())
+
+	// 其他副本删除进程内缓存
+	require.NoError(t, c1.Set(ctx, "1", &testingUser{ID: 1, Name: "v2"}))
+	require.Eventually(t, func() bool { return c2.local.len() == 0 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, c2.Get(ctx, "1", user))
+	require.Equal(t, "v2", user.Name)
+	require.Equal(t, uint64(0), c1.Stats().Invalidations)
+
+	require.NoError(t, c1.Delete(ctx, "1"))
+	require.Eventually(t, func() bool { return c2.local.len() == 0 }, time.Second, 10*time.Millisecond)
+	require.ErrorIs(t, c2.Get(ctx, "1", user), redis.Nil)
+	require.Equal(t, uint64(3), c2.Stats().Invalidations)
+}
+
+// go test -v ./util/cache/ -count=1 -test.run=TestProtoCodec
+func TestProtoCodec(t *testing.T) {
+	server := miniredis.RunT(t)
+	c := newTestingCache(t, server, WithCodec(ProtoCodec{}), WithLocalSize(0))
+	ctx := context.Background()
+
+	app := &configs.App{ProjectName: "testing-project", ServerName: "testing-service"}
+	got, err := GetOrLoad(ctx, c, "app", func(ctx context.Context) (*configs.App, error) {
+		return app, nil
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(app, got))
+
+	got, err = GetOrLoad(ctx, c, "app", func(ctx context.Context) (*configs.App, error) {
+		return nil, pkgerrors.New("testing")
+	})
+	require.NoError(t, err)
+	require.True(t, proto.Equal(app, got))
+	require.Equal(t, uint64(1), c.Stats().RedisHits)
+	require.Equal(t, uint64(0), c.Stats().LocalMisses)
+
+	_, err = ProtoCodec{}.Marshal(&testingUser{})
+	require.Error(t, err)
+}
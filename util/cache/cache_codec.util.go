@@ -0,0 +1,75 @@
+package cacheutil
+
+import (
+	"encoding/json"
+	"reflect"
+
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Codec 序列化
+type Codec interface {
+	// Name 名称
+	Name() string
+	Marshal(v interface{}) ([]byte, error)
+	// Unmarshal v 为指针
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// JSONCodec json
+type JSONCodec struct{}
+
+// Name ...
+func (JSONCodec) Name() string {
+	return "json"
+}
+
+// Marshal ...
+func (JSONCodec) Marshal(v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	return data, pkgerrors.WithStack(err)
+}
+
+// Unmarshal ...
+func (JSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return pkgerrors.WithStack(json.Unmarshal(data, v))
+}
+
+// ProtoCodec protobuf；值为 proto.Message；例：GetOrLoad[*pb.User]
+type ProtoCodec struct{}
+
+// Name ...
+func (ProtoCodec) Name() string {
+	return "proto"
+}
+
+// Marshal ...
+func (ProtoCodec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, pkgerrors.Errorf("cache : %T is not a proto.Message", v)
+	}
+	data, err := proto.Marshal(m)
+	return data, pkgerrors.WithStack(err)
+}
+
+// Unmarshal v 为 proto.Message，或指向 proto.Message 的指针(nil 时创建)
+func (ProtoCodec) Unmarshal(data []byte, v interface{}) error {
+	if m, ok := v.(proto.Message); ok {
+		return pkgerrors.WithStack(proto.Unmarshal(data, m))
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Ptr {
+		return pkgerrors.Errorf("cache : %T is not a proto.Message", v)
+	}
+	elem := rv.Elem()
+	if elem.IsNil() {
+		elem.Set(reflect.New(elem.Type().Elem()))
+	}
+	m, ok := elem.Interface().(proto.Message)
+	if !ok {
+		return pkgerrors.Errorf("cache : %T is not a proto.Message", v)
+	}
+	return pkgerrors.WithStack(proto.Unmarshal(data, m))
+}
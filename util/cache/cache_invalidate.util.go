@@ -0,0 +1,86 @@
+package cacheutil
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// subscribeTimeout 订阅的超时时间
+	subscribeTimeout = 5 * time.Second
+)
+
+// invalidation 失效广播
+type invalidation struct {
+	// Instance 发送的副本
+	Instance string   `json:"instance"`
+	Keys     []string `json:"keys"`
+}
+
+// channel 失效广播的频道
+func (c *Cache) channel() string {
+	return c.opts.keyPrefix + c.name + ":invalidate"
+}
+
+// publish 广播失效；其他副本删除进程内缓存
+func (c *Cache) publish(ctx context.Context, keys ...string) error {
+	message, err := json.Marshal(&invalidation{Instance: c.instanceID, Keys: keys})
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	return pkgerrors.WithStack(c.redisCC.Publish(ctx, c.channel(), message).Err())
+}
+
+// subscribe 订阅失效广播；未使用进程内缓存时不订阅；断线重连期间的广播会丢失，由 localTTL 兜底
+func (c *Cache) subscribe() error {
+	if c.local.size <= 0 {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(c.ctx, subscribeTimeout)
+	defer cancel()
+	c.pubsub = c.redisCC.Subscribe(ctx, c.channel())
+	if _, err := c.pubsub.Receive(ctx); err != nil {
+		_ = c.pubsub.Close()
+		return pkgerrors.WithMessage(err, "cache : subscribe failed")
+	}
+
+	messages := c.pubsub.Channel()
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		for {
+			select {
+			case <-c.ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+				c.invalidate(message.Payload)
+			}
+		}
+	}()
+	return nil
+}
+
+// invalidate 处理失效广播
+func (c *Cache) invalidate(payload string) {
+	msg := &invalidation{}
+	if err := json.Unmarshal([]byte(payload), msg); err != nil {
+		logpkg.Warnw(
+			"cache.name", c.name,
+			"cache.status", "invalid invalidation",
+			"cache.error", err.Error(),
+		)
+		return
+	}
+	if msg.Instance == c.instanceID {
+		return
+	}
+	c.stats.invalidations.Add(1)
+	c.local.delete(msg.Keys...)
+}
@@ -0,0 +1,127 @@
+package cacheutil
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// entry 缓存值
+type entry struct {
+	data []byte
+	// freshUntil 新鲜期；之后为过期副本，可在刷新期间返回
+	freshUntil time.Time
+	// staleUntil 过期副本的保留期
+	staleUntil time.Time
+}
+
+// isFresh ...
+func (e *entry) isFresh(now time.Time) bool {
+	return now.Before(e.freshUntil)
+}
+
+// localEntry 进程内缓存值
+type localEntry struct {
+	key string
+	entry
+	// expiresAt 进程内的过期时间：min(写入时间 + localTTL, staleUntil)
+	expiresAt time.Time
+}
+
+// localCache 进程内 LRU；size <= 0 时不缓存
+type localCache struct {
+	size int
+	ttl  time.Duration
+
+	mutex sync.Mutex
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+// newLocalCache ...
+func newLocalCache(size int, ttl time.Duration) *localCache {
+	return &localCache{
+		size:  size,
+		ttl:   ttl,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// get ...
+func (c *localCache) get(key string, now time.Time) (entry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	element, ok := c.items[key]
+	if !ok {
+		return entry{}, false
+	}
+	le := element.Value.(*localEntry)
+	if !now.Before(le.expiresAt) {
+		c.removeElement(element)
+		return entry{}, false
+	}
+	c.ll.MoveToFront(element)
+	return le.entry, true
+}
+
+// set ...
+func (c *localCache) set(key string, e entry, now time.Time) {
+	if c.size <= 0 {
+		return
+	}
+	expiresAt := now.Add(c.ttl)
+	if e.staleUntil.Before(expiresAt) {
+		expiresAt = e.staleUntil
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if element, ok := c.items[key]; ok {
+		le := element.Value.(*localEntry)
+		le.entry = e
+		le.expiresAt = expiresAt
+		c.ll.MoveToFront(element)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&localEntry{key: key, entry: e, expiresAt: expiresAt})
+	for c.ll.Len() > c.size {
+		c.removeElement(c.ll.Back())
+	}
+}
+
+// delete ...
+func (c *localCache) delete(keys ...string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, key := range keys {
+		if element, ok := c.items[key]; ok {
+			c.removeElement(element)
+		}
+	}
+}
+
+// purge ...
+func (c *localCache) purge() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.ll.Init()
+	c.items = make(map[string]*list.Element)
+}
+
+// len ...
+func (c *localCache) len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.ll.Len()
+}
+
+// removeElement ...
+func (c *localCache) removeElement(element *list.Element) {
+	c.ll.Remove(element)
+	delete(c.items, element.Value.(*localEntry).key)
+}
@@ -0,0 +1,396 @@
+package captchautil
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	enumv1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/enums"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultKeyPrefix redis键前缀
+	DefaultKeyPrefix = "captcha:"
+	// DefaultLength 验证码长度
+	DefaultLength = 6
+	// DefaultTTL 有效时间
+	DefaultTTL = 5 * time.Minute
+	// DefaultMaxVerifyAttempts 最大验证次数
+	DefaultMaxVerifyAttempts = 5
+	// DefaultResendInterval 同一个接收者的重新发送间隔
+	DefaultResendInterval = time.Minute
+	// DefaultImageSendLimit 同一个ip与用途在重新发送间隔内图片验证码的发送次数
+	DefaultImageSendLimit = 10
+	// DefaultTokenTTL 验证凭证的有效时间
+	DefaultTokenTTL = 5 * time.Minute
+)
+
+var (
+	// ErrInvalidType 不支持的验证码类型：未配置生成器，或短信、邮件未配置发送者
+	ErrInvalidType = pkgerrors.New("captcha : invalid type")
+	// ErrInvalidTarget 短信、邮件的接收者为空
+	ErrInvalidTarget = pkgerrors.New("captcha : invalid target")
+	// ErrNotFound 验证码不存在：已过期或已使用
+	ErrNotFound = pkgerrors.New("captcha : not found")
+	// ErrIncorrect 验证码错误；errors.As(err, *IncorrectError) 获取剩余次数
+	ErrIncorrect = pkgerrors.New("captcha : incorrect")
+	// ErrTooManyAttempts 验证次数过多；验证码已失效
+	ErrTooManyAttempts = pkgerrors.New("captcha : too many attempts")
+	// ErrSendTooFrequent 发送过于频繁；errors.As(err, *ResendError) 获取等待时间
+	ErrSendTooFrequent = pkgerrors.New("captcha : send too frequent")
+	// ErrSendFailed 发送失败
+	ErrSendFailed = pkgerrors.New("captcha : send failed")
+	// ErrTokenDisabled 未配置验证凭证的签名密钥
+	ErrTokenDisabled = pkgerrors.New("captcha : token secret is not configured")
+	// ErrInvalidToken 验证凭证无效：签名错误、已过期、已使用，或接收者与用途不一致
+	ErrInvalidToken = pkgerrors.New("captcha : invalid token")
+)
+
+var (
+	// _verifyScript 验证；返回 0:成功 -1:不存在 -2:次数过多 >0:错误，剩余次数
+	_verifyScript = redis.NewScript(`
+local stored = redis.call("HMGET", KEYS[1], "code", "target", "purpose")
+if not stored[1] then
+	return -1
+end
+if stored[1] == ARGV[1] and stored[2] == ARGV[2] and stored[3] == ARGV[3] then
+	redis.call("DEL", KEYS[1])
+	return 0
+end
+local attempts = redis.call("HINCRBY", KEYS[1], "attempts", 1)
+local remaining = tonumber(ARGV[4]) - attempts
+if remaining <= 0 then
+	redis.call("DEL", KEYS[1])
+	return -2
+end
+return remaining
+`)
+	// _sendCountScript 发送次数；返回 {count, ttl_ms}
+	_sendCountScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+	ttl = tonumber(ARGV[1])
+end
+return {count, ttl}
+`)
+)
+
+// IncorrectError 验证码错误
+type IncorrectError struct {
+	// RemainingAttempts 失效前的剩余次数
+	RemainingAttempts int64
+}
+
+// Error ...
+func (e *IncorrectError) Error() string {
+	return fmt.Sprintf("captcha : incorrect, %d attempts remaining", e.RemainingAttempts)
+}
+
+// Is errors.Is(err, ErrIncorrect)
+func (e *IncorrectError) Is(target error) bool {
+	return target == ErrIncorrect
+}
+
+// AsIncorrectError 获取剩余次数
+func AsIncorrectError(err error) (*IncorrectError, bool) {
+	var e *IncorrectError
+	ok := pkgerrors.As(err, &e)
+	return e, ok
+}
+
+// ResendError 发送过于频繁
+type ResendError struct {
+	// RetryAfter 重新发送的等待时间
+	RetryAfter time.Duration
+}
+
+// Error ...
+func (e *ResendError) Error() string {
+	return fmt.Sprintf("captcha : send too frequent, retry after %s", e.RetryAfter)
+}
+
+// Is errors.Is(err, ErrSendTooFrequent)
+func (e *ResendError) Is(target error) bool {
+	return target == ErrSendTooFrequent
+}
+
+// AsResendError 获取等待时间
+func AsResendError(err error) (*ResendError, bool) {
+	var e *ResendError
+	ok := pkgerrors.As(err, &e)
+	return e, ok
+}
+
+// Sender 发送短信、邮件
+type Sender interface {
+	Send(ctx context.Context, target, code string, ttl time.Duration) error
+}
+
+// SenderFunc ...
+type SenderFunc func(ctx context.Context, target, code string, ttl time.Duration) error
+
+// Send ...
+func (f SenderFunc) Send(ctx context.Context, target, code string, ttl time.Duration) error {
+	return f(ctx, target, code, ttl)
+}
+
+// Config 规则；对应 configs.Setting_Captcha
+type Config struct {
+	// Length 验证码长度；默认 DefaultLength
+	Length int
+	// TTL 有效时间；默认 DefaultTTL
+	TTL time.Duration
+	// MaxVerifyAttempts 最大验证次数；默认 DefaultMaxVerifyAttempts
+	MaxVerifyAttempts int64
+	// ResendInterval 同一个接收者的重新发送间隔；默认 DefaultResendInterval
+	ResendInterval time.Duration
+	// ImageSendLimit 同一个ip与用途在 ResendInterval 内图片验证码的发送次数；默认 DefaultImageSendLimit
+	ImageSendLimit int64
+	// TokenTTL 验证凭证的有效时间；默认 DefaultTokenTTL
+	TokenTTL time.Duration
+}
+
+// options 可选项
+type options struct {
+	keyPrefix   string
+	generators  map[enumv1.CaptchaTypeEnum_CaptchaType]Generator
+	senders     map[enumv1.CaptchaTypeEnum_CaptchaType]Sender
+	tokenSecret []byte
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithKeyPrefix redis键前缀；例：go-srv-saas:DEVELOP:user-service:captcha:
+func WithKeyPrefix(keyPrefix string) Option {
+	return func(o *options) {
+		o.keyPrefix = keyPrefix
+	}
+}
+
+// WithGenerator 生成器；默认 短信、邮件：NumericGenerator，图片：ImageGenerator
+func WithGenerator(captchaType enumv1.CaptchaTypeEnum_CaptchaType, generator Generator) Option {
+	return func(o *options) {
+		o.generators[captchaType] = generator
+	}
+}
+
+// WithSender 发送者；短信、邮件需配置
+func WithSender(captchaType enumv1.CaptchaTypeEnum_CaptchaType, sender Sender) Option {
+	return func(o *options) {
+		o.senders[captchaType] = sender
+	}
+}
+
+// WithTokenSecret 验证凭证的签名密钥(HMAC-SHA256)；未配置时 VerifyAndIssueToken、VerifyToken 返回 ErrTokenDisabled
+func WithTokenSecret(secret string) Option {
+	return func(o *options) {
+		o.tokenSecret = []byte(secret)
+	}
+}
+
+// SendRequest 发送验证码
+type SendRequest struct {
+	Type enumv1.CaptchaTypeEnum_CaptchaType
+	// Target 接收者：手机号、邮箱；图片验证码为空
+	Target string
+	// Purpose 用途；例：login、register；验证时需一致
+	Purpose string
+	// ClientIP 请求方的ip；图片验证码按ip与用途限制发送次数
+	ClientIP string
+}
+
+// SendResult 发送验证码
+type SendResult struct {
+	// CaptchaID 验证码ID
+	CaptchaID string
+	// ExpiresIn 有效时间
+	ExpiresIn time.Duration
+	// ResendAfter 重新发送的等待时间；图片验证码为 0
+	ResendAfter time.Duration
+	// Image 图片验证码(png)
+	Image []byte
+}
+
+// VerifyRequest 验证验证码
+type VerifyRequest struct {
+	CaptchaID string
+	Code      string
+	// Target 接收者；与发送时一致
+	Target string
+	// Purpose 用途；与发送时一致
+	Purpose string
+}
+
+// Service 验证码
+type Service struct {
+	redisCC redis.UniversalClient
+	cfg     Config
+	opts    *options
+	// now 当前时间；测试时替换
+	now func() time.Time
+}
+
+// NewService 验证码
+func NewService(redisCC redis.UniversalClient, cfg Config, opts ...Option) *Service {
+	serviceOpts := &options{
+		keyPrefix: DefaultKeyPrefix,
+		generators: map[enumv1.CaptchaTypeEnum_CaptchaType]Generator{
+			enumv1.CaptchaTypeEnum_SMS:   NumericGenerator{},
+			enumv1.CaptchaTypeEnum_EMAIL: NumericGenerator{},
+			enumv1.CaptchaTypeEnum_IMAGE: ImageGenerator{},
+		},
+		senders: make(map[enumv1.CaptchaTypeEnum_CaptchaType]Sender),
+	}
+	for i := range opts {
+		opts[i](serviceOpts)
+	}
+	if cfg.Length <= 0 {
+		cfg.Length = DefaultLength
+	}
+	if cfg.TTL <= 0 {
+		cfg.TTL = DefaultTTL
+	}
+	if cfg.MaxVerifyAttempts <= 0 {
+		cfg.MaxVerifyAttempts = DefaultMaxVerifyAttempts
+	}
+	if cfg.ResendInterval <= 0 {
+		cfg.ResendInterval = DefaultResendInterval
+	}
+	if cfg.ImageSendLimit <= 0 {
+		cfg.ImageSendLimit = DefaultImageSendLimit
+	}
+	if cfg.TokenTTL <= 0 {
+		cfg.TokenTTL = DefaultTokenTTL
+	}
+	return &Service{
+		redisCC: redisCC,
+		cfg:     cfg,
+		opts:    serviceOpts,
+		now:     time.Now,
+	}
+}
+
+// Send 生成并发送验证码；短信、邮件：同一个接收者与用途在 ResendInterval 内仅发送一次；
+// 图片：同一个ip与用途在 ResendInterval 内发送 ImageSendLimit 次
+func (s *Service) Send(ctx context.Context, req *SendRequest) (*SendResult, error) {
+	generator, ok := s.opts.generators[req.Type]
+	if !ok || generator == nil {
+		return nil, pkgerrors.WithMessage(ErrInvalidType, req.Type.String())
+	}
+	sender, needSend := s.opts.senders[req.Type], req.Type != enumv1.CaptchaTypeEnum_IMAGE
+	if needSend {
+		if sender == nil {
+			return nil, pkgerrors.WithMessage(ErrInvalidType, req.Type.String()+" : sender is not configured")
+		}
+		if req.Target == "" {
+			return nil, ErrInvalidTarget
+		}
+	}
+
+	result := &SendResult{
+		CaptchaID: uuid.NewString(),
+		ExpiresIn: s.cfg.TTL,
+	}
+
+	// 重新发送间隔；先于生成，拒绝的请求不生成图片
+	resendKey := s.resendKey(req)
+	if needSend {
+		ok, err := s.redisCC.SetNX(ctx, resendKey, result.CaptchaID, s.cfg.ResendInterval).Result()
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		if !ok {
+			retryAfter, err := s.redisCC.PTTL(ctx, resendKey).Result()
+			if err != nil {
+				return nil, pkgerrors.WithStack(err)
+			}
+			return nil, &ResendError{RetryAfter: max(retryAfter, 0)}
+		}
+		result.ResendAfter = s.cfg.ResendInterval
+	} else {
+		values, err := _sendCountScript.Run(ctx, s.redisCC, []string{resendKey}, s.cfg.ResendInterval.Milliseconds()).Int64Slice()
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		if len(values) != 2 {
+			return nil, pkgerrors.Errorf("captcha : unexpected script result %v", values)
+		}
+		if values[0] > s.cfg.ImageSendLimit {
+			return nil, &ResendError{RetryAfter: max(time.Duration(values[1])*time.Millisecond, 0)}
+		}
+	}
+
+	code, image, err := generator.Generate(s.cfg.Length)
+	if err != nil {
+		if needSend {
+			_ = s.redisCC.Del(ctx, resendKey).Err()
+		}
+		return nil, err
+	}
+	result.Image = image
+
+	codeKey := s.codeKey(result.CaptchaID)
+	_, err = s.redisCC.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSet(ctx, codeKey, "code", code, "target", req.Target, "purpose", req.Purpose, "attempts", 0)
+		pipe.PExpire(ctx, codeKey, s.cfg.TTL)
+		return nil
+	})
+	if err != nil {
+		if needSend {
+			_ = s.redisCC.Del(ctx, resendKey).Err()
+		}
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	if needSend {
+		if err = sender.Send(ctx, req.Target, code, s.cfg.TTL); err != nil {
+			// 发送失败时可立即重新发送
+			_ = s.redisCC.Del(ctx, codeKey, resendKey).Err()
+			return nil, pkgerrors.WithMessage(ErrSendFailed, err.Error())
+		}
+	}
+	return result, nil
+}
+
+// Verify 验证；成功后失效，错误达到 MaxVerifyAttempts 次后失效
+func (s *Service) Verify(ctx context.Context, req *VerifyRequest) error {
+	code := strings.TrimSpace(req.Code)
+	result, err := _verifyScript.Run(ctx, s.redisCC, []string{s.codeKey(req.CaptchaID)},
+		code, req.Target, req.Purpose, s.cfg.MaxVerifyAttempts,
+	).Int64()
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	switch {
+	case result == 0:
+		return nil
+	case result == -1:
+		return ErrNotFound
+	case result == -2:
+		return ErrTooManyAttempts
+	default:
+		return &IncorrectError{RemainingAttempts: result}
+	}
+}
+
+// codeKey ...
+func (s *Service) codeKey(captchaID string) string {
+	return s.opts.keyPrefix + "code:" + captchaID
+}
+
+// resendKey 接收者与用途；图片验证码为ip与用途
+func (s *Service) resendKey(req *SendRequest) string {
+	target := req.Target
+	if req.Type == enumv1.CaptchaTypeEnum_IMAGE {
+		target = "ip:" + req.ClientIP
+	}
+	return s.opts.keyPrefix + "resend:" + strconv.Itoa(int(req.Type)) + ":" + req.Purpose + ":" + target
+}
@@ -0,0 +1,244 @@
+package captchautil
+
+import (
+	"bytes"
+	"context"
+	"image/png"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	enumv1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/enums"
+	errorv1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/errors"
+	resourcev1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/resources"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// testingSender 记录发送的验证码
+type testingSender struct {
+	codes map[string]string
+	err   error
+}
+
+func (s *testingSender) Send(ctx context.Context, target, code string, ttl time.Duration) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.codes[target] = code
+	return nil
+}
+
+func newTestingService(t *testing.T, server *miniredis.Miniredis, cfg Config) (*Service, *testingSender) {
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	sender := &testingSender{codes: make(map[string]string)}
+	service := NewService(client, cfg,
+		WithKeyPrefix("testing:captcha:"),
+		WithSender(enumv1.CaptchaTypeEnum_SMS, sender),
+		WithTokenSecret("testing-secret"),
+	)
+	return service, sender
+}
+
+// go test -v ./util/captcha/ -count=1 -test.run=TestService_SendAndVerify
+func TestService_SendAndVerify(t *testing.T) {
+	server := miniredis.RunT(t)
+	service, sender := newTestingService(t, server, Config{
+		MaxVerifyAttempts: 3,
+		ResendInterval:    time.Minute,
+	})
+	ctx := context.Background()
+
+	req := &SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Target: "13800000000", Purpose: "login"}
+	result, err := service.Send(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, DefaultTTL, result.ExpiresIn)
+	require.Equal(t, time.Minute, result.ResendAfter)
+	code := sender.codes[req.Target]
+	require.Len(t, code, DefaultLength)
+
+	// 重新发送间隔
+	_, err = service.Send(ctx, req)
+	require.ErrorIs(t, err, ErrSendTooFrequent)
+	resendErr, ok := AsResendError(err)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, resendErr.RetryAfter)
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Target: req.Target, Purpose: "register"})
+	require.NoError(t, err)
+
+	// 用途不一致、验证码错误
+	verifyReq := &VerifyRequest{CaptchaID: result.CaptchaID, Code: code, Target: req.Target, Purpose: "register"}
+	err = service.Verify(ctx, verifyReq)
+	incorrectErr, ok := AsIncorrectError(err)
+	require.True(t, ok)
+	require.Equal(t, int64(2), incorrectErr.RemainingAttempts)
+
+	// 一次性
+	verifyReq.Purpose = req.Purpose
+	require.NoError(t, service.Verify(ctx, verifyReq))
+	require.ErrorIs(t, service.Verify(ctx, verifyReq), ErrNotFound)
+
+	// 过期
+	server.FastForward(time.Minute)
+	result, err = service.Send(ctx, req)
+	require.NoError(t, err)
+	server.FastForward(DefaultTTL)
+	err = service.Verify(ctx, &VerifyRequest{CaptchaID: result.CaptchaID, Code: sender.codes[req.Target], Target: req.Target, Purpose: req.Purpose})
+	require.ErrorIs(t, err, ErrNotFound)
+
+	// 参数
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Purpose: "login"})
+	require.ErrorIs(t, err, ErrInvalidTarget)
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_EMAIL, Target: "a@b.c"})
+	require.ErrorIs(t, err, ErrInvalidType)
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_UNSPECIFIED})
+	require.ErrorIs(t, err, ErrInvalidType)
+}
+
+// go test -v ./util/captcha/ -count=1 -test.run=TestService_TooManyAttempts
+func TestService_TooManyAttempts(t *testing.T) {
+	server := miniredis.RunT(t)
+	service, sender := newTestingService(t, server, Config{MaxVerifyAttempts: 2})
+	ctx := context.Background()
+
+	req := &SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Target: "13800000000"}
+	result, err := service.Send(ctx, req)
+	require.NoError(t, err)
+
+	verifyReq := &VerifyRequest{CaptchaID: result.CaptchaID, Code: "wrong", Target: req.Target}
+	require.ErrorIs(t, service.Verify(ctx, verifyReq), ErrIncorrect)
+	require.ErrorIs(t, service.Verify(ctx, verifyReq), ErrTooManyAttempts)
+	verifyReq.Code = sender.codes[req.Target]
+	require.ErrorIs(t, service.Verify(ctx, verifyReq), ErrNotFound)
+
+	// 发送失败：可立即重新发送
+	sender.err = pkgerrors.New("testing")
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Target: "13900000000"})
+	require.ErrorIs(t, err, ErrSendFailed)
+	sender.err = nil
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Target: "13900000000"})
+	require.NoError(t, err)
+}
+
+// go test -v ./util/captcha/ -count=1 -test.run=TestServer_Image
+func TestServer_Image(t *testing.T) {
+	server := miniredis.RunT(t)
+	service, _ := newTestingService(t, server, Config{Length: 4})
+	srv := NewServer(service)
+	ctx := context.Background()
+
+	resp, err := srv.SendCaptcha(ctx, &resourcev1.SendCaptchaReq{CaptchaType: enumv1.CaptchaTypeEnum_IMAGE})
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), resp.GetResendAfter().AsDuration())
+	img, err := png.Decode(bytes.NewReader(resp.GetImage()))
+	require.NoError(t, err)
+	require.Equal(t, DefaultImageWidth, img.Bounds().Dx())
+	require.Equal(t, DefaultImageHeight, img.Bounds().Dy())
+
+	_, err = srv.VerifyCaptcha(ctx, &resourcev1.VerifyCaptchaReq{CaptchaId: resp.GetCaptchaId(), Code: "x"})
+	require.True(t, errorv1.IsCaptchaIncorrect(err))
+	require.Equal(t, "4", errors.FromError(err).Metadata[RemainingAttemptsMetadataKey])
+
+	// 错误转换
+	_, err = srv.SendCaptcha(ctx, &resourcev1.SendCaptchaReq{CaptchaType: enumv1.CaptchaTypeEnum_SMS, Target: "13800000000"})
+	require.NoError(t, err)
+	_, err = srv.SendCaptcha(ctx, &resourcev1.SendCaptchaReq{CaptchaType: enumv1.CaptchaTypeEnum_SMS, Target: "13800000000"})
+	require.True(t, errorv1.IsCaptchaSendTooFrequent(err))
+	require.Equal(t, "60", errors.FromError(err).Metadata[RetryAfterMetadataKey])
+	_, err = srv.VerifyCaptcha(ctx, &resourcev1.VerifyCaptchaReq{CaptchaId: "not-found"})
+	require.True(t, errorv1.IsCaptchaNotFound(err))
+	require.True(t, errorv1.IsCaptchaInvalidToken(ToError(ErrInvalidToken)))
+}
+
+// go test -v ./util/captcha/ -count=1 -test.run=TestService_ImageSendLimit
+func TestService_ImageSendLimit(t *testing.T) {
+	server := miniredis.RunT(t)
+	service, _ := newTestingService(t, server, Config{ImageSendLimit: 2, ResendInterval: time.Minute})
+	ctx := context.Background()
+
+	req := &SendRequest{Type: enumv1.CaptchaTypeEnum_IMAGE, Purpose: "login", ClientIP: "10.0.0.1"}
+	for i := 0; i < 2; i++ {
+		_, err := service.Send(ctx, req)
+		require.NoError(t, err)
+	}
+	_, err := service.Send(ctx, req)
+	resendErr, ok := AsResendError(err)
+	require.True(t, ok)
+	require.Equal(t, time.Minute, resendErr.RetryAfter)
+
+	// 其他ip、用途
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_IMAGE, Purpose: "login", ClientIP: "10.0.0.2"})
+	require.NoError(t, err)
+	_, err = service.Send(ctx, &SendRequest{Type: enumv1.CaptchaTypeEnum_IMAGE, Purpose: "register", ClientIP: req.ClientIP})
+	require.NoError(t, err)
+
+	server.FastForward(time.Minute)
+	_, err = service.Send(ctx, req)
+	require.NoError(t, err)
+}
+
+// go test -v ./util/captcha/ -count=1 -test.run=TestService_Token
+func TestService_Token(t *testing.T) {
+	server := miniredis.RunT(t)
+	service, sender := newTestingService(t, server, Config{TokenTTL: time.Minute})
+	srv := NewServer(service)
+	ctx := context.Background()
+
+	req := &SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Target: "13800000000", Purpose: "login"}
+	result, err := service.Send(ctx, req)
+	require.NoError(t, err)
+	resp, err := srv.VerifyCaptcha(ctx, &resourcev1.VerifyCaptchaReq{
+		CaptchaId: result.CaptchaID,
+		Code:      sender.codes[req.Target],
+		Target:    req.Target,
+		Purpose:   req.Purpose,
+	})
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, resp.GetExpiresIn().AsDuration())
+	token := resp.GetVerificationToken()
+
+	// 接收者、用途不一致；篡改
+	require.ErrorIs(t, service.VerifyToken(ctx, &VerifyTokenRequest{Token: token, Target: "13900000000", Purpose: req.Purpose}), ErrInvalidToken)
+	require.ErrorIs(t, service.VerifyToken(ctx, &VerifyTokenRequest{Token: token, Target: req.Target, Purpose: "register"}), ErrInvalidToken)
+	require.ErrorIs(t, service.VerifyToken(ctx, &VerifyTokenRequest{Token: token + "x", Target: req.Target, Purpose: req.Purpose}), ErrInvalidToken)
+	require.ErrorIs(t, service.VerifyToken(ctx, &VerifyTokenRequest{Token: "invalid", Target: req.Target, Purpose: req.Purpose}), ErrInvalidToken)
+
+	// 一次性
+	tokenReq := &VerifyTokenRequest{Token: token, Target: req.Target, Purpose: req.Purpose}
+	require.NoError(t, service.VerifyToken(ctx, tokenReq))
+	require.ErrorIs(t, service.VerifyToken(ctx, tokenReq), ErrInvalidToken)
+
+	// 过期
+	server.FastForward(time.Minute)
+	result, err = service.Send(ctx, req)
+	require.NoError(t, err)
+	issued, err := service.VerifyAndIssueToken(ctx, &VerifyRequest{CaptchaID: result.CaptchaID, Code: sender.codes[req.Target], Target: req.Target, Purpose: req.Purpose})
+	require.NoError(t, err)
+	service.now = func() time.Time { return time.Now().Add(time.Minute) }
+	require.ErrorIs(t, service.VerifyToken(ctx, &VerifyTokenRequest{Token: issued.Token, Target: req.Target, Purpose: req.Purpose}), ErrInvalidToken)
+
+	// 未配置签名密钥
+	disabled := NewService(service.redisCC, Config{})
+	_, err = disabled.VerifyAndIssueToken(ctx, &VerifyRequest{})
+	require.ErrorIs(t, err, ErrTokenDisabled)
+	require.ErrorIs(t, disabled.VerifyToken(ctx, tokenReq), ErrTokenDisabled)
+}
+
+// go test -v ./util/captcha/ -count=1 -test.run=TestGenerator
+func TestGenerator(t *testing.T) {
+	code, image, err := NumericGenerator{}.Generate(8)
+	require.NoError(t, err)
+	require.Len(t, code, 8)
+	require.Nil(t, image)
+
+	code, image, err = ImageGenerator{Width: 200, Height: 60}.Generate(5)
+	require.NoError(t, err)
+	require.Len(t, code, 5)
+	img, err := png.Decode(bytes.NewReader(image))
+	require.NoError(t, err)
+	require.Equal(t, 200, img.Bounds().Dx())
+	require.Equal(t, 60, img.Bounds().Dy())
+}
@@ -0,0 +1,248 @@
+package captchautil
+
+import (
+	"bytes"
+	"crypto/rand"
+	"image"
+	"image/color"
+	"image/png"
+	"math/big"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// DefaultImageWidth 图片验证码的宽度
+	DefaultImageWidth = 120
+	// DefaultImageHeight 图片验证码的高度
+	DefaultImageHeight = 40
+
+	// digits 验证码字符
+	digits = "0123456789"
+)
+
+// Generator 生成验证码
+type Generator interface {
+	// Generate 生成验证码；image 为图片验证码(png)，其他类型为 nil
+	Generate(length int) (code string, image []byte, err error)
+}
+
+// NumericGenerator 数字验证码；短信、邮件
+type NumericGenerator struct{}
+
+// Generate ...
+func (NumericGenerator) Generate(length int) (string, []byte, error) {
+	code, err := randomDigits(length)
+	return code, nil, err
+}
+
+// ImageGenerator 图片验证码；数字，添加干扰线与噪点
+type ImageGenerator struct {
+	// Width 宽度；默认 DefaultImageWidth
+	Width int
+	// Height 高度；默认 DefaultImageHeight
+	Height int
+}
+
+// Generate ...
+func (g ImageGenerator) Generate(length int) (string, []byte, error) {
+	code, err := randomDigits(length)
+	if err != nil {
+		return "", nil, err
+	}
+	width, height := g.Width, g.Height
+	if width <= 0 {
+		width = DefaultImageWidth
+	}
+	if height <= 0 {
+		height = DefaultImageHeight
+	}
+	img, err := renderImage(code, width, height)
+	if err != nil {
+		return "", nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err = png.Encode(buf, img); err != nil {
+		return "", nil, pkgerrors.WithStack(err)
+	}
+	return code, buf.Bytes(), nil
+}
+
+// randomDigits ...
+func randomDigits(length int) (string, error) {
+	code := make([]byte, length)
+	for i := range code {
+		n, err := randomInt(len(digits))
+		if err != nil {
+			return "", err
+		}
+		code[i] = digits[n]
+	}
+	return string(code), nil
+}
+
+// randomInt [0, n)
+func randomInt(n int) (int, error) {
+	if n <= 0 {
+		return 0, nil
+	}
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, pkgerrors.WithStack(err)
+	}
+	return int(v.Int64()), nil
+}
+
+// glyphs 5x7 点阵数字
+var glyphs = map[byte][7]string{
+	'0': {"01110", "10001", "10011", "10101", "11001", "10001", "01110"},
+	'1': {"00100", "01100", "00100", "00100", "00100", "00100", "01110"},
+	'2': {"01110", "10001", "00001", "00010", "00100", "01000", "11111"},
+	'3': {"11111", "00010", "00100", "00010", "00001", "10001", "01110"},
+	'4': {"00010", "00110", "01010", "10010", "11111", "00010", "00010"},
+	'5': {"11111", "10000", "11110", "00001", "00001", "10001", "01110"},
+	'6': {"00110", "01000", "10000", "11110", "10001", "10001", "01110"},
+	'7': {"11111", "00001", "00010", "00100", "01000", "01000", "01000"},
+	'8': {"01110", "10001", "10001", "01110", "10001", "10001", "01110"},
+	'9': {"01110", "10001", "10001", "01111", "00001", "00010", "01100"},
+}
+
+// renderImage 绘制：每个字符随机偏移与颜色，之后添加干扰线与噪点
+func renderImage(code string, width, height int) (*image.RGBA, error) {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	background := color.RGBA{R: 245, G: 245, B: 240, A: 255}
+	for x := 0; x < width; x++ {
+		for y := 0; y < height; y++ {
+			img.Set(x, y, background)
+		}
+	}
+	if len(code) == 0 {
+		return img, nil
+	}
+
+	cellWidth := width / len(code)
+	scale := min(cellWidth/6, height/9)
+	if scale < 1 {
+		scale = 1
+	}
+	for i := 0; i < len(code); i++ {
+		glyph := glyphs[code[i]]
+		jitterX, err := randomInt(max(cellWidth-5*scale, 1))
+		if err != nil {
+			return nil, err
+		}
+		jitterY, err := randomInt(max(height-7*scale, 1))
+		if err != nil {
+			return nil, err
+		}
+		c, err := randomColor(20, 140)
+		if err != nil {
+			return nil, err
+		}
+		originX, originY := i*cellWidth+jitterX, jitterY
+		for row := range glyph {
+			for col := 0; col < len(glyph[row]); col++ {
+				if glyph[row][col] != '1' {
+					continue
+				}
+				fillRect(img, originX+col*scale, originY+row*scale, scale, scale, c)
+			}
+		}
+	}
+
+	// 干扰线
+	for i := 0; i < len(code); i++ {
+		points := make([]int, 4)
+		for j := range points {
+			bound := width
+			if j%2 == 1 {
+				bound = height
+			}
+			v, err := randomInt(bound)
+			if err != nil {
+				return nil, err
+			}
+			points[j] = v
+		}
+		c, err := randomColor(60, 180)
+		if err != nil {
+			return nil, err
+		}
+		drawLine(img, points[0], points[1], points[2], points[3], c)
+	}
+	// 噪点
+	for i := 0; i < width*height/20; i++ {
+		x, err := randomInt(width)
+		if err != nil {
+			return nil, err
+		}
+		y, err := randomInt(height)
+		if err != nil {
+			return nil, err
+		}
+		c, err := randomColor(80, 220)
+		if err != nil {
+			return nil, err
+		}
+		img.Set(x, y, c)
+	}
+	return img, nil
+}
+
+// randomColor 各通道 [low, high)
+func randomColor(low, high int) (color.RGBA, error) {
+	var channels [3]uint8
+	for i := range channels {
+		v, err := randomInt(high - low)
+		if err != nil {
+			return color.RGBA{}, err
+		}
+		channels[i] = uint8(low + v)
+	}
+	return color.RGBA{R: channels[0], G: channels[1], B: channels[2], A: 255}, nil
+}
+
+// fillRect ...
+func fillRect(img *image.RGBA, x, y, w, h int, c color.Color) {
+	for i := x; i < x+w; i++ {
+		for j := y; j < y+h; j++ {
+			img.Set(i, j, c)
+		}
+	}
+}
+
+// drawLine Bresenham
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.Color) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+// abs ...
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
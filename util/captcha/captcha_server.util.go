@@ -0,0 +1,128 @@
+package captchautil
+
+import (
+	"context"
+	"math"
+	"strconv"
+
+	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
+	errorv1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/errors"
+	resourcev1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/resources"
+	servicev1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/services"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	// RetryAfterMetadataKey 发送过于频繁时，错误元数据中的等待秒数
+	RetryAfterMetadataKey = "retry_after"
+	// RemainingAttemptsMetadataKey 验证码错误时，错误元数据中的剩余次数
+	RemainingAttemptsMetadataKey = "remaining_attempts"
+)
+
+var (
+	_ servicev1.SrvCaptchaV1Server     = (*Server)(nil)
+	_ servicev1.SrvCaptchaV1HTTPServer = (*Server)(nil)
+)
+
+// Server 验证码服务；servicev1.RegisterSrvCaptchaV1Server、servicev1.RegisterSrvCaptchaV1HTTPServer
+type Server struct {
+	servicev1.UnimplementedSrvCaptchaV1Server
+
+	service  *Service
+	clientIP func(ctx context.Context) string
+}
+
+// ServerOption 验证码服务可选项
+type ServerOption func(*Server)
+
+// WithClientIP 请求方的ip；默认 apputil.RemoteIP(连接的对端地址)
+func WithClientIP(clientIP func(ctx context.Context) string) ServerOption {
+	return func(s *Server) {
+		s.clientIP = clientIP
+	}
+}
+
+// NewServer 验证码服务
+func NewServer(service *Service, opts ...ServerOption) *Server {
+	s := &Server{
+		service:  service,
+		clientIP: apputil.RemoteIP,
+	}
+	for i := range opts {
+		opts[i](s)
+	}
+	return s
+}
+
+// SendCaptcha 发送验证码
+func (s *Server) SendCaptcha(ctx context.Context, in *resourcev1.SendCaptchaReq) (*resourcev1.SendCaptchaResp, error) {
+	result, err := s.service.Send(ctx, &SendRequest{
+		Type:     in.GetCaptchaType(),
+		Target:   in.GetTarget(),
+		Purpose:  in.GetPurpose(),
+		ClientIP: s.clientIP(ctx),
+	})
+	if err != nil {
+		return nil, ToError(err)
+	}
+	return &resourcev1.SendCaptchaResp{
+		CaptchaId:   result.CaptchaID,
+		ExpiresIn:   durationpb.New(result.ExpiresIn),
+		ResendAfter: durationpb.New(result.ResendAfter),
+		Image:       result.Image,
+	}, nil
+}
+
+// VerifyCaptcha 验证验证码；成功后返回验证凭证，业务接口使用 Service.VerifyToken 验证；需配置 WithTokenSecret
+func (s *Server) VerifyCaptcha(ctx context.Context, in *resourcev1.VerifyCaptchaReq) (*resourcev1.VerifyCaptchaResp, error) {
+	token, err := s.service.VerifyAndIssueToken(ctx, &VerifyRequest{
+		CaptchaID: in.GetCaptchaId(),
+		Code:      in.GetCode(),
+		Target:    in.GetTarget(),
+		Purpose:   in.GetPurpose(),
+	})
+	if err != nil {
+		return nil, ToError(err)
+	}
+	return &resourcev1.VerifyCaptchaResp{
+		VerificationToken: token.Token,
+		ExpiresIn:         durationpb.New(token.ExpiresIn),
+	}, nil
+}
+
+// ToError 转换为 errorv1 错误；其他错误原样返回
+func ToError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if resendErr, ok := AsResendError(err); ok {
+		retryAfter := strconv.FormatInt(int64(math.Ceil(resendErr.RetryAfter.Seconds())), 10)
+		e := errorv1.ErrorCaptchaSendTooFrequent("发送过于频繁，请稍后再试")
+		e = e.WithMetadata(map[string]string{RetryAfterMetadataKey: retryAfter})
+		return errorpkg.WithStack(e)
+	}
+	if incorrectErr, ok := AsIncorrectError(err); ok {
+		e := errorv1.ErrorCaptchaIncorrect("验证码错误")
+		e = e.WithMetadata(map[string]string{
+			RemainingAttemptsMetadataKey: strconv.FormatInt(incorrectErr.RemainingAttempts, 10),
+		})
+		return errorpkg.WithStack(e)
+	}
+	switch {
+	case pkgerrors.Is(err, ErrInvalidType):
+		return errorpkg.WithStack(errorv1.ErrorCaptchaInvalidType("不支持的验证码类型"))
+	case pkgerrors.Is(err, ErrInvalidTarget):
+		return errorpkg.WithStack(errorv1.ErrorCaptchaInvalidTarget("接收者不能为空"))
+	case pkgerrors.Is(err, ErrNotFound):
+		return errorpkg.WithStack(errorv1.ErrorCaptchaNotFound("验证码已过期或不存在"))
+	case pkgerrors.Is(err, ErrTooManyAttempts):
+		return errorpkg.WithStack(errorv1.ErrorCaptchaTooManyAttempts("验证码错误次数过多，请重新获取"))
+	case pkgerrors.Is(err, ErrSendFailed):
+		return errorpkg.WithStack(errorv1.ErrorCaptchaSendFailed("验证码发送失败"))
+	case pkgerrors.Is(err, ErrInvalidToken):
+		return errorpkg.WithStack(errorv1.ErrorCaptchaInvalidToken("验证凭证无效，请重新验证"))
+	}
+	return err
+}
@@ -0,0 +1,113 @@
+package captchautil
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// VerificationToken 验证凭证
+type VerificationToken struct {
+	// Token 签名的短期凭证；仅可使用一次
+	Token string
+	// ExpiresIn 有效时间
+	ExpiresIn time.Duration
+}
+
+// VerifyTokenRequest 验证验证凭证
+type VerifyTokenRequest struct {
+	Token string
+	// Target 接收者；与发送验证码时一致
+	Target string
+	// Purpose 用途；与发送验证码时一致
+	Purpose string
+}
+
+// tokenPayload 验证凭证的内容
+type tokenPayload struct {
+	ID        string `json:"id"`
+	Target    string `json:"tgt"`
+	Purpose   string `json:"pur"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// VerifyAndIssueToken 验证验证码，成功后签发验证凭证；
+// 用于验证与业务接口分离的场景：业务接口使用 VerifyToken 验证凭证
+func (s *Service) VerifyAndIssueToken(ctx context.Context, req *VerifyRequest) (*VerificationToken, error) {
+	if len(s.opts.tokenSecret) == 0 {
+		return nil, ErrTokenDisabled
+	}
+	if err := s.Verify(ctx, req); err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(&tokenPayload{
+		ID:        uuid.NewString(),
+		Target:    req.Target,
+		Purpose:   req.Purpose,
+		ExpiresAt: s.now().Add(s.cfg.TokenTTL).UnixMilli(),
+	})
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return &VerificationToken{
+		Token:     encoded + "." + base64.RawURLEncoding.EncodeToString(s.signToken(encoded)),
+		ExpiresIn: s.cfg.TokenTTL,
+	}, nil
+}
+
+// VerifyToken 验证验证凭证；签名、有效时间、接收者与用途；验证成功后失效
+func (s *Service) VerifyToken(ctx context.Context, req *VerifyTokenRequest) error {
+	if len(s.opts.tokenSecret) == 0 {
+		return ErrTokenDisabled
+	}
+	encoded, signature, ok := strings.Cut(req.Token, ".")
+	if !ok {
+		return ErrInvalidToken
+	}
+	signatureBytes, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(signatureBytes, s.signToken(encoded)) {
+		return ErrInvalidToken
+	}
+	payloadBytes, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return ErrInvalidToken
+	}
+	payload := &tokenPayload{}
+	if err = json.Unmarshal(payloadBytes, payload); err != nil {
+		return ErrInvalidToken
+	}
+	ttl := time.UnixMilli(payload.ExpiresAt).Sub(s.now())
+	if ttl <= 0 || payload.Target != req.Target || payload.Purpose != req.Purpose {
+		return ErrInvalidToken
+	}
+
+	// 一次性
+	ok, err = s.redisCC.SetNX(ctx, s.tokenKey(payload.ID), 1, ttl).Result()
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	if !ok {
+		return ErrInvalidToken
+	}
+	return nil
+}
+
+// signToken HMAC-SHA256
+func (s *Service) signToken(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.opts.tokenSecret)
+	_, _ = mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// tokenKey 已使用的验证凭证
+func (s *Service) tokenKey(tokenID string) string {
+	return s.opts.keyPrefix + "token:" + tokenID
+}
@@ -30,6 +30,8 @@ type ClientApiEndpoint struct {
 	RegistryName string
 	HttpHost     string
 	GrpcHost     string
+	// ClusterService 集群内的服务：client_api.cluster_service
+	ClusterService bool
 }
 
 func (s *ClientApiEndpoint) SetByPbClientApiEndpoint(cfg *configs.ClientApi_Endpoint) {
@@ -39,6 +41,18 @@ func (s *ClientApiEndpoint) SetByPbClientApiEndpoint(cfg *configs.ClientApi_Endp
 	s.GrpcHost = cfg.GrpcHost
 }
 
+// SignAudience 服务间请求签名的被调用方：集群内服务的 app.server_name(registry_name，未配置时为 name)；
+// 第三方服务为空，不签名
+func (s *ClientApiEndpoint) SignAudience() string {
+	if !s.ClusterService {
+		return ""
+	}
+	if s.RegistryName != "" {
+		return s.RegistryName
+	}
+	return s.Name
+}
+
 // getClientApiConfig ...
 func getClientApiConfig(engineHandler setuputil.Engine, serviceName ServiceName) (*ClientApiEndpoint, error) {
 	apiConfig := engineHandler.ClientApiConfig()
@@ -51,6 +65,7 @@ func getClientApiConfig(engineHandler setuputil.Engine, serviceName ServiceName)
 		if cfg.Name == serviceName.String() {
 			res := new(ClientApiEndpoint)
 			res.SetByPbClientApiEndpoint(cfg)
+			res.ClusterService = true
 			return res, nil
 		}
 	}
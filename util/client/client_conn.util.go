@@ -5,7 +5,7 @@ import (
 	"strings"
 	"sync"
 
-	"github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	etcdregistry "github.com/go-kratos/kratos/contrib/registry/etcd/v2"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/registry"
 	"github.com/go-kratos/kratos/v2/transport"
@@ -15,10 +15,11 @@ import (
 	clientpkg "github.com/ikaiguang/go-srv-kit/kratos/client"
 	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
 	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
-	middlewarepkg "github.com/ikaiguang/go-srv-kit/kratos/middleware"
 	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
 	configs "github.com/my-saas-platform/api-proto/api/config"
 	apputil "github.com/my-saas-platform/api-proto/util/app"
+	middlewareutil "github.com/my-saas-platform/api-proto/util/middleware"
+	servicesignutil "github.com/my-saas-platform/api-proto/util/servicesign"
 	setuputil "github.com/my-saas-platform/api-proto/util/setup"
 	pkgerrors "github.com/pkg/errors"
 	stdgrpc "google.golang.org/grpc"
@@ -77,7 +78,11 @@ func newGRPCConnection(engineHandler setuputil.Engine, serviceName ServiceName,
 	}
 
 	// 服务端点
-	endpointOpts, err := getGRPCEndpoint(engineHandler, serviceName)
+	endpointInfo, err := getClientApiConfig(engineHandler, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	endpointOpts, err := getGRPCEndpoint(engineHandler, serviceName, endpointInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -89,7 +94,15 @@ func newGRPCConnection(engineHandler setuputil.Engine, serviceName ServiceName,
 		return nil, err
 	}
 	logHelper := log.NewHelper(logger)
-	opts = append(opts, grpc.WithMiddleware(middlewarepkg.DefaultClientMiddlewares(logHelper)...))
+	middlewares, err := middlewareutil.NewClientMiddlewares(engineHandler, logHelper, endpointInfo.SignAudience())
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, grpc.WithMiddleware(middlewares...))
+	// 服务间请求签名：确定性的 protobuf 编码，发送的字节与签名的摘要一致
+	if cfg := engineHandler.ServiceEncryptConfig(); cfg != nil && cfg.Enable && endpointInfo.SignAudience() != "" {
+		opts = append(opts, grpc.WithOptions(stdgrpc.WithDefaultCallOptions(stdgrpc.ForceCodec(servicesignutil.Codec{}))))
+	}
 	// 其他
 	opts = append(opts, otherOpts...)
 
@@ -112,7 +125,11 @@ func newHTTPConnection(engineHandler setuputil.Engine, serviceName ServiceName,
 	opts = append(opts, apputil.ClientDecoderEncoder()...)
 
 	// 服务端点
-	endpointOpts, err := getHTTPEndpoint(engineHandler, serviceName)
+	endpointInfo, err := getClientApiConfig(engineHandler, serviceName)
+	if err != nil {
+		return nil, err
+	}
+	endpointOpts, err := getHTTPEndpoint(engineHandler, serviceName, endpointInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +141,11 @@ func newHTTPConnection(engineHandler setuputil.Engine, serviceName ServiceName,
 		return nil, err
 	}
 	logHelper := log.NewHelper(logger)
-	opts = append(opts, http.WithMiddleware(middlewarepkg.DefaultClientMiddlewares(logHelper)...))
+	middlewares, err := middlewareutil.NewClientMiddlewares(engineHandler, logHelper, endpointInfo.SignAudience())
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, http.WithMiddleware(middlewares...))
 	// 其他
 	opts = append(opts, otherOpts...)
 
@@ -137,13 +158,9 @@ func newHTTPConnection(engineHandler setuputil.Engine, serviceName ServiceName,
 }
 
 // getHTTPEndpoint 获取服务端点
-func getHTTPEndpoint(engineHandler setuputil.Engine, serviceName ServiceName) ([]http.ClientOption, error) {
-	endpointInfo, err := getClientApiConfig(engineHandler, serviceName)
-	if err != nil {
-		return nil, err
-	}
-
+func getHTTPEndpoint(engineHandler setuputil.Engine, serviceName ServiceName, endpointInfo *ClientApiEndpoint) ([]http.ClientOption, error) {
 	var (
+		err          error
 		clientKind   = transport.KindHTTP
 		opts         []http.ClientOption
 		registryType = engineHandler.GetRegistryType()
@@ -151,7 +168,7 @@ func getHTTPEndpoint(engineHandler setuputil.Engine, serviceName ServiceName) ([
 		endpoint     string
 	)
 	switch registryType {
-	case registrypkg.RegistryTypeConsul:
+	case registrypkg.RegistryTypeConsul, registrypkg.RegistryTypeEtcd:
 		discovery, endpoint, err = getRegistryAndServerEndpoint(engineHandler, serviceName, endpointInfo.RegistryName)
 		if err != nil {
 			return nil, err
@@ -171,13 +188,9 @@ func getHTTPEndpoint(engineHandler setuputil.Engine, serviceName ServiceName) ([
 }
 
 // getGRPCEndpoint 获取服务端点
-func getGRPCEndpoint(engineHandler setuputil.Engine, serviceName ServiceName) ([]grpc.ClientOption, error) {
-	endpointInfo, err := getClientApiConfig(engineHandler, serviceName)
-	if err != nil {
-		return nil, err
-	}
-
+func getGRPCEndpoint(engineHandler setuputil.Engine, serviceName ServiceName, endpointInfo *ClientApiEndpoint) ([]grpc.ClientOption, error) {
 	var (
+		err          error
 		clientKind   = transport.KindGRPC
 		opts         []grpc.ClientOption
 		registryType = engineHandler.GetRegistryType()
@@ -185,7 +198,7 @@ func getGRPCEndpoint(engineHandler setuputil.Engine, serviceName ServiceName) ([
 		endpoint     string
 	)
 	switch registryType {
-	case registrypkg.RegistryTypeConsul:
+	case registrypkg.RegistryTypeConsul, registrypkg.RegistryTypeEtcd:
 		discovery, endpoint, err = getRegistryAndServerEndpoint(engineHandler, serviceName, endpointInfo.RegistryName)
 		if err != nil {
 			return nil, err
@@ -204,21 +217,32 @@ func getGRPCEndpoint(engineHandler setuputil.Engine, serviceName ServiceName) ([
 	return opts, nil
 }
 
-// getRegistryAndServerEndpoint ...
-func getRegistryAndServerEndpoint(engineHandler setuputil.Engine, serviceName ServiceName, registryName string) (*consul.Registry, string, error) {
+// getRegistryAndServerEndpoint 服务发现：consul、etcd
+func getRegistryAndServerEndpoint(engineHandler setuputil.Engine, serviceName ServiceName, registryName string) (registry.Discovery, string, error) {
 	if registryName = strings.TrimSpace(registryName); registryName == "" {
 		msg := "请先配置: client_api.xxx.name." + serviceName.String() + ".registry_name"
 		e := errorpkg.ErrorInvalidParameter(msg)
 		return nil, "", errorpkg.WithStack(e)
 	}
 
-	consulClient, err := engineHandler.GetConsulClient()
-	if err != nil {
-		return nil, "", err
-	}
-	r, err := registrypkg.NewConsulRegistry(consulClient)
-	if err != nil {
-		return nil, "", err
+	var r registry.Discovery
+	switch engineHandler.GetRegistryType() {
+	case registrypkg.RegistryTypeEtcd:
+		etcdClient, err := engineHandler.GetEtcdClient()
+		if err != nil {
+			return nil, "", err
+		}
+		r = etcdregistry.New(etcdClient, setuputil.ToEtcdRegistryOptions(engineHandler.EtcdConfig())...)
+	default:
+		consulClient, err := engineHandler.GetConsulClient()
+		if err != nil {
+			return nil, "", err
+		}
+		consulRegistry, err := registrypkg.NewConsulRegistry(consulClient)
+		if err != nil {
+			return nil, "", err
+		}
+		r = consulRegistry
 	}
 	// 端点 "discovery:///${registry_endpoint}"
 	// registry_endpoint = ${app.belong_to}/${app.env}/${app.env_branch}/${app.version}/${app.name}
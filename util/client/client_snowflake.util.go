@@ -0,0 +1,167 @@
+package clientutil
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	clientpkg "github.com/ikaiguang/go-srv-kit/kratos/client"
+	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
+	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
+	snowflakeresourcev1 "github.com/my-saas-platform/api-proto/api/snowflake-service/v1/resources"
+	snowflakeservicev1 "github.com/my-saas-platform/api-proto/api/snowflake-service/v1/services"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	middlewareutil "github.com/my-saas-platform/api-proto/util/middleware"
+	setuputil "github.com/my-saas-platform/api-proto/util/setup"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var _ setuputil.SnowflakeNodeAllocator = (*snowflakeNodeAllocator)(nil)
+
+// NewSnowflakeNodeAllocator 雪花算法节点ID分配：snowflake-service；配置 infrastructure.snowflake
+// 例：setuputil.New(setuputil.WithSnowflakeNodeAllocator(clientutil.NewSnowflakeNodeAllocator))
+func NewSnowflakeNodeAllocator(engineHandler setuputil.Engine) (setuputil.SnowflakeNodeAllocator, error) {
+	conn, err := newSnowflakeHTTPConnection(engineHandler)
+	if err != nil {
+		return nil, err
+	}
+	return &snowflakeNodeAllocator{
+		client: snowflakeservicev1.NewSrvSnowflakeV1HTTPClient(conn),
+	}, nil
+}
+
+// newSnowflakeHTTPConnection snowflake-service http 链接
+func newSnowflakeHTTPConnection(engineHandler setuputil.Engine) (*http.Client, error) {
+	workerConfig := engineHandler.SnowflakeWorkerConfig()
+	if workerConfig == nil || !workerConfig.Enable {
+		msg := "请先配置: infrastructure.snowflake"
+		e := errorpkg.ErrorInvalidParameter(msg)
+		return nil, errorpkg.WithStack(e)
+	}
+
+	var opts = []http.ClientOption{
+		http.WithTimeout(defaultTimeout),
+		http.WithEndpoint(workerConfig.Endpoint),
+	}
+	opts = append(opts, apputil.ClientDecoderEncoder()...)
+
+	// 服务发现
+	if workerConfig.WithDiscovery {
+		consulClient, err := engineHandler.GetConsulClient()
+		if err != nil {
+			return nil, err
+		}
+		r, err := registrypkg.NewConsulRegistry(consulClient)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, http.WithDiscovery(r))
+	}
+
+	// 中间件
+	logger, _, err := engineHandler.Logger()
+	if err != nil {
+		return nil, err
+	}
+	logHelper := log.NewHelper(logger)
+	middlewares, err := middlewareutil.NewClientMiddlewares(engineHandler, logHelper, SnowflakeService.String())
+	if err != nil {
+		return nil, err
+	}
+	if workerConfig.WithHttpBasicAuth {
+		middlewares = append(middlewares, basicAuthClient(workerConfig.Username, workerConfig.Password))
+	}
+	opts = append(opts, http.WithMiddleware(middlewares...))
+
+	// http 链接
+	conn, err := clientpkg.NewHTTPClient(context.Background(), opts...)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return conn, nil
+}
+
+// basicAuthClient http basic auth
+func basicAuthClient(username, password string) middleware.Middleware {
+	authorization := "Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password))
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				tr.RequestHeader().Set("Authorization", authorization)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// snowflakeNodeAllocator snowflake-service 分配节点ID
+type snowflakeNodeAllocator struct {
+	client snowflakeservicev1.SrvSnowflakeV1HTTPClient
+}
+
+// Allocate 获取节点ID
+func (s *snowflakeNodeAllocator) Allocate(ctx context.Context, req *setuputil.SnowflakeNodeRequest) (*setuputil.SnowflakeNodeLease, error) {
+	resp, err := s.client.GetNodeId(ctx, &snowflakeresourcev1.GetNodeIdReq{
+		InstanceId:   req.InstanceID,
+		InstanceName: req.InstanceName,
+		Endpoints:    req.Endpoints,
+		Metadata:     req.Metadata,
+		LeaseTtl:     durationpb.New(req.LeaseTTL),
+	})
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return toSnowflakeNodeLease(resp.GetData())
+}
+
+// Renew 续期
+func (s *snowflakeNodeAllocator) Renew(ctx context.Context, lease *setuputil.SnowflakeNodeLease, leaseTTL time.Duration) (*setuputil.SnowflakeNodeLease, error) {
+	resp, err := s.client.ExtendNodeId(ctx, &snowflakeresourcev1.ExtendNodeIdReq{
+		Id:         lease.ID,
+		InstanceId: lease.InstanceID,
+		NodeId:     lease.NodeID,
+		LeaseTtl:   durationpb.New(leaseTTL),
+	})
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	renewed, err := toSnowflakeNodeLease(resp.GetData())
+	if err != nil {
+		return nil, err
+	}
+	if renewed.NodeID != lease.NodeID || renewed.InstanceID != lease.InstanceID {
+		return nil, pkgerrors.WithStack(setuputil.ErrSnowflakeLeaseLost)
+	}
+	return renewed, nil
+}
+
+// Release 释放节点ID
+func (s *snowflakeNodeAllocator) Release(ctx context.Context, lease *setuputil.SnowflakeNodeLease) error {
+	_, err := s.client.ReleaseNodeId(ctx, &snowflakeresourcev1.ReleaseNodeIdReq{
+		Id:         lease.ID,
+		InstanceId: lease.InstanceID,
+		NodeId:     lease.NodeID,
+	})
+	return pkgerrors.WithStack(err)
+}
+
+// toSnowflakeNodeLease ...
+func toSnowflakeNodeLease(node *snowflakeresourcev1.SnowflakeNode) (*setuputil.SnowflakeNodeLease, error) {
+	if node == nil {
+		return nil, pkgerrors.New("snowflake-service : 响应数据为空")
+	}
+	lease := &setuputil.SnowflakeNodeLease{
+		ID:         node.Id,
+		InstanceID: node.InstanceId,
+		NodeID:     node.NodeId,
+	}
+	if node.ExpiredAt != nil {
+		lease.ExpiredAt = node.ExpiredAt.AsTime()
+	}
+	return lease, nil
+}
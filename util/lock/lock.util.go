@@ -0,0 +1,375 @@
+package lockutil
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultKeyPrefix redis键前缀
+	DefaultKeyPrefix = "lock:"
+	// DefaultTTL 租约时间
+	DefaultTTL = 30 * time.Second
+	// DefaultRetryInterval Acquire 的重试间隔；另加随机抖动
+	DefaultRetryInterval = 100 * time.Millisecond
+	// DefaultDriftFactor 时钟漂移系数；租约的有效时间 = ttl - 获取耗时 - ttl*DefaultDriftFactor
+	DefaultDriftFactor = 0.01
+	// DefaultFenceTTL fencing token 的键的过期时间；每次获取与续期时重置
+	DefaultFenceTTL = 7 * 24 * time.Hour
+	// MinFenceTTLFactor fencing token 的键的过期时间至少为租约时间的倍数
+	MinFenceTTLFactor = 10
+)
+
+var (
+	// ErrNotAcquired 锁被其他持有者占用
+	ErrNotAcquired = pkgerrors.New("lock : not acquired")
+	// ErrLockNotHeld 锁已不属于当前持有者：已释放、租约过期或被其他持有者获取
+	ErrLockNotHeld = pkgerrors.New("lock : not held")
+	// ErrLockerClosed 已关闭
+	ErrLockerClosed = pkgerrors.New("lock : locker closed")
+)
+
+var (
+	// _acquireScript 获取；成功时返回当前的 fencing token，失败时返回 -1
+	_acquireScript = redis.NewScript(`
+if redis.call("SET", KEYS[1], ARGV[1], "NX", "PX", ARGV[2]) then
+	return tonumber(redis.call("GET", KEYS[2]) or "0")
+end
+return -1
+`)
+	// _fenceScript 设置 fencing token 及其过期时间；仅持有者可设置，且只增不减
+	_fenceScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+local current = tonumber(redis.call("GET", KEYS[2]) or "0")
+if tonumber(ARGV[2]) > current then
+	redis.call("SET", KEYS[2], ARGV[2])
+end
+redis.call("PEXPIRE", KEYS[2], ARGV[3])
+return 1
+`)
+	// _extendScript 续期，并重置 fencing token 的过期时间；仅持有者可续期
+	_extendScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	redis.call("PEXPIRE", KEYS[2], ARGV[3])
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+	// _releaseScript 释放；仅持有者可释放
+	_releaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+)
+
+// options 可选项
+type options struct {
+	keyPrefix      string
+	ttl            time.Duration
+	retryInterval  time.Duration
+	autoExtend     bool
+	extendInterval time.Duration
+	fenceTTL       time.Duration
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithKeyPrefix redis键前缀；例：go-srv-saas:DEVELOP:user-service:lock:
+func WithKeyPrefix(keyPrefix string) Option {
+	return func(o *options) {
+		o.keyPrefix = keyPrefix
+	}
+}
+
+// WithTTL 默认的租约时间；Acquire 的 ttl <= 0 时使用
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// WithRetryInterval Acquire 的重试间隔
+func WithRetryInterval(retryInterval time.Duration) Option {
+	return func(o *options) {
+		o.retryInterval = retryInterval
+	}
+}
+
+// WithAutoExtend 持有期间自动续期；默认 true
+func WithAutoExtend(autoExtend bool) Option {
+	return func(o *options) {
+		o.autoExtend = autoExtend
+	}
+}
+
+// WithExtendInterval 自动续期的间隔；默认 ttl/3
+func WithExtendInterval(extendInterval time.Duration) Option {
+	return func(o *options) {
+		o.extendInterval = extendInterval
+	}
+}
+
+// WithFenceTTL fencing token 的键的过期时间；默认 DefaultFenceTTL，至少为租约时间的 MinFenceTTLFactor 倍；
+// 过期后 token 重新从 1 开始，受保护的资源保存 token 的时间不宜超过该时间
+func WithFenceTTL(fenceTTL time.Duration) Option {
+	return func(o *options) {
+		o.fenceTTL = fenceTTL
+	}
+}
+
+// Locker 分布式锁；
+// 单节点：SET NX PX；多节点(Redlock)：在多数节点上获取成功且未超出租约时间时持有
+type Locker struct {
+	clients []redis.UniversalClient
+	quorum  int
+	opts    *options
+
+	mutex  sync.Mutex
+	locks  map[*Lock]struct{}
+	closed bool
+}
+
+// NewLocker 单节点的分布式锁
+func NewLocker(redisCC redis.UniversalClient, opts ...Option) *Locker {
+	return newLocker([]redis.UniversalClient{redisCC}, opts...)
+}
+
+// NewRedlock 多节点的分布式锁(Redlock)；各节点为相互独立的 redis，不可为同一集群的主从
+func NewRedlock(clients []redis.UniversalClient, opts ...Option) (*Locker, error) {
+	if len(clients) == 0 {
+		return nil, pkgerrors.New("lock : redlock requires at least one redis client")
+	}
+	return newLocker(clients, opts...), nil
+}
+
+// newLocker ...
+func newLocker(clients []redis.UniversalClient, opts ...Option) *Locker {
+	lockerOpts := &options{
+		keyPrefix:     DefaultKeyPrefix,
+		ttl:           DefaultTTL,
+		retryInterval: DefaultRetryInterval,
+		autoExtend:    true,
+		fenceTTL:      DefaultFenceTTL,
+	}
+	for i := range opts {
+		opts[i](lockerOpts)
+	}
+	if lockerOpts.ttl <= 0 {
+		lockerOpts.ttl = DefaultTTL
+	}
+	if lockerOpts.retryInterval <= 0 {
+		lockerOpts.retryInterval = DefaultRetryInterval
+	}
+	if lockerOpts.fenceTTL <= 0 {
+		lockerOpts.fenceTTL = DefaultFenceTTL
+	}
+	return &Locker{
+		clients: clients,
+		quorum:  len(clients)/2 + 1,
+		opts:    lockerOpts,
+		locks:   make(map[*Lock]struct{}),
+	}
+}
+
+// IsRedlock 是否为多节点
+func (l *Locker) IsRedlock() bool {
+	return len(l.clients) > 1
+}
+
+// TryAcquire 获取锁；锁被占用时立即返回 ErrNotAcquired；ttl <= 0 时使用默认的租约时间
+func (l *Locker) TryAcquire(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	if ttl <= 0 {
+		ttl = l.opts.ttl
+	}
+	l.mutex.Lock()
+	closed := l.closed
+	l.mutex.Unlock()
+	if closed {
+		return nil, pkgerrors.WithStack(ErrLockerClosed)
+	}
+
+	var (
+		lock = &Lock{
+			locker:   l,
+			key:      key,
+			redisKey: l.redisKey(key),
+			owner:    uuid.NewString(),
+			ttl:      ttl,
+			lost:     make(chan struct{}),
+		}
+		start   = time.Now()
+		results = l.eval(ctx, _acquireScript, lock.redisKeys(), lock.owner, ttl.Milliseconds())
+	)
+	var (
+		acquired   int
+		maxToken   int64
+		firstErr   error
+		errorCount int
+	)
+	for _, result := range results {
+		switch {
+		case result.err != nil:
+			errorCount++
+			if firstErr == nil {
+				firstErr = result.err
+			}
+		case result.val >= 0:
+			acquired++
+			if result.val > maxToken {
+				maxToken = result.val
+			}
+		}
+	}
+	validity := l.validity(start, ttl)
+	if acquired < l.quorum || validity <= 0 {
+		lock.releaseNodes()
+		// 多数节点不可用
+		if errorCount > len(l.clients)-l.quorum {
+			return nil, pkgerrors.WithMessage(firstErr, "lock : acquire failed")
+		}
+		return nil, pkgerrors.WithStack(ErrNotAcquired)
+	}
+
+	// fencing token：多数节点的最大值 + 1；与上一次持有的多数节点必有交集，保证递增
+	lock.token = maxToken + 1
+	fenced := 0
+	for _, result := range l.eval(ctx, _fenceScript, lock.redisKeys(), lock.owner, lock.token, l.fenceTTL(ttl).Milliseconds()) {
+		if result.err == nil && result.val == 1 {
+			fenced++
+		}
+	}
+	if fenced < l.quorum {
+		lock.releaseNodes()
+		return nil, pkgerrors.WithStack(ErrNotAcquired)
+	}
+	lock.expiresAt = start.Add(validity)
+
+	if !l.register(lock) {
+		lock.releaseNodes()
+		return nil, pkgerrors.WithStack(ErrLockerClosed)
+	}
+	if l.opts.autoExtend {
+		lock.startAutoExtend(l.extendInterval(ttl))
+	}
+	return lock, nil
+}
+
+// Acquire 获取锁；锁被占用时重试，直至获取成功或 ctx 结束；ttl <= 0 时使用默认的租约时间
+func (l *Locker) Acquire(ctx context.Context, key string, ttl time.Duration) (*Lock, error) {
+	for {
+		lock, err := l.TryAcquire(ctx, key, ttl)
+		if err == nil {
+			return lock, nil
+		}
+		if !pkgerrors.Is(err, ErrNotAcquired) {
+			return nil, err
+		}
+
+		retryInterval := l.opts.retryInterval + time.Duration(rand.Int63n(int64(l.opts.retryInterval)))
+		timer := time.NewTimer(retryInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("%w : %w", ErrNotAcquired, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// Close 停止自动续期并释放持有的锁；之后不可再获取
+func (l *Locker) Close(ctx context.Context) error {
+	l.mutex.Lock()
+	l.closed = true
+	locks := make([]*Lock, 0, len(l.locks))
+	for lock := range l.locks {
+		locks = append(locks, lock)
+	}
+	l.mutex.Unlock()
+
+	var firstErr error
+	for _, lock := range locks {
+		if err := lock.Release(ctx); err != nil && !pkgerrors.Is(err, ErrLockNotHeld) && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// register 记录持有的锁
+func (l *Locker) register(lock *Lock) bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.closed {
+		return false
+	}
+	l.locks[lock] = struct{}{}
+	return true
+}
+
+// unregister ...
+func (l *Locker) unregister(lock *Lock) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	delete(l.locks, lock)
+}
+
+// redisKey 锁的键；{key} 保证锁与 fencing token 位于同一个集群槽
+func (l *Locker) redisKey(key string) string {
+	return l.opts.keyPrefix + "{" + key + "}"
+}
+
+// validity 租约的有效时间
+func (l *Locker) validity(start time.Time, ttl time.Duration) time.Duration {
+	drift := time.Duration(float64(ttl)*DefaultDriftFactor) + 2*time.Millisecond
+	return ttl - time.Since(start) - drift
+}
+
+// extendInterval 自动续期的间隔
+func (l *Locker) extendInterval(ttl time.Duration) time.Duration {
+	if l.opts.extendInterval > 0 && l.opts.extendInterval < ttl {
+		return l.opts.extendInterval
+	}
+	return ttl / 3
+}
+
+// fenceTTL fencing token 的键的过期时间
+func (l *Locker) fenceTTL(ttl time.Duration) time.Duration {
+	return max(l.opts.fenceTTL, ttl*MinFenceTTLFactor)
+}
+
+// nodeResult 节点的执行结果
+type nodeResult struct {
+	val int64
+	err error
+}
+
+// eval 在全部节点上并发执行
+func (l *Locker) eval(ctx context.Context, script *redis.Script, keys []string, args ...interface{}) []nodeResult {
+	results := make([]nodeResult, len(l.clients))
+	if len(l.clients) == 1 {
+		results[0].val, results[0].err = script.Run(ctx, l.clients[0], keys, args...).Int64()
+		return results
+	}
+	var wg sync.WaitGroup
+	for i := range l.clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i].val, results[i].err = script.Run(ctx, l.clients[i], keys, args...).Int64()
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
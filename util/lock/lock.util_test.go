@@ -0,0 +1,223 @@
+package lockutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestingClient(t *testing.T, server *miniredis.Miniredis) redis.UniversalClient {
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return client
+}
+
+// go test -v ./util/lock/ -count=1 -test.run=TestLocker_TryAcquire
+func TestLocker_TryAcquire(t *testing.T) {
+	server := miniredis.RunT(t)
+	locker := NewLocker(newTestingClient(t, server), WithKeyPrefix("testing:lock:"))
+	ctx := context.Background()
+
+	lock, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), lock.Token())
+	require.True(t, server.Exists("testing:lock:{order:1}"))
+
+	_, err = locker.TryAcquire(ctx, "order:1", time.Second)
+	require.ErrorIs(t, err, ErrNotAcquired)
+
+	require.NoError(t, lock.Release(ctx))
+	require.ErrorIs(t, lock.Release(ctx), ErrLockNotHeld)
+
+	lock2, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), lock2.Token())
+	require.NotEqual(t, lock.Owner(), lock2.Owner())
+	require.NoError(t, lock2.Release(ctx))
+}
+
+// go test -v ./util/lock/ -count=1 -test.run=TestLocker_LeaseExpiry
+func TestLocker_LeaseExpiry(t *testing.T) {
+	server := miniredis.RunT(t)
+	locker := NewLocker(newTestingClient(t, server), WithAutoExtend(false))
+	ctx := context.Background()
+
+	lock, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+
+	// 租约过期后被其他持有者获取
+	server.FastForward(2 * time.Second)
+	lock2, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+	require.Greater(t, lock2.Token(), lock.Token())
+
+	// 仅持有者可续期与释放
+	require.ErrorIs(t, lock.Extend(ctx, time.Second), ErrLockNotHeld)
+	select {
+	case <-lock.Lost():
+	default:
+		t.Fatal("lock should be lost")
+	}
+	require.ErrorIs(t, lock.Release(ctx), ErrLockNotHeld)
+	require.True(t, server.Exists("lock:{order:1}"))
+
+	// 租约丢失的锁不再属于持有的锁
+	locker.mutex.Lock()
+	_, held := locker.locks[lock]
+	_, held2 := locker.locks[lock2]
+	locker.mutex.Unlock()
+	require.False(t, held)
+	require.True(t, held2)
+
+	// fencing token 的键：至少为租约时间的 MinFenceTTLFactor 倍，续期时重置
+	require.Equal(t, DefaultFenceTTL, server.TTL("lock:{order:1}:fence"))
+	server.FastForward(500 * time.Millisecond)
+	require.Less(t, server.TTL("lock:{order:1}:fence"), DefaultFenceTTL)
+	require.NoError(t, lock2.Extend(ctx, time.Second))
+	require.Equal(t, DefaultFenceTTL, server.TTL("lock:{order:1}:fence"))
+	require.NoError(t, lock2.Release(ctx))
+
+	locker = NewLocker(newTestingClient(t, server), WithAutoExtend(false), WithFenceTTL(time.Second))
+	lock3, err := locker.TryAcquire(ctx, "order:2", time.Second)
+	require.NoError(t, err)
+	require.Equal(t, MinFenceTTLFactor*time.Second, server.TTL("lock:{order:2}:fence"))
+	require.NoError(t, lock3.Release(ctx))
+}
+
+// go test -v ./util/lock/ -count=1 -test.run=TestLocker_AutoExtend
+func TestLocker_AutoExtend(t *testing.T) {
+	server := miniredis.RunT(t)
+	locker := NewLocker(newTestingClient(t, server), WithExtendInterval(50*time.Millisecond))
+	ctx := context.Background()
+
+	lock, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+
+	// 累计超过租约时间，持有期间自动续期
+	for i := 0; i < 4; i++ {
+		time.Sleep(150 * time.Millisecond)
+		server.FastForward(500 * time.Millisecond)
+	}
+	_, err = locker.TryAcquire(ctx, "order:1", time.Second)
+	require.ErrorIs(t, err, ErrNotAcquired)
+	select {
+	case <-lock.Lost():
+		t.Fatal("lock should be held")
+	default:
+	}
+
+	require.NoError(t, lock.Release(ctx))
+	require.False(t, server.Exists("lock:{order:1}"))
+}
+
+// go test -v ./util/lock/ -count=1 -test.run=TestLocker_HolderCrash
+func TestLocker_HolderCrash(t *testing.T) {
+	server := miniredis.RunT(t)
+	holderClient := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	holder := NewLocker(holderClient, WithExtendInterval(50*time.Millisecond))
+	locker := NewLocker(newTestingClient(t, server))
+	ctx := context.Background()
+
+	lock, err := holder.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+
+	// 持有者崩溃：不再续期与释放
+	require.NoError(t, holderClient.Close())
+	_, err = locker.TryAcquire(ctx, "order:1", time.Second)
+	require.ErrorIs(t, err, ErrNotAcquired)
+
+	server.FastForward(time.Second)
+	lock2, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+	require.Greater(t, lock2.Token(), lock.Token())
+
+	// 续期失败直至租约到期
+	require.Eventually(t, func() bool {
+		select {
+		case <-lock.Lost():
+			return true
+		default:
+			return false
+		}
+	}, 3*time.Second, 20*time.Millisecond)
+	require.NoError(t, lock2.Release(ctx))
+}
+
+// go test -v ./util/lock/ -count=1 -test.run=TestLocker_Acquire
+func TestLocker_Acquire(t *testing.T) {
+	server := miniredis.RunT(t)
+	locker := NewLocker(newTestingClient(t, server), WithRetryInterval(10*time.Millisecond))
+
+	lock, err := locker.TryAcquire(context.Background(), "order:1", time.Second)
+	require.NoError(t, err)
+
+	// 等待超时
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = locker.Acquire(ctx, "order:1", time.Second)
+	require.ErrorIs(t, err, ErrNotAcquired)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// 等待释放
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		_ = lock.Release(context.Background())
+	}()
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel2()
+	lock2, err := locker.Acquire(ctx2, "order:1", time.Second)
+	require.NoError(t, err)
+
+	// 关闭时释放持有的锁
+	require.NoError(t, locker.Close(context.Background()))
+	require.False(t, server.Exists("lock:{order:1}"))
+	require.ErrorIs(t, lock2.Release(context.Background()), ErrLockNotHeld)
+	_, err = locker.TryAcquire(context.Background(), "order:1", time.Second)
+	require.ErrorIs(t, err, ErrLockerClosed)
+}
+
+// go test -v ./util/lock/ -count=1 -test.run=TestRedlock
+func TestRedlock(t *testing.T) {
+	servers := []*miniredis.Miniredis{miniredis.RunT(t), miniredis.RunT(t), miniredis.RunT(t)}
+	clients := make([]redis.UniversalClient, len(servers))
+	for i := range servers {
+		clients[i] = newTestingClient(t, servers[i])
+	}
+	locker, err := NewRedlock(clients, WithAutoExtend(false))
+	require.NoError(t, err)
+	require.True(t, locker.IsRedlock())
+	ctx := context.Background()
+
+	lock, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+	for i := range servers {
+		require.True(t, servers[i].Exists("lock:{order:1}"))
+	}
+	_, err = locker.TryAcquire(ctx, "order:1", time.Second)
+	require.ErrorIs(t, err, ErrNotAcquired)
+	require.NoError(t, lock.Release(ctx))
+
+	// 少数节点不可用：多数节点获取成功
+	servers[0].Close()
+	lock2, err := locker.TryAcquire(ctx, "order:1", time.Second)
+	require.NoError(t, err)
+	require.Greater(t, lock2.Token(), lock.Token())
+	require.NoError(t, lock2.Extend(ctx, time.Second))
+
+	// 少数节点被其他持有者占用：仍不可获取
+	_, err = locker.TryAcquire(ctx, "order:1", time.Second)
+	require.ErrorIs(t, err, ErrNotAcquired)
+	require.NoError(t, lock2.Release(ctx))
+
+	// 多数节点不可用
+	servers[1].Close()
+	_, err = locker.TryAcquire(ctx, "order:1", time.Second)
+	require.Error(t, err)
+	require.False(t, pkgerrors.Is(err, ErrNotAcquired))
+	require.False(t, servers[2].Exists("lock:{order:1}"))
+}
@@ -0,0 +1,220 @@
+package lockutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// releaseTimeout 获取失败时释放已获取节点的超时时间
+	releaseTimeout = 3 * time.Second
+)
+
+// Lock 持有的锁
+type Lock struct {
+	locker   *Locker
+	key      string
+	redisKey string
+	owner    string
+	token    int64
+	ttl      time.Duration
+
+	mutex     sync.Mutex
+	expiresAt time.Time
+	released  bool
+
+	lost     chan struct{}
+	lostOnce sync.Once
+
+	stopExtend chan struct{}
+	extendDone chan struct{}
+	stopOnce   sync.Once
+}
+
+// Key 锁的名称
+func (l *Lock) Key() string {
+	return l.key
+}
+
+// Owner 持有者；每次获取唯一
+func (l *Lock) Owner() string {
+	return l.owner
+}
+
+// Token fencing token；同一个锁每次获取时递增，
+// 写入受保护的资源时携带，资源拒绝比已见过的更小的 token
+func (l *Lock) Token() int64 {
+	return l.token
+}
+
+// ExpiresAt 本地估算的租约到期时间
+func (l *Lock) ExpiresAt() time.Time {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.expiresAt
+}
+
+// Lost 租约丢失时关闭：续期时已被其他持有者获取，或续期失败直至租约到期
+func (l *Lock) Lost() <-chan struct{} {
+	return l.lost
+}
+
+// Extend 续期；ttl <= 0 时使用获取时的租约时间
+func (l *Lock) Extend(ctx context.Context, ttl time.Duration) error {
+	if ttl <= 0 {
+		ttl = l.ttl
+	}
+	l.mutex.Lock()
+	released := l.released
+	l.mutex.Unlock()
+	if released {
+		return pkgerrors.WithStack(ErrLockNotHeld)
+	}
+
+	var (
+		start            = time.Now()
+		extended         int
+		notHeld          int
+		firstErr         error
+		locker           = l.locker
+		results          = locker.eval(ctx, _extendScript, l.redisKeys(), l.owner, ttl.Milliseconds(), locker.fenceTTL(ttl).Milliseconds())
+		nodeCount        = len(locker.clients)
+		tolerableFailure = nodeCount - locker.quorum
+	)
+	for _, result := range results {
+		switch {
+		case result.err != nil:
+			if firstErr == nil {
+				firstErr = result.err
+			}
+		case result.val == 1:
+			extended++
+		default:
+			notHeld++
+		}
+	}
+	if notHeld > tolerableFailure {
+		l.markLost()
+		return pkgerrors.WithStack(ErrLockNotHeld)
+	}
+	validity := locker.validity(start, ttl)
+	if extended < locker.quorum || validity <= 0 {
+		if firstErr == nil {
+			firstErr = ErrLockNotHeld
+		}
+		return pkgerrors.WithMessage(firstErr, "lock : extend failed")
+	}
+
+	l.mutex.Lock()
+	l.expiresAt = start.Add(validity)
+	l.mutex.Unlock()
+	return nil
+}
+
+// Release 释放；仅持有者可释放，锁已不属于当前持有者时返回 ErrLockNotHeld
+func (l *Lock) Release(ctx context.Context) error {
+	l.mutex.Lock()
+	if l.released {
+		l.mutex.Unlock()
+		return pkgerrors.WithStack(ErrLockNotHeld)
+	}
+	l.released = true
+	l.mutex.Unlock()
+
+	l.stopAutoExtend()
+	defer l.locker.unregister(l)
+
+	var (
+		released int
+		firstErr error
+		locker   = l.locker
+	)
+	for _, result := range locker.eval(ctx, _releaseScript, []string{l.redisKey}, l.owner) {
+		switch {
+		case result.err != nil:
+			if firstErr == nil {
+				firstErr = result.err
+			}
+		case result.val == 1:
+			released++
+		}
+	}
+	if released >= locker.quorum {
+		return nil
+	}
+	if firstErr != nil {
+		return pkgerrors.WithMessage(firstErr, "lock : release failed")
+	}
+	return pkgerrors.WithStack(ErrLockNotHeld)
+}
+
+// startAutoExtend 持有期间自动续期
+func (l *Lock) startAutoExtend(interval time.Duration) {
+	l.stopExtend = make(chan struct{})
+	l.extendDone = make(chan struct{})
+	go func() {
+		defer close(l.extendDone)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-l.stopExtend:
+				return
+			case <-ticker.C:
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			err := l.Extend(ctx, l.ttl)
+			cancel()
+			select {
+			case <-l.stopExtend:
+				// 释放中
+				return
+			default:
+			}
+			switch {
+			case err == nil:
+			case pkgerrors.Is(err, ErrLockNotHeld):
+				l.markLost()
+				return
+			case !time.Now().Before(l.ExpiresAt()):
+				// 节点不可用直至租约到期
+				l.markLost()
+				return
+			}
+		}
+	}()
+}
+
+// stopAutoExtend 停止自动续期并等待续期结束
+func (l *Lock) stopAutoExtend() {
+	if l.stopExtend == nil {
+		return
+	}
+	l.stopOnce.Do(func() {
+		close(l.stopExtend)
+	})
+	<-l.extendDone
+}
+
+// markLost 租约丢失；不再属于持有的锁，Locker.Close 时无需释放
+func (l *Lock) markLost() {
+	l.lostOnce.Do(func() {
+		close(l.lost)
+		l.locker.unregister(l)
+	})
+}
+
+// releaseNodes 获取失败时释放已获取的节点
+func (l *Lock) releaseNodes() {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseTimeout)
+	defer cancel()
+	_ = l.locker.eval(ctx, _releaseScript, []string{l.redisKey}, l.owner)
+}
+
+// redisKeys 锁的键、fencing token 的键
+func (l *Lock) redisKeys() []string {
+	return []string{l.redisKey, l.redisKey + ":fence"}
+}
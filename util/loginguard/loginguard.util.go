@@ -0,0 +1,320 @@
+package loginguardutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultKeyPrefix redis键前缀
+	DefaultKeyPrefix = "login:"
+	// DefaultSerialDuration 连续错误的统计时间
+	DefaultSerialDuration = 30 * time.Minute
+	// DefaultLockDuration 连续错误后的锁定时间
+	DefaultLockDuration = 30 * time.Minute
+
+	// SubjectAccount 账号
+	SubjectAccount = "account"
+	// SubjectIP ip
+	SubjectIP = "ip"
+
+	// LockReasonSerial 连续错误
+	LockReasonSerial = "serial"
+	// LockReasonDaily 当日错误上限；次日零点解除
+	LockReasonDaily = "daily"
+)
+
+var (
+	// ErrLocked 已锁定；errors.Is(err, ErrLocked) 判断，errors.As(err, *LockedError) 获取详情
+	ErrLocked = pkgerrors.New("login : locked")
+)
+
+var (
+	// _failureScript 记录错误；返回 {锁定原因(0:未锁定 1:连续错误 2:当日上限), 锁定的剩余毫秒, 连续错误次数, 当日错误次数}
+	_failureScript = redis.NewScript(`
+local locked = redis.call("PTTL", KEYS[3])
+if locked > 0 then
+	local reason = 1
+	if redis.call("GET", KEYS[3]) == "daily" then
+		reason = 2
+	end
+	return {reason, locked, tonumber(redis.call("GET", KEYS[1]) or "0"), tonumber(redis.call("GET", KEYS[2]) or "0")}
+end
+
+local serial = redis.call("INCR", KEYS[1])
+if serial == 1 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+local daily = redis.call("INCR", KEYS[2])
+if daily == 1 then
+	redis.call("PEXPIRE", KEYS[2], ARGV[5])
+end
+
+local dailyLimit = tonumber(ARGV[4])
+if dailyLimit > 0 and daily >= dailyLimit then
+	local ttl = redis.call("PTTL", KEYS[2])
+	if ttl <= 0 then
+		ttl = tonumber(ARGV[5])
+	end
+	redis.call("SET", KEYS[3], "daily", "PX", ttl)
+	redis.call("DEL", KEYS[1])
+	return {2, ttl, serial, daily}
+end
+local serialTimes = tonumber(ARGV[1])
+if serialTimes > 0 and serial >= serialTimes then
+	redis.call("SET", KEYS[3], "serial", "PX", ARGV[3])
+	redis.call("DEL", KEYS[1])
+	return {1, tonumber(ARGV[3]), serial, daily}
+end
+return {0, 0, serial, daily}
+`)
+)
+
+// LockedError 已锁定
+type LockedError struct {
+	// Subject 锁定的对象：SubjectAccount、SubjectIP
+	Subject string
+	// Reason 锁定原因：LockReasonSerial、LockReasonDaily
+	Reason string
+	// RetryAfter 锁定的剩余时间
+	RetryAfter time.Duration
+}
+
+// Error ...
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("login : %s locked (%s), retry after %s", e.Subject, e.Reason, e.RetryAfter)
+}
+
+// Is errors.Is(err, ErrLocked)
+func (e *LockedError) Is(target error) bool {
+	return target == ErrLocked
+}
+
+// AsLockedError 获取锁定详情
+func AsLockedError(err error) (*LockedError, bool) {
+	var e *LockedError
+	ok := pkgerrors.As(err, &e)
+	return e, ok
+}
+
+// Config 规则；对应 configs.Setting_Login
+type Config struct {
+	// SerialTimes 连续错误N次后锁定；0 时不限制
+	SerialTimes int64
+	// SerialDuration 连续错误的统计时间；默认 DefaultSerialDuration
+	SerialDuration time.Duration
+	// LockDuration 连续错误后的锁定时间；默认 DefaultLockDuration
+	LockDuration time.Duration
+	// DailyLimitTimes 当日错误上限，达到后锁定至次日零点；0 时不限制
+	DailyLimitTimes int64
+	// Location 当日的时区；默认 time.Local
+	Location *time.Location
+}
+
+// Status 状态
+type Status struct {
+	// Locked 已锁定时为锁定详情
+	Locked *LockedError
+	// SerialFailures 连续错误次数；账号与ip中较大的
+	SerialFailures int64
+	// DailyFailures 当日错误次数；账号与ip中较大的
+	DailyFailures int64
+	// RemainingAttempts 锁定前的剩余次数；-1 为不限制
+	RemainingAttempts int64
+}
+
+// Err 已锁定时返回 *LockedError
+func (s *Status) Err() error {
+	if s.Locked != nil {
+		return s.Locked
+	}
+	return nil
+}
+
+// options 可选项
+type options struct {
+	keyPrefix string
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithKeyPrefix redis键前缀；例：go-srv-saas:DEVELOP:user-service:login:
+func WithKeyPrefix(keyPrefix string) Option {
+	return func(o *options) {
+		o.keyPrefix = keyPrefix
+	}
+}
+
+// Guard 登录错误锁定；按账号与ip记录密码错误
+type Guard struct {
+	redisCC redis.UniversalClient
+	cfg     Config
+	opts    *options
+	// now 当前时间；测试时替换
+	now func() time.Time
+}
+
+// NewGuard 登录错误锁定
+func NewGuard(redisCC redis.UniversalClient, cfg Config, opts ...Option) *Guard {
+	guardOpts := &options{
+		keyPrefix: DefaultKeyPrefix,
+	}
+	for i := range opts {
+		opts[i](guardOpts)
+	}
+	if cfg.SerialDuration <= 0 {
+		cfg.SerialDuration = DefaultSerialDuration
+	}
+	if cfg.LockDuration <= 0 {
+		cfg.LockDuration = DefaultLockDuration
+	}
+	if cfg.Location == nil {
+		cfg.Location = time.Local
+	}
+	return &Guard{
+		redisCC: redisCC,
+		cfg:     cfg,
+		opts:    guardOpts,
+		now:     time.Now,
+	}
+}
+
+// Check 登录前检查；已锁定时返回 *LockedError；ip 为空时仅检查账号
+func (g *Guard) Check(ctx context.Context, account, ip string) (*Status, error) {
+	subjects := g.subjects(account, ip)
+	pipe := g.redisCC.Pipeline()
+	type subjectCmds struct {
+		serial, daily, lock *redis.StringCmd
+		lockTTL             *redis.DurationCmd
+	}
+	cmds := make([]subjectCmds, len(subjects))
+	for i := range subjects {
+		keys := g.keys(subjects[i])
+		cmds[i] = subjectCmds{
+			serial:  pipe.Get(ctx, keys[0]),
+			daily:   pipe.Get(ctx, keys[1]),
+			lock:    pipe.Get(ctx, keys[2]),
+			lockTTL: pipe.PTTL(ctx, keys[2]),
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil && !pkgerrors.Is(err, redis.Nil) {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	status := &Status{}
+	for i := range subjects {
+		serial, _ := cmds[i].serial.Int64()
+		daily, _ := cmds[i].daily.Int64()
+		status.merge(subjects[i].name, g.lockReason(cmds[i].lock.Val()), cmds[i].lockTTL.Val(), serial, daily)
+	}
+	g.remainingAttempts(status)
+	return status, status.Err()
+}
+
+// RecordFailure 记录密码错误；达到连续错误次数或当日上限时锁定，并返回 *LockedError
+func (g *Guard) RecordFailure(ctx context.Context, account, ip string) (*Status, error) {
+	now := g.now().In(g.cfg.Location)
+	year, month, day := now.Date()
+	untilMidnight := time.Date(year, month, day+1, 0, 0, 0, 0, g.cfg.Location).Sub(now)
+
+	status := &Status{}
+	for _, s := range g.subjects(account, ip) {
+		values, err := _failureScript.Run(ctx, g.redisCC, g.keys(s),
+			g.cfg.SerialTimes,
+			g.cfg.SerialDuration.Milliseconds(),
+			g.cfg.LockDuration.Milliseconds(),
+			g.cfg.DailyLimitTimes,
+			max(untilMidnight.Milliseconds(), 1),
+		).Int64Slice()
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		if len(values) != 4 {
+			return nil, pkgerrors.Errorf("login : unexpected script result %v", values)
+		}
+		reason := ""
+		switch values[0] {
+		case 1:
+			reason = LockReasonSerial
+		case 2:
+			reason = LockReasonDaily
+		}
+		status.merge(s.name, reason, time.Duration(values[1])*time.Millisecond, values[2], values[3])
+	}
+	g.remainingAttempts(status)
+	return status, status.Err()
+}
+
+// ClearFailures 登录成功；清除账号的错误次数；不清除ip的错误次数，防止使用其他账号重置
+func (g *Guard) ClearFailures(ctx context.Context, account string) error {
+	keys := g.keys(subject{name: SubjectAccount, value: account})
+	return pkgerrors.WithStack(g.redisCC.Del(ctx, keys[0], keys[1]).Err())
+}
+
+// Unlock 解除锁定，并清除错误次数；例：管理员解锁账号
+func (g *Guard) Unlock(ctx context.Context, subjectName, value string) error {
+	keys := g.keys(subject{name: subjectName, value: value})
+	return pkgerrors.WithStack(g.redisCC.Del(ctx, keys...).Err())
+}
+
+// subject 锁定的对象
+type subject struct {
+	name  string
+	value string
+}
+
+// subjects ...
+func (g *Guard) subjects(account, ip string) []subject {
+	subjects := []subject{{name: SubjectAccount, value: account}}
+	if ip != "" {
+		subjects = append(subjects, subject{name: SubjectIP, value: ip})
+	}
+	return subjects
+}
+
+// keys 连续错误次数、当日错误次数、锁定；同一个对象的键位于同一个槽
+func (g *Guard) keys(s subject) []string {
+	prefix := g.opts.keyPrefix + "{" + s.name + ":" + s.value + "}:"
+	return []string{prefix + "serial", prefix + "daily", prefix + "lock"}
+}
+
+// lockReason 锁定的值
+func (g *Guard) lockReason(value string) string {
+	switch value {
+	case LockReasonSerial, LockReasonDaily:
+		return value
+	}
+	return ""
+}
+
+// merge 合并对象的状态；优先返回账号的锁定
+func (s *Status) merge(subjectName, reason string, lockTTL time.Duration, serial, daily int64) {
+	if reason != "" && lockTTL > 0 && s.Locked == nil {
+		s.Locked = &LockedError{Subject: subjectName, Reason: reason, RetryAfter: lockTTL}
+	}
+	s.SerialFailures = max(s.SerialFailures, serial)
+	s.DailyFailures = max(s.DailyFailures, daily)
+}
+
+// remainingAttempts ...
+func (g *Guard) remainingAttempts(status *Status) {
+	status.RemainingAttempts = -1
+	if status.Locked != nil {
+		status.RemainingAttempts = 0
+		return
+	}
+	if g.cfg.SerialTimes > 0 {
+		status.RemainingAttempts = max(g.cfg.SerialTimes-status.SerialFailures, 0)
+	}
+	if g.cfg.DailyLimitTimes > 0 {
+		remaining := max(g.cfg.DailyLimitTimes-status.DailyFailures, 0)
+		if status.RemainingAttempts < 0 || remaining < status.RemainingAttempts {
+			status.RemainingAttempts = remaining
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package loginguardutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestingGuard(t *testing.T, server *miniredis.Miniredis, cfg Config) *Guard {
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return NewGuard(client, cfg, WithKeyPrefix("testing:login:"))
+}
+
+// go test -v ./util/loginguard/ -count=1 -test.run=TestGuard_Serial
+func TestGuard_Serial(t *testing.T) {
+	server := miniredis.RunT(t)
+	guard := newTestingGuard(t, server, Config{
+		SerialTimes:    3,
+		SerialDuration: time.Minute,
+		LockDuration:   10 * time.Minute,
+	})
+	ctx := context.Background()
+
+	status, err := guard.Check(ctx, "user-1", "10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), status.RemainingAttempts)
+
+	for i := 1; i <= 2; i++ {
+		status, err = guard.RecordFailure(ctx, "user-1", "10.0.0.1")
+		require.NoError(t, err)
+		require.Equal(t, int64(i), status.SerialFailures)
+		require.Equal(t, int64(3-i), status.RemainingAttempts)
+	}
+
+	// 登录成功：清除账号的错误次数，不清除ip的错误次数
+	require.NoError(t, guard.ClearFailures(ctx, "user-1"))
+	status, err = guard.Check(ctx, "user-1", "")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), status.SerialFailures)
+	status, err = guard.Check(ctx, "user-2", "10.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), status.SerialFailures)
+
+	// ip 锁定
+	_, err = guard.RecordFailure(ctx, "user-2", "10.0.0.1")
+	require.ErrorIs(t, err, ErrLocked)
+	lockedErr, ok := AsLockedError(err)
+	require.True(t, ok)
+	require.Equal(t, SubjectIP, lockedErr.Subject)
+	require.Equal(t, LockReasonSerial, lockedErr.Reason)
+	require.Equal(t, 10*time.Minute, lockedErr.RetryAfter)
+
+	status, err = guard.Check(ctx, "user-3", "10.0.0.1")
+	require.ErrorIs(t, err, ErrLocked)
+	require.Equal(t, int64(0), status.RemainingAttempts)
+	status, err = guard.Check(ctx, "user-3", "10.0.0.2")
+	require.NoError(t, err)
+
+	// 连续错误的统计时间
+	for i := 0; i < 2; i++ {
+		_, err = guard.RecordFailure(ctx, "user-3", "")
+		require.NoError(t, err)
+	}
+	server.FastForward(time.Minute)
+	_, err = guard.RecordFailure(ctx, "user-3", "")
+	require.NoError(t, err)
+
+	// 锁定时间
+	server.FastForward(5 * time.Minute)
+	_, err = guard.Check(ctx, "user-2", "10.0.0.1")
+	lockedErr, ok = AsLockedError(err)
+	require.True(t, ok)
+	require.Equal(t, 4*time.Minute, lockedErr.RetryAfter)
+	server.FastForward(4 * time.Minute)
+	_, err = guard.Check(ctx, "user-2", "10.0.0.1")
+	require.NoError(t, err)
+}
+
+// go test -v ./util/loginguard/ -count=1 -test.run=TestGuard_Daily
+func TestGuard_Daily(t *testing.T) {
+	server := miniredis.RunT(t)
+	location := time.FixedZone("UTC+8", 8*60*60)
+	guard := newTestingGuard(t, server, Config{
+		SerialTimes:     3,
+		LockDuration:    time.Minute,
+		DailyLimitTimes: 5,
+		Location:        location,
+	})
+	guard.now = func() time.Time {
+		return time.Date(2024, 1, 1, 22, 0, 0, 0, location)
+	}
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		_, err := guard.RecordFailure(ctx, "user-1", "")
+		require.Equal(t, i == 2, isLocked(err))
+	}
+	server.FastForward(time.Minute)
+	status, err := guard.Check(ctx, "user-1", "")
+	require.NoError(t, err)
+	require.Equal(t, int64(3), status.DailyFailures)
+	require.Equal(t, int64(2), status.RemainingAttempts)
+
+	// 当日上限：锁定至本地时间的零点
+	_, err = guard.RecordFailure(ctx, "user-1", "")
+	require.NoError(t, err)
+	_, err = guard.RecordFailure(ctx, "user-1", "")
+	lockedErr, ok := AsLockedError(err)
+	require.True(t, ok)
+	require.Equal(t, SubjectAccount, lockedErr.Subject)
+	require.Equal(t, LockReasonDaily, lockedErr.Reason)
+	require.InDelta(t, 2*time.Hour, lockedErr.RetryAfter, float64(2*time.Minute))
+
+	// 锁定期间的错误不计数
+	status, err = guard.RecordFailure(ctx, "user-1", "")
+	require.ErrorIs(t, err, ErrLocked)
+	require.Equal(t, int64(5), status.DailyFailures)
+
+	// 管理员解锁
+	require.NoError(t, guard.Unlock(ctx, SubjectAccount, "user-1"))
+	status, err = guard.Check(ctx, "user-1", "")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), status.DailyFailures)
+}
+
+func isLocked(err error) bool {
+	_, ok := AsLockedError(err)
+	return ok
+}
@@ -0,0 +1,224 @@
+// Package metricsutil prometheus 指标；指标名称前缀为 app.server_name，命名约定见 README.md
+package metricsutil
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	// DefaultPath 指标的 http 路径
+	DefaultPath = "/metrics"
+)
+
+// 标签
+const (
+	LabelKind      = "kind"
+	LabelOperation = "operation"
+	LabelCode      = "code"
+	LabelSystem    = "system"
+	LabelInstance  = "instance"
+	LabelPool      = "pool"
+	LabelCommand   = "command"
+	LabelStatus    = "status"
+	LabelState     = "state"
+	LabelCache     = "cache"
+	LabelTier      = "tier"
+	LabelResult    = "result"
+
+	StatusOK    = "ok"
+	StatusError = "error"
+
+	// PoolPrimary 主库的连接池；从库为 replica_{序号}，序号从 0 开始
+	PoolPrimary = "primary"
+)
+
+// options 可选项
+type options struct {
+	path            string
+	durationBuckets []float64
+	registry        *prometheus.Registry
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithPath 指标的 http 路径；默认 DefaultPath
+func WithPath(path string) Option {
+	return func(o *options) {
+		o.path = path
+	}
+}
+
+// WithDurationBuckets 耗时直方图的桶(s)；默认 prometheus.DefBuckets
+func WithDurationBuckets(buckets []float64) Option {
+	return func(o *options) {
+		o.durationBuckets = buckets
+	}
+}
+
+// WithRegistry 注册表；默认 prometheus.NewRegistry
+func WithRegistry(registry *prometheus.Registry) Option {
+	return func(o *options) {
+		o.registry = registry
+	}
+}
+
+// Metrics 指标
+type Metrics struct {
+	opts      *options
+	namespace string
+	registry  *prometheus.Registry
+
+	serverRequests *prometheus.CounterVec
+	serverDuration *prometheus.HistogramVec
+	clientRequests *prometheus.CounterVec
+	clientDuration *prometheus.HistogramVec
+	dbDuration     *prometheus.HistogramVec
+	redisDuration  *prometheus.HistogramVec
+	cacheRequests  *prometheus.CounterVec
+
+	dbStats    *dbStatsCollector
+	redisStats *redisStatsCollector
+}
+
+// NewMetrics 指标；serverName = app.server_name，作为指标名称前缀；包含 go 运行时与进程指标
+func NewMetrics(serverName string, opts ...Option) (*Metrics, error) {
+	metricsOpts := &options{
+		path:            DefaultPath,
+		durationBuckets: prometheus.DefBuckets,
+	}
+	for i := range opts {
+		opts[i](metricsOpts)
+	}
+	if metricsOpts.path == "" {
+		metricsOpts.path = DefaultPath
+	}
+	if len(metricsOpts.durationBuckets) == 0 {
+		metricsOpts.durationBuckets = prometheus.DefBuckets
+	}
+	if metricsOpts.registry == nil {
+		metricsOpts.registry = prometheus.NewRegistry()
+	}
+
+	namespace := Namespace(serverName)
+	m := &Metrics{
+		opts:      metricsOpts,
+		namespace: namespace,
+		registry:  metricsOpts.registry,
+		serverRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "server",
+			Name:      "requests_total",
+			Help:      "The total number of requests handled by the server.",
+		}, []string{LabelKind, LabelOperation, LabelCode}),
+		serverDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "server",
+			Name:      "request_duration_seconds",
+			Help:      "The duration of requests handled by the server.",
+			Buckets:   metricsOpts.durationBuckets,
+		}, []string{LabelKind, LabelOperation, LabelCode}),
+		clientRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "client",
+			Name:      "requests_total",
+			Help:      "The total number of requests sent by the client.",
+		}, []string{LabelKind, LabelOperation, LabelCode}),
+		clientDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "client",
+			Name:      "request_duration_seconds",
+			Help:      "The duration of requests sent by the client.",
+			Buckets:   metricsOpts.durationBuckets,
+		}, []string{LabelKind, LabelOperation, LabelCode}),
+		dbDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "query_duration_seconds",
+			Help:      "The duration of database queries.",
+			Buckets:   metricsOpts.durationBuckets,
+		}, []string{LabelSystem, LabelInstance, LabelOperation, LabelStatus}),
+		redisDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "redis",
+			Name:      "command_duration_seconds",
+			Help:      "The duration of redis commands.",
+			Buckets:   metricsOpts.durationBuckets,
+		}, []string{LabelInstance, LabelCommand, LabelStatus}),
+		cacheRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "cache",
+			Name:      "requests_total",
+			Help:      "The total number of cache lookups per tier.",
+		}, []string{LabelCache, LabelTier, LabelResult}),
+		dbStats:    newDBStatsCollector(namespace),
+		redisStats: newRedisStatsCollector(namespace),
+	}
+
+	cs := []prometheus.Collector{
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.serverRequests,
+		m.serverDuration,
+		m.clientRequests,
+		m.clientDuration,
+		m.dbDuration,
+		m.redisDuration,
+		m.cacheRequests,
+		m.dbStats,
+		m.redisStats,
+	}
+	for i := range cs {
+		if err := m.registry.Register(cs[i]); err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+	}
+	return m, nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-zA-Z0-9_]+`)
+
+// Namespace 指标名称前缀；例：saas-ping-server => saas_ping_server
+func Namespace(serverName string) string {
+	namespace := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(serverName), "_"), "_")
+	if namespace == "" {
+		return ""
+	}
+	if namespace[0] >= '0' && namespace[0] <= '9' {
+		namespace = "_" + namespace
+	}
+	return namespace
+}
+
+// Namespace 指标名称前缀
+func (m *Metrics) Namespace() string {
+	return m.namespace
+}
+
+// Path 指标的 http 路径
+func (m *Metrics) Path() string {
+	return m.opts.path
+}
+
+// Registry 注册表；可注册业务指标
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler 指标的 http 处理
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// RegisterHTTPServer 在 http 服务上暴露指标
+func (m *Metrics) RegisterHTTPServer(srv *khttp.Server) {
+	srv.Handle(m.opts.path, m.Handler())
+}
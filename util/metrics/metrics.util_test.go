@@ -0,0 +1,198 @@
+package metricsutil
+
+import (
+	"context"
+	"database/sql"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// testingTransport transport.Transporter
+type testingTransport struct {
+	kind      transport.Kind
+	operation string
+}
+
+func (t *testingTransport) Kind() transport.Kind            { return t.kind }
+func (t *testingTransport) Endpoint() string                { return "" }
+func (t *testingTransport) Operation() string               { return t.operation }
+func (t *testingTransport) RequestHeader() transport.Header { return nil }
+func (t *testingTransport) ReplyHeader() transport.Header   { return nil }
+func (t *testingTransport) String() string                  { return t.operation }
+func (t *testingTransport) withServer() context.Context {
+	return transport.NewServerContext(context.Background(), t)
+}
+func (t *testingTransport) withClient() context.Context {
+	return transport.NewClientContext(context.Background(), t)
+}
+func newTestingTransport(kind transport.Kind, operation string) *testingTransport {
+	return &testingTransport{kind: kind, operation: operation}
+}
+
+func newTestingMetrics(t *testing.T) *Metrics {
+	m, err := NewMetrics("saas-ping-server")
+	require.NoError(t, err)
+	return m
+}
+
+// go test -v ./util/metrics/ -count=1 -test.run=TestNamespace
+func TestNamespace(t *testing.T) {
+	require.Equal(t, "saas_ping_server", Namespace("saas-ping-server"))
+	require.Equal(t, "saas_ping_server", Namespace("Saas.Ping Server"))
+	require.Equal(t, "_1_server", Namespace("1-server"))
+	require.Equal(t, "", Namespace("--"))
+}
+
+// go test -v ./util/metrics/ -count=1 -test.run=TestMetrics_Middleware
+func TestMetrics_Middleware(t *testing.T) {
+	m := newTestingMetrics(t)
+
+	handler := m.ServerMiddleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		if req == "fail" {
+			return nil, errors.NotFound("NOT_FOUND", "not found")
+		}
+		return "ok", nil
+	})
+	ctx := newTestingTransport(transport.KindGRPC, "/ping.v1.SrvPing/Ping").withServer()
+	_, err := handler(ctx, "ok")
+	require.NoError(t, err)
+	_, err = handler(ctx, "ok")
+	require.NoError(t, err)
+	_, err = handler(ctx, "fail")
+	require.Error(t, err)
+
+	require.Equal(t, float64(2), testutil.ToFloat64(m.serverRequests.WithLabelValues("grpc", "/ping.v1.SrvPing/Ping", "200")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.serverRequests.WithLabelValues("grpc", "/ping.v1.SrvPing/Ping", "404")))
+	require.Equal(t, 2, testutil.CollectAndCount(m.serverDuration))
+
+	clientHandler := m.ClientMiddleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	_, err = clientHandler(newTestingTransport(transport.KindHTTP, "/api/v1/ping").withClient(), nil)
+	require.NoError(t, err)
+	require.Equal(t, float64(1), testutil.ToFloat64(m.clientRequests.WithLabelValues("http", "/api/v1/ping", "200")))
+}
+
+// go test -v ./util/metrics/ -count=1 -test.run=TestMetrics_Gorm
+func TestMetrics_Gorm(t *testing.T) {
+	m := newTestingMetrics(t)
+
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	defer func() { _ = sqlDB.Close() }()
+	require.NoError(t, db.Use(m.GormPlugin("sqlite", "default")))
+	m.RegisterDBStats("sqlite", "default", sqlDB)
+
+	type testingUser struct {
+		ID   uint64
+		Name string
+	}
+	require.NoError(t, db.AutoMigrate(&testingUser{}))
+	require.NoError(t, db.Create(&testingUser{Name: "testing"}).Error)
+	var user testingUser
+	require.NoError(t, db.First(&user).Error)
+	require.ErrorIs(t, db.Where("name = ?", "nobody").First(&user).Error, gorm.ErrRecordNotFound)
+	require.Error(t, db.Exec("SELECT * FROM not_exists").Error)
+
+	names := gatherSeries(t, m)
+	require.Contains(t, names, `saas_ping_server_db_query_duration_seconds_count{instance="default",operation="create",status="ok",system="sqlite"} 1`)
+	require.Contains(t, names, `saas_ping_server_db_query_duration_seconds_count{instance="default",operation="query",status="ok",system="sqlite"} 2`)
+	require.Contains(t, names, `saas_ping_server_db_query_duration_seconds_count{instance="default",operation="raw",status="error",system="sqlite"} 1`)
+	require.Contains(t, names, `saas_ping_server_db_pool_connections{instance="default",pool="primary",state="idle",system="sqlite"}`)
+	require.Contains(t, names, `saas_ping_server_db_pool_wait_count_total{instance="default",pool="primary",system="sqlite"} 0`)
+
+	// 从库；重复注册时替换全部从库
+	replica1, err := sql.Open("sqlite3", "file::memory:")
+	require.NoError(t, err)
+	defer func() { _ = replica1.Close() }()
+	replica2, err := sql.Open("sqlite3", "file::memory:")
+	require.NoError(t, err)
+	defer func() { _ = replica2.Close() }()
+	m.RegisterDBReplicaStats("sqlite", "default", []*sql.DB{replica1, replica2})
+	names = gatherSeries(t, m)
+	require.Contains(t, names, `saas_ping_server_db_pool_connections{instance="default",pool="replica_0",state="in_use",system="sqlite"} 0`)
+	require.Contains(t, names, `saas_ping_server_db_pool_connections{instance="default",pool="replica_1",state="in_use",system="sqlite"} 0`)
+	m.RegisterDBReplicaStats("sqlite", "default", []*sql.DB{replica1})
+	names = gatherSeries(t, m)
+	require.Contains(t, names, `saas_ping_server_db_pool_connections{instance="default",pool="replica_0",state="in_use",system="sqlite"} 0`)
+	require.NotContains(t, names, `pool="replica_1"`)
+	// 跳过的从库不影响其他从库的序号
+	m.RegisterDBReplicaStats("sqlite", "default", []*sql.DB{nil, replica2})
+	names = gatherSeries(t, m)
+	require.NotContains(t, names, `pool="replica_0"`)
+	require.Contains(t, names, `saas_ping_server_db_pool_connections{instance="default",pool="replica_1",state="in_use",system="sqlite"} 0`)
+	require.Contains(t, names, `saas_ping_server_db_pool_connections{instance="default",pool="primary",state="idle",system="sqlite"}`)
+
+	m.UnregisterDBStats("sqlite", "default")
+	require.NotContains(t, gatherSeries(t, m), `saas_ping_server_db_pool_connections`)
+}
+
+// go test -v ./util/metrics/ -count=1 -test.run=TestMetrics_Redis
+func TestMetrics_Redis(t *testing.T) {
+	m := newTestingMetrics(t)
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	defer func() { _ = client.Close() }()
+	client.AddHook(m.RedisHook("default"))
+	m.RegisterRedisStats("default", client)
+
+	ctx := context.Background()
+	require.NoError(t, client.Set(ctx, "testing", "value", 0).Err())
+	require.ErrorIs(t, client.Get(ctx, "not_exists").Err(), redis.Nil)
+	require.Error(t, client.Do(ctx, "not_a_command").Err())
+	_, err := client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Get(ctx, "testing")
+		pipe.Get(ctx, "testing")
+		return nil
+	})
+	require.NoError(t, err)
+
+	names := gatherSeries(t, m)
+	require.Contains(t, names, `saas_ping_server_redis_command_duration_seconds_count{command="set",instance="default",status="ok"} 1`)
+	require.Contains(t, names, `saas_ping_server_redis_command_duration_seconds_count{command="get",instance="default",status="ok"} 1`)
+	require.Contains(t, names, `saas_ping_server_redis_command_duration_seconds_count{command="not_a_command",instance="default",status="error"} 1`)
+	require.Contains(t, names, `saas_ping_server_redis_command_duration_seconds_count{command="pipeline",instance="default",status="ok"} 1`)
+	require.Contains(t, names, `saas_ping_server_redis_pool_connections{instance="default",state="total"} 1`)
+	require.Contains(t, names, `saas_ping_server_redis_pool_connections{instance="default",state="in_use"} 0`)
+	require.Contains(t, names, `saas_ping_server_redis_pool_hits_total{instance="default"}`)
+}
+
+// go test -v ./util/metrics/ -count=1 -test.run=TestMetrics_Handler
+func TestMetrics_Handler(t *testing.T) {
+	m, err := NewMetrics("saas-ping-server", WithPath("/internal/metrics"), WithDurationBuckets([]float64{0.1, 1}))
+	require.NoError(t, err)
+	require.Equal(t, "/internal/metrics", m.Path())
+	require.Equal(t, "saas_ping_server", m.Namespace())
+
+	names := gatherSeries(t, m)
+	require.Contains(t, names, "go_goroutines")
+	require.Contains(t, names, "go_memstats_alloc_bytes")
+}
+
+// gatherSeries 通过 http 获取的指标文本
+func gatherSeries(t *testing.T, m *Metrics) string {
+	server := httptest.NewServer(m.Handler())
+	defer server.Close()
+	resp, err := http.Get(server.URL)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return strings.TrimSpace(string(body))
+}
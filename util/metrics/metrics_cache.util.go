@@ -0,0 +1,8 @@
+package metricsutil
+
+// RecordCache 缓存查询；标签：cache、tier(local、redis)、result(hit、stale、miss)
+//
+//	cacheutil.NewCache(name, redisCC, cacheutil.WithMetrics(metrics))
+func (m *Metrics) RecordCache(cache, tier, result string) {
+	m.cacheRequests.WithLabelValues(cache, tier, result).Inc()
+}
@@ -0,0 +1,213 @@
+package metricsutil
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"gorm.io/gorm"
+)
+
+const (
+	gormPluginName     = "metricsutil:gorm"
+	gormStartTimeKey   = "metricsutil:start_time"
+	gormCallbackPrefix = "metricsutil:"
+)
+
+// gormPlugin 查询耗时
+type gormPlugin struct {
+	duration *prometheus.HistogramVec
+	system   string
+	instance string
+}
+
+// GormPlugin gorm 插件：查询耗时；标签：system(mysql、postgres)、instance、operation、status
+//
+//	err = db.Use(metrics.GormPlugin("mysql", "default"))
+func (m *Metrics) GormPlugin(system, instance string) gorm.Plugin {
+	return &gormPlugin{duration: m.dbDuration, system: system, instance: instance}
+}
+
+// Name gorm.Plugin
+func (p *gormPlugin) Name() string {
+	return gormPluginName
+}
+
+// Initialize gorm.Plugin
+func (p *gormPlugin) Initialize(db *gorm.DB) error {
+	callback := db.Callback()
+	processors := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", callback.Create().Before("*").Register, callback.Create().After("*").Register},
+		{"query", callback.Query().Before("*").Register, callback.Query().After("*").Register},
+		{"update", callback.Update().Before("*").Register, callback.Update().After("*").Register},
+		{"delete", callback.Delete().Before("*").Register, callback.Delete().After("*").Register},
+		{"row", callback.Row().Before("*").Register, callback.Row().After("*").Register},
+		{"raw", callback.Raw().Before("*").Register, callback.Raw().After("*").Register},
+	}
+	for _, processor := range processors {
+		if err := processor.before(gormCallbackPrefix+"before_"+processor.operation, p.before); err != nil {
+			return err
+		}
+		if err := processor.after(gormCallbackPrefix+"after_"+processor.operation, p.after(processor.operation)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// before ...
+func (p *gormPlugin) before(db *gorm.DB) {
+	db.InstanceSet(gormStartTimeKey, time.Now())
+}
+
+// after ...
+func (p *gormPlugin) after(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		value, ok := db.InstanceGet(gormStartTimeKey)
+		if !ok {
+			return
+		}
+		startTime, ok := value.(time.Time)
+		if !ok {
+			return
+		}
+		status := StatusOK
+		if db.Error != nil && !errors.Is(db.Error, gorm.ErrRecordNotFound) {
+			status = StatusError
+		}
+		p.duration.WithLabelValues(p.system, p.instance, operation, status).Observe(time.Since(startTime).Seconds())
+	}
+}
+
+// RegisterDBStats 主库的连接池指标；同一 system 与 instance 重复注册时替换
+func (m *Metrics) RegisterDBStats(system, instance string, db *sql.DB) {
+	m.dbStats.register(dbStatsKey{system: system, instance: instance, pool: PoolPrimary}, db)
+}
+
+// RegisterDBReplicaStats 从库的连接池指标；pool 标签为 replica_{序号}，序号为配置的从库序号；
+// 不支持的从库为 nil，跳过且不影响其他从库的序号；同一 system 与 instance 重复注册时替换全部从库
+func (m *Metrics) RegisterDBReplicaStats(system, instance string, replicas []*sql.DB) {
+	m.dbStats.registerReplicas(system, instance, replicas)
+}
+
+// UnregisterDBStats 移除连接池指标；包括从库；关闭数据库后调用
+func (m *Metrics) UnregisterDBStats(system, instance string) {
+	m.dbStats.unregister(system, instance, true)
+}
+
+// ReplicaPool 从库的 pool 标签
+func ReplicaPool(index int) string {
+	return "replica_" + strconv.Itoa(index)
+}
+
+// dbStatsKey ...
+type dbStatsKey struct {
+	system   string
+	instance string
+	pool     string
+}
+
+// dbStatsCollector 连接池指标
+type dbStatsCollector struct {
+	mutex sync.RWMutex
+	dbs   map[dbStatsKey]*sql.DB
+
+	maxOpen      *prometheus.Desc
+	connections  *prometheus.Desc
+	waitCount    *prometheus.Desc
+	waitDuration *prometheus.Desc
+}
+
+// newDBStatsCollector ...
+func newDBStatsCollector(namespace string) *dbStatsCollector {
+	labels := []string{LabelSystem, LabelInstance, LabelPool}
+	return &dbStatsCollector{
+		dbs: make(map[dbStatsKey]*sql.DB),
+		maxOpen: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db", "pool_max_open_connections"),
+			"Maximum number of open connections to the database.",
+			labels, nil,
+		),
+		connections: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db", "pool_connections"),
+			"The number of connections by state: in_use, idle.",
+			append(labels, LabelState), nil,
+		),
+		waitCount: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db", "pool_wait_count_total"),
+			"The total number of connections waited for.",
+			labels, nil,
+		),
+		waitDuration: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "db", "pool_wait_duration_seconds_total"),
+			"The total time blocked waiting for a new connection.",
+			labels, nil,
+		),
+	}
+}
+
+func (c *dbStatsCollector) register(key dbStatsKey, db *sql.DB) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.dbs[key] = db
+}
+
+func (c *dbStatsCollector) registerReplicas(system, instance string, replicas []*sql.DB) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.deleteLocked(system, instance, false)
+	for i := range replicas {
+		if replicas[i] == nil {
+			continue
+		}
+		c.dbs[dbStatsKey{system: system, instance: instance, pool: ReplicaPool(i)}] = replicas[i]
+	}
+}
+
+func (c *dbStatsCollector) unregister(system, instance string, withPrimary bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.deleteLocked(system, instance, withPrimary)
+}
+
+// deleteLocked 移除从库；withPrimary 时包括主库
+func (c *dbStatsCollector) deleteLocked(system, instance string, withPrimary bool) {
+	for key := range c.dbs {
+		if key.system != system || key.instance != instance {
+			continue
+		}
+		if key.pool == PoolPrimary && !withPrimary {
+			continue
+		}
+		delete(c.dbs, key)
+	}
+}
+
+// Describe prometheus.Collector
+func (c *dbStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.maxOpen
+	ch <- c.connections
+	ch <- c.waitCount
+	ch <- c.waitDuration
+}
+
+// Collect prometheus.Collector
+func (c *dbStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for key, db := range c.dbs {
+		stats := db.Stats()
+		ch <- prometheus.MustNewConstMetric(c.maxOpen, prometheus.GaugeValue, float64(stats.MaxOpenConnections), key.system, key.instance, key.pool)
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stats.InUse), key.system, key.instance, key.pool, "in_use")
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stats.Idle), key.system, key.instance, key.pool, "idle")
+		ch <- prometheus.MustNewConstMetric(c.waitCount, prometheus.CounterValue, float64(stats.WaitCount), key.system, key.instance, key.pool)
+		ch <- prometheus.MustNewConstMetric(c.waitDuration, prometheus.CounterValue, stats.WaitDuration.Seconds(), key.system, key.instance, key.pool)
+	}
+}
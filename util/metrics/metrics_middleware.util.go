@@ -0,0 +1,54 @@
+package metricsutil
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ServerMiddleware 服务端中间件：请求数量与耗时；标签：kind、operation、code
+func (m *Metrics) ServerMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var kind, operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				kind, operation = tr.Kind().String(), tr.Operation()
+			}
+			startTime := time.Now()
+			reply, err := handler(ctx, req)
+			observeRequest(m.serverRequests, m.serverDuration, kind, operation, err, startTime)
+			return reply, err
+		}
+	}
+}
+
+// ClientMiddleware 客户端中间件：请求数量与耗时；标签：kind、operation、code
+func (m *Metrics) ClientMiddleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var kind, operation string
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				kind, operation = tr.Kind().String(), tr.Operation()
+			}
+			startTime := time.Now()
+			reply, err := handler(ctx, req)
+			observeRequest(m.clientRequests, m.clientDuration, kind, operation, err, startTime)
+			return reply, err
+		}
+	}
+}
+
+// observeRequest code 为 kratos 错误码(http状态码语义)；成功为 200
+func observeRequest(requests *prometheus.CounterVec, duration *prometheus.HistogramVec, kind, operation string, err error, startTime time.Time) {
+	code := strconv.Itoa(200)
+	if err != nil {
+		code = strconv.Itoa(int(errors.FromError(err).Code))
+	}
+	requests.WithLabelValues(kind, operation, code).Inc()
+	duration.WithLabelValues(kind, operation, code).Observe(time.Since(startTime).Seconds())
+}
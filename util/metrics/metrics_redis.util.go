@@ -0,0 +1,155 @@
+package metricsutil
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+// redisHook 命令耗时
+type redisHook struct {
+	duration *prometheus.HistogramVec
+	instance string
+}
+
+// RedisHook redis 钩子：命令耗时；标签：instance、command、status；管道的 command 为 pipeline
+//
+//	client.AddHook(metrics.RedisHook("default"))
+func (m *Metrics) RedisHook(instance string) redis.Hook {
+	return &redisHook{duration: m.redisDuration, instance: instance}
+}
+
+// DialHook redis.Hook
+func (h *redisHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook redis.Hook
+func (h *redisHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		startTime := time.Now()
+		err := next(ctx, cmd)
+		h.observe(strings.ToLower(cmd.Name()), err, startTime)
+		return err
+	}
+}
+
+// ProcessPipelineHook redis.Hook
+func (h *redisHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		startTime := time.Now()
+		err := next(ctx, cmds)
+		h.observe("pipeline", err, startTime)
+		return err
+	}
+}
+
+// observe redis.Nil 视为成功
+func (h *redisHook) observe(command string, err error, startTime time.Time) {
+	status := StatusOK
+	if err != nil && !errors.Is(err, redis.Nil) {
+		status = StatusError
+	}
+	h.duration.WithLabelValues(h.instance, command, status).Observe(time.Since(startTime).Seconds())
+}
+
+// RegisterRedisStats 连接池指标；同一 instance 重复注册时替换
+func (m *Metrics) RegisterRedisStats(instance string, client redis.UniversalClient) {
+	m.redisStats.register(instance, client)
+}
+
+// UnregisterRedisStats 移除连接池指标；关闭客户端后调用
+func (m *Metrics) UnregisterRedisStats(instance string) {
+	m.redisStats.unregister(instance)
+}
+
+// redisStatsCollector 连接池指标
+type redisStatsCollector struct {
+	mutex   sync.RWMutex
+	clients map[string]redis.UniversalClient
+
+	connections *prometheus.Desc
+	hits        *prometheus.Desc
+	misses      *prometheus.Desc
+	timeouts    *prometheus.Desc
+}
+
+// newRedisStatsCollector ...
+func newRedisStatsCollector(namespace string) *redisStatsCollector {
+	labels := []string{LabelInstance}
+	return &redisStatsCollector{
+		clients: make(map[string]redis.UniversalClient),
+		connections: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "redis", "pool_connections"),
+			"The number of connections by state: total, in_use, idle, stale.",
+			[]string{LabelInstance, LabelState}, nil,
+		),
+		hits: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "redis", "pool_hits_total"),
+			"The total number of times a free connection was found in the pool.",
+			labels, nil,
+		),
+		misses: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "redis", "pool_misses_total"),
+			"The total number of times a free connection was not found in the pool.",
+			labels, nil,
+		),
+		timeouts: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "redis", "pool_timeouts_total"),
+			"The total number of times a wait for a connection timed out.",
+			labels, nil,
+		),
+	}
+}
+
+func (c *redisStatsCollector) register(instance string, client redis.UniversalClient) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.clients[instance] = client
+}
+
+func (c *redisStatsCollector) unregister(instance string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.clients, instance)
+}
+
+// Describe prometheus.Collector
+func (c *redisStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.connections
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+}
+
+// Collect prometheus.Collector
+func (c *redisStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for instance, client := range c.clients {
+		stats := client.PoolStats()
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stats.TotalConns), instance, "total")
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(redisInUseConns(stats)), instance, "in_use")
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stats.IdleConns), instance, "idle")
+		ch <- prometheus.MustNewConstMetric(c.connections, prometheus.GaugeValue, float64(stats.StaleConns), instance, "stale")
+		ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits), instance)
+		ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses), instance)
+		ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(stats.Timeouts), instance)
+	}
+}
+
+// redisInUseConns 使用中的连接：总数减去空闲
+func redisInUseConns(stats *redis.PoolStats) uint32 {
+	if stats == nil || stats.TotalConns < stats.IdleConns {
+		return 0
+	}
+	return stats.TotalConns - stats.IdleConns
+}
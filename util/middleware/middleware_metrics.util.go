@@ -0,0 +1,46 @@
+package middlewareutil
+
+import (
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	middlewarepkg "github.com/ikaiguang/go-srv-kit/kratos/middleware"
+	setuputil "github.com/my-saas-platform/api-proto/util/setup"
+)
+
+// NewServerMiddlewares 服务端中间件；配置 server.metrics.enable = true 时，最外层添加指标中间件
+//
+//	middlewares, err := middlewareutil.NewServerMiddlewares(engineHandler, logHelper)
+//	srv := http.NewServer(http.Middleware(middlewares...))
+//	metrics.RegisterHTTPServer(srv) // 暴露 server.metrics.path
+func NewServerMiddlewares(engineHandler setuputil.Engine, logHelper *log.Helper) ([]middleware.Middleware, error) {
+	middlewares := middlewarepkg.DefaultServerMiddlewares(logHelper)
+	if cfg := engineHandler.MetricsConfig(); cfg == nil || !cfg.Enable {
+		return middlewares, nil
+	}
+	metrics, err := engineHandler.GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+	return append([]middleware.Middleware{metrics.ServerMiddleware()}, middlewares...), nil
+}
+
+// NewClientMiddlewares 客户端中间件；配置 server.metrics.enable = true 时，最外层添加指标中间件；
+// 配置 setting.encrypt_secret.service_encrypt.enable = true 且 audience(被调用方的服务名称)不为空时，最内层添加服务间请求签名中间件
+func NewClientMiddlewares(engineHandler setuputil.Engine, logHelper *log.Helper, audience string) ([]middleware.Middleware, error) {
+	middlewares := middlewarepkg.DefaultClientMiddlewares(logHelper)
+	if cfg := engineHandler.ServiceEncryptConfig(); cfg != nil && cfg.Enable && audience != "" {
+		signMiddleware, err := NewServiceSignClientMiddleware(engineHandler, audience)
+		if err != nil {
+			return nil, err
+		}
+		middlewares = append(middlewares, signMiddleware)
+	}
+	if cfg := engineHandler.MetricsConfig(); cfg == nil || !cfg.Enable {
+		return middlewares, nil
+	}
+	metrics, err := engineHandler.GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+	return append([]middleware.Middleware{metrics.ClientMiddleware()}, middlewares...), nil
+}
@@ -0,0 +1,229 @@
+package middlewareutil
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	authpkg "github.com/ikaiguang/go-srv-kit/kratos/auth"
+	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	ratelimitutil "github.com/my-saas-platform/api-proto/util/ratelimit"
+	setuputil "github.com/my-saas-platform/api-proto/util/setup"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// 限流维度
+	RateLimitKeyByIP     = "ip"
+	RateLimitKeyByUser   = "user"
+	RateLimitKeyByTenant = "tenant"
+
+	// RetryAfterHeader 被限流时的等待秒数
+	RetryAfterHeader = "Retry-After"
+	// RetryAfterMetadataKey 被限流时错误的元数据：等待秒数
+	RetryAfterMetadataKey = "retry_after"
+
+	// rateLimitConfigKey 监听的配置
+	rateLimitConfigKey = "setting.rate_limit"
+)
+
+// RateLimitTenantFunc 从认证信息解析租户；返回空时使用 ip
+type RateLimitTenantFunc func(claims *authpkg.Claims) string
+
+// rateLimitOptions ...
+type rateLimitOptions struct {
+	tenantFunc RateLimitTenantFunc
+}
+
+// RateLimitOption 限流中间件的可选项
+type RateLimitOption func(*rateLimitOptions)
+
+// WithRateLimitTenant 按租户限流时，从 jwt 中间件校验后的认证信息解析租户；
+// 不使用请求头：客户端可伪造请求头绕过限流
+func WithRateLimitTenant(fn RateLimitTenantFunc) RateLimitOption {
+	return func(o *rateLimitOptions) {
+		o.tenantFunc = fn
+	}
+}
+
+// NewRateLimitMiddleware 限流中间件；规则为 setting.rate_limit，配置变更时生效；
+// 启用 redis 时多个副本共享计数，redis 不可用时使用进程内限流；
+// 按用户、租户限流时，添加在 jwt 中间件之后；按租户限流需 WithRateLimitTenant
+func NewRateLimitMiddleware(engineHandler setuputil.Engine, opts ...RateLimitOption) (middleware.Middleware, error) {
+	opt := &rateLimitOptions{}
+	for i := range opts {
+		opts[i](opt)
+	}
+	cfg := engineHandler.SettingConfig().GetRateLimit()
+	if err := validateRateLimitConfig(cfg, opt.tenantFunc != nil); err != nil {
+		return nil, err
+	}
+
+	var redisCC redis.UniversalClient
+	if redisConfig := engineHandler.RedisConfig(); redisConfig != nil && redisConfig.Enable {
+		var err error
+		if redisCC, err = engineHandler.GetRedisClient(); err != nil {
+			return nil, err
+		}
+	}
+	limiter := ratelimitutil.NewLimiter(redisCC,
+		ratelimitutil.WithKeyPrefix(apputil.KeyPrefix(engineHandler.AppConfig())+ratelimitutil.DefaultKeyPrefix),
+		ratelimitutil.WithBreaker(cfg.GetRedisBreakerBackoff().AsDuration(), cfg.GetRedisBreakerMaxBackoff().AsDuration()),
+	)
+	r, err := newRateLimiter(limiter, cfg, opt.tenantFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	// 配置变更
+	var observer = func(k string, v config.Value) {
+		newConfig := &configs.Setting_RateLimit{}
+		if err := v.Scan(newConfig); err != nil {
+			logpkg.Warnw("ratelimit.status", "invalid config", "ratelimit.error", err.Error())
+			return
+		}
+		if err := r.update(newConfig); err != nil {
+			logpkg.Warnw("ratelimit.status", "invalid config", "ratelimit.error", err.Error())
+			return
+		}
+		logpkg.Infow("ratelimit.status", "config updated")
+	}
+	err = engineHandler.Watch(rateLimitConfigKey, observer)
+	if err != nil && !pkgerrors.Is(err, config.ErrNotFound) && !setuputil.IsUninitializedError(err) {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return r.middleware(), nil
+}
+
+// rateLimiter 限流
+type rateLimiter struct {
+	limiter    *ratelimitutil.Limiter
+	tenantFunc RateLimitTenantFunc
+	cfg        atomic.Pointer[rateLimitConfig]
+}
+
+// rateLimitConfig 规则与信任的代理
+type rateLimitConfig struct {
+	*configs.Setting_RateLimit
+	trustedProxies []*net.IPNet
+}
+
+// newRateLimiter ...
+func newRateLimiter(limiter *ratelimitutil.Limiter, cfg *configs.Setting_RateLimit, tenantFunc RateLimitTenantFunc) (*rateLimiter, error) {
+	r := &rateLimiter{limiter: limiter, tenantFunc: tenantFunc}
+	if err := r.update(cfg); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// update 更新规则；规则无效时保留原有的规则
+func (r *rateLimiter) update(cfg *configs.Setting_RateLimit) error {
+	if err := validateRateLimitConfig(cfg, r.tenantFunc != nil); err != nil {
+		return err
+	}
+	trustedProxies, err := apputil.ParseTrustedProxies(cfg.GetTrustedProxies())
+	if err != nil {
+		return pkgerrors.WithMessage(err, "[请配置服务再启动] setting.rate_limit.trusted_proxies")
+	}
+	r.cfg.Store(&rateLimitConfig{Setting_RateLimit: cfg, trustedProxies: trustedProxies})
+	return nil
+}
+
+// middleware ...
+func (r *rateLimiter) middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			cfg := r.cfg.Load()
+			if !cfg.GetEnable() {
+				return handler(ctx, req)
+			}
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			rule, ok := cfg.GetOperations()[tr.Operation()]
+			if !ok {
+				rule = cfg.GetDefaultRule()
+			}
+			if rule.GetLimit() == 0 {
+				return handler(ctx, req)
+			}
+
+			key := tr.Operation() + ":" + r.rateLimitKey(ctx, cfg, rule.GetKeyBy())
+			result, err := r.limiter.Allow(ctx, key, ratelimitutil.Rule{
+				Algorithm: rule.GetAlgorithm(),
+				Limit:     int64(rule.GetLimit()),
+				Window:    rule.GetWindow().AsDuration(),
+				Burst:     int64(rule.GetBurst()),
+			})
+			if err != nil {
+				return nil, err
+			}
+			if !result.Allowed {
+				retryAfter := strconv.FormatInt(int64(math.Ceil(result.RetryAfter.Seconds())), 10)
+				tr.ReplyHeader().Set(RetryAfterHeader, retryAfter)
+				e := errorpkg.TooManyRequestsWithMetadata(
+					errorpkg.ERROR_TOO_MANY_REQUESTS.String(),
+					"请求过于频繁，请稍后再试",
+					map[string]string{RetryAfterMetadataKey: retryAfter},
+				)
+				return nil, errorpkg.WithStack(e)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// rateLimitKey 限流维度的值；用户、租户来自认证信息，缺失时使用 ip
+func (r *rateLimiter) rateLimitKey(ctx context.Context, cfg *rateLimitConfig, keyBy string) string {
+	switch keyBy {
+	case RateLimitKeyByUser:
+		if claims, ok := authpkg.GetAuthClaimsFromContext(ctx); ok && claims.Payload != nil {
+			return RateLimitKeyByUser + ":" + claims.Payload.UserIdentifier()
+		}
+	case RateLimitKeyByTenant:
+		if claims, ok := authpkg.GetAuthClaimsFromContext(ctx); ok && r.tenantFunc != nil {
+			if tenant := r.tenantFunc(claims); tenant != "" {
+				return RateLimitKeyByTenant + ":" + tenant
+			}
+		}
+	}
+	return RateLimitKeyByIP + ":" + apputil.ClientIP(ctx, cfg.trustedProxies)
+}
+
+// validateRateLimitConfig ...
+func validateRateLimitConfig(cfg *configs.Setting_RateLimit, tenantEnabled bool) error {
+	rules := make(map[string]*configs.Setting_RateLimit_Rule, len(cfg.GetOperations())+1)
+	for operation, rule := range cfg.GetOperations() {
+		rules[operation] = rule
+	}
+	if rule := cfg.GetDefaultRule(); rule != nil {
+		rules["default_rule"] = rule
+	}
+	for name, rule := range rules {
+		switch rule.GetAlgorithm() {
+		case "", ratelimitutil.AlgorithmFixedWindow, ratelimitutil.AlgorithmSlidingWindow, ratelimitutil.AlgorithmTokenBucket:
+		default:
+			return pkgerrors.Errorf("[请配置服务再启动] setting.rate_limit : %s : unknown algorithm %q", name, rule.GetAlgorithm())
+		}
+		switch rule.GetKeyBy() {
+		case "", RateLimitKeyByIP, RateLimitKeyByUser, RateLimitKeyByTenant:
+		default:
+			return pkgerrors.Errorf("[请配置服务再启动] setting.rate_limit : %s : unknown key_by %q", name, rule.GetKeyBy())
+		}
+		if rule.GetKeyBy() == RateLimitKeyByTenant && !tenantEnabled {
+			return pkgerrors.Errorf("[请配置服务再启动] setting.rate_limit : %s : key_by %q requires middlewareutil.WithRateLimitTenant", name, rule.GetKeyBy())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package middlewareutil
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v4"
+	authpkg "github.com/ikaiguang/go-srv-kit/kratos/auth"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	ratelimitutil "github.com/my-saas-platform/api-proto/util/ratelimit"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// testingHeader transport.Header
+type testingHeader metadata.MD
+
+func (h testingHeader) Get(key string) string {
+	if values := metadata.MD(h).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (h testingHeader) Set(key, value string) { metadata.MD(h).Set(key, value) }
+func (h testingHeader) Add(key, value string) { metadata.MD(h).Append(key, value) }
+func (h testingHeader) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+func (h testingHeader) Values(key string) []string { return metadata.MD(h).Get(key) }
+
+// testingTransport transport.Transporter
+type testingTransport struct {
+	operation     string
+	requestHeader testingHeader
+	replyHeader   testingHeader
+}
+
+func (t *testingTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *testingTransport) Endpoint() string                { return "" }
+func (t *testingTransport) Operation() string               { return t.operation }
+func (t *testingTransport) RequestHeader() transport.Header { return t.requestHeader }
+func (t *testingTransport) ReplyHeader() transport.Header   { return t.replyHeader }
+
+func newTestingContext(operation, ip string, header map[string]string) (context.Context, *testingTransport) {
+	tr := &testingTransport{
+		operation:     operation,
+		requestHeader: testingHeader(metadata.New(header)),
+		replyHeader:   testingHeader(metadata.MD{}),
+	}
+	ctx := transport.NewServerContext(context.Background(), tr)
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 10000}})
+	return ctx, tr
+}
+
+// go test -v ./util/middleware/ -count=1 -test.run=TestRateLimitMiddleware
+func TestRateLimitMiddleware(t *testing.T) {
+	const (
+		pingOperation  = "/saas.api.ping.servicev1.SrvPingV1/Ping"
+		otherOperation = "/saas.api.ping.servicev1.SrvPingV1/Other"
+	)
+	r, err := newRateLimiter(ratelimitutil.NewLimiter(nil), &configs.Setting_RateLimit{
+		Enable: true,
+		Operations: map[string]*configs.Setting_RateLimit_Rule{
+			pingOperation: {Limit: 2, Window: durationpb.New(time.Minute)},
+		},
+	}, func(claims *authpkg.Claims) string {
+		return claims.Subject
+	})
+	require.NoError(t, err)
+	handler := r.middleware()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+
+	// 按 ip 限流
+	for i := 0; i < 2; i++ {
+		ctx, _ := newTestingContext(pingOperation, "10.0.0.1", nil)
+		_, err := handler(ctx, nil)
+		require.NoError(t, err)
+	}
+	ctx, tr := newTestingContext(pingOperation, "10.0.0.1", nil)
+	_, err = handler(ctx, nil)
+	require.Error(t, err)
+	e := errors.FromError(err)
+	require.Equal(t, int32(429), e.Code)
+	require.Equal(t, codes.ResourceExhausted, e.GRPCStatus().Code())
+	require.Equal(t, "60", e.Metadata[RetryAfterMetadataKey])
+	require.Equal(t, "60", tr.ReplyHeader().Get(RetryAfterHeader))
+
+	// 其他 ip、未配置规则的操作
+	ctx, _ = newTestingContext(pingOperation, "10.0.0.2", nil)
+	_, err = handler(ctx, nil)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		ctx, _ = newTestingContext(otherOperation, "10.0.0.1", nil)
+		_, err = handler(ctx, nil)
+		require.NoError(t, err)
+	}
+
+	// 未配置信任的代理：不使用 X-Forwarded-For
+	ctx, _ = newTestingContext(pingOperation, "10.0.0.1", map[string]string{"X-Forwarded-For": "10.0.0.3"})
+	_, err = handler(ctx, nil)
+	require.Error(t, err)
+
+	// 信任的代理：X-Forwarded-For 从右向左第一个非信任代理的地址
+	require.Error(t, r.update(&configs.Setting_RateLimit{Enable: true, TrustedProxies: []string{"invalid"}}))
+	require.NoError(t, r.update(&configs.Setting_RateLimit{
+		Enable:         true,
+		TrustedProxies: []string{"10.0.0.0/24", "192.168.0.1"},
+		Operations: map[string]*configs.Setting_RateLimit_Rule{
+			pingOperation: {Limit: 1, Window: durationpb.New(time.Minute)},
+		},
+	}))
+	ctx, _ = newTestingContext(pingOperation, "10.0.0.9", map[string]string{"X-Forwarded-For": "172.16.0.1, 192.168.0.1"})
+	_, err = handler(ctx, nil)
+	require.NoError(t, err)
+	// 伪造左侧的地址无效
+	ctx, _ = newTestingContext(pingOperation, "10.0.0.9", map[string]string{"X-Forwarded-For": "1.1.1.1, 172.16.0.1"})
+	_, err = handler(ctx, nil)
+	require.Error(t, err)
+	// 对端地址不是信任的代理
+	ctx, _ = newTestingContext(pingOperation, "172.16.0.2", map[string]string{"X-Forwarded-For": "172.16.0.3"})
+	_, err = handler(ctx, nil)
+	require.NoError(t, err)
+	ctx, _ = newTestingContext(pingOperation, "172.16.0.2", map[string]string{"X-Forwarded-For": "172.16.0.4"})
+	_, err = handler(ctx, nil)
+	require.Error(t, err)
+
+	// 配置变更：默认规则按用户限流，租户限流
+	require.Error(t, r.update(&configs.Setting_RateLimit{
+		Enable:      true,
+		DefaultRule: &configs.Setting_RateLimit_Rule{Limit: 1, Algorithm: "testing"},
+	}))
+	require.NoError(t, r.update(&configs.Setting_RateLimit{
+		Enable:      true,
+		DefaultRule: &configs.Setting_RateLimit_Rule{Limit: 1, KeyBy: RateLimitKeyByUser},
+		Operations: map[string]*configs.Setting_RateLimit_Rule{
+			pingOperation: {Limit: 1, KeyBy: RateLimitKeyByTenant, Algorithm: ratelimitutil.AlgorithmTokenBucket},
+		},
+	}))
+	for _, user := range []string{"user-1", "user-2"} {
+		ctx, _ = newTestingContext(otherOperation, "10.0.0.1", nil)
+		ctx = authpkg.PutAuthClaimsIntoContext(ctx, &authpkg.Claims{Payload: &authpkg.Payload{UserUuid: user}})
+		_, err = handler(ctx, nil)
+		require.NoError(t, err)
+		_, err = handler(ctx, nil)
+		require.Error(t, err)
+	}
+	for _, tenant := range []string{"tenant-1", "tenant-2"} {
+		ctx, _ = newTestingContext(pingOperation, "10.0.0.1", nil)
+		ctx = authpkg.PutAuthClaimsIntoContext(ctx, &authpkg.Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: tenant}})
+		_, err = handler(ctx, nil)
+		require.NoError(t, err)
+		_, err = handler(ctx, nil)
+		require.Error(t, err)
+	}
+	// 租户不使用请求头：未认证时使用 ip
+	ctx, _ = newTestingContext(pingOperation, "10.0.0.5", map[string]string{"X-Tenant-Id": "tenant-3"})
+	_, err = handler(ctx, nil)
+	require.NoError(t, err)
+	ctx, _ = newTestingContext(pingOperation, "10.0.0.5", map[string]string{"X-Tenant-Id": "tenant-4"})
+	_, err = handler(ctx, nil)
+	require.Error(t, err)
+	// 未设置租户的解析：按租户限流的配置无效
+	_, err = newRateLimiter(ratelimitutil.NewLimiter(nil), &configs.Setting_RateLimit{
+		Enable:      true,
+		DefaultRule: &configs.Setting_RateLimit_Rule{Limit: 1, KeyBy: RateLimitKeyByTenant},
+	}, nil)
+	require.Error(t, err)
+
+	// 关闭
+	require.NoError(t, r.update(&configs.Setting_RateLimit{}))
+	_, err = handler(ctx, nil)
+	require.NoError(t, err)
+}
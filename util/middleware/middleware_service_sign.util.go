@@ -0,0 +1,154 @@
+package middlewareutil
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
+	servicesignutil "github.com/my-saas-platform/api-proto/util/servicesign"
+	setuputil "github.com/my-saas-platform/api-proto/util/setup"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewServiceSignClientMiddleware 服务间请求签名：客户端；audience 为被调用方的服务名称 app.server_name，签名仅对该服务有效；
+// 配置 setting.encrypt_secret.service_encrypt.enable = true 时，NewClientMiddlewares 最内层添加；
+// grpc 客户端需使用 servicesignutil.Codec，发送的字节与签名的摘要一致
+func NewServiceSignClientMiddleware(engineHandler setuputil.Engine, audience string) (middleware.Middleware, error) {
+	if audience == "" {
+		return nil, pkgerrors.New("[请配置服务再启动] 服务间请求签名需指定被调用方的服务名称")
+	}
+	signer, err := engineHandler.GetServiceSigner()
+	if err != nil {
+		return nil, err
+	}
+	return serviceSignClient(signer, audience), nil
+}
+
+// NewServiceVerifyMiddleware 服务间请求签名：服务端；验证签名，拒绝有效时间内重复的请求；
+// 验证通过后，servicesignutil.CallerFromContext 获取调用方的服务名称；
+// 仅用于服务间调用的接口，使用 selector 匹配
+func NewServiceVerifyMiddleware(engineHandler setuputil.Engine) (middleware.Middleware, error) {
+	verifier, err := engineHandler.GetServiceVerifier()
+	if err != nil {
+		return nil, err
+	}
+	return serviceVerify(verifier), nil
+}
+
+// serviceSignClient ...
+func serviceSignClient(signer *servicesignutil.Signer, audience string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromClientContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			signReq, err := serviceSignClientRequest(tr, req)
+			if err != nil {
+				return nil, err
+			}
+			signReq.Audience = audience
+			if err = signer.Sign(tr.RequestHeader(), signReq); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// serviceVerify ...
+func serviceVerify(verifier *servicesignutil.Verifier) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			signReq, err := serviceSignServerRequest(ctx, tr)
+			if err != nil {
+				return nil, err
+			}
+			service, err := verifier.Verify(ctx, tr.RequestHeader(), signReq)
+			if err != nil {
+				message := "服务间请求签名验证失败"
+				switch {
+				case pkgerrors.Is(err, servicesignutil.ErrMissingSignature):
+					message = "服务间请求未签名"
+				case pkgerrors.Is(err, servicesignutil.ErrUntrustedService):
+					message = "服务间请求的调用方不受信任"
+				case pkgerrors.Is(err, servicesignutil.ErrExpired):
+					message = "服务间请求的时间戳已过期"
+				case pkgerrors.Is(err, servicesignutil.ErrReplayed):
+					message = "服务间请求重复"
+				case !pkgerrors.Is(err, servicesignutil.ErrInvalidSignature):
+					// 随机数存储错误
+					return nil, err
+				}
+				e := errorpkg.Unauthorized(errorpkg.ERROR_UNAUTHORIZED.String(), message)
+				return nil, errorpkg.WithStack(e)
+			}
+			return handler(servicesignutil.NewCallerContext(ctx, service), req)
+		}
+	}
+}
+
+// serviceSignClientRequest 客户端发送的请求；http：方法、路径、查询参数与请求体；grpc：operation 与确定性的 protobuf 编码
+func serviceSignClientRequest(tr transport.Transporter, req interface{}) (*servicesignutil.Request, error) {
+	if ht, ok := tr.(khttp.Transporter); ok && ht.Request() != nil {
+		r := ht.Request()
+		signReq := &servicesignutil.Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery}
+		if r.GetBody != nil {
+			body, err := r.GetBody()
+			if err != nil {
+				return nil, pkgerrors.WithStack(err)
+			}
+			defer func() { _ = body.Close() }()
+			if signReq.Body, err = io.ReadAll(body); err != nil {
+				return nil, pkgerrors.WithStack(err)
+			}
+		}
+		return signReq, nil
+	}
+	signReq := &servicesignutil.Request{Path: tr.Operation()}
+	if req != nil {
+		message, ok := req.(proto.Message)
+		if !ok {
+			return nil, pkgerrors.Errorf("service sign : request is not proto.Message : %T", req)
+		}
+		body, err := servicesignutil.MarshalProto(message)
+		if err != nil {
+			return nil, err
+		}
+		signReq.Body = body
+	}
+	return signReq, nil
+}
+
+// serviceSignServerRequest 服务端接收的请求；http：读取请求体后重置；grpc：servicesignutil.GRPCServerOption 记录的请求消息
+func serviceSignServerRequest(ctx context.Context, tr transport.Transporter) (*servicesignutil.Request, error) {
+	if ht, ok := tr.(khttp.Transporter); ok && ht.Request() != nil {
+		r := ht.Request()
+		signReq := &servicesignutil.Request{Method: r.Method, Path: r.URL.Path, Query: r.URL.RawQuery}
+		if r.Body != nil && r.Body != http.NoBody {
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				return nil, pkgerrors.WithStack(err)
+			}
+			_ = r.Body.Close()
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			signReq.Body = body
+		}
+		return signReq, nil
+	}
+	body, ok := servicesignutil.PayloadFromContext(ctx)
+	if !ok {
+		return nil, pkgerrors.WithMessage(servicesignutil.ErrBodyUnavailable, "grpc 服务需配置 servicesignutil.GRPCServerOption")
+	}
+	return &servicesignutil.Request{Path: tr.Operation(), Body: body}, nil
+}
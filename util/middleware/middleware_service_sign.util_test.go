@@ -0,0 +1,136 @@
+package middlewareutil
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	servicesignutil "github.com/my-saas-platform/api-proto/util/servicesign"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// go test -v ./util/middleware/ -count=1 -test.run=TestServiceSignMiddleware
+func TestServiceSignMiddleware(t *testing.T) {
+	const operation = "/saas.api.ping.servicev1.SrvPingV1/Ping"
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	signer, err := servicesignutil.NewSigner("user-service", string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})))
+	require.NoError(t, err)
+	verifier, err := servicesignutil.NewVerifier("ping-service", map[string]string{
+		"user-service": string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+	})
+	require.NoError(t, err)
+
+	// 客户端签名：请求头传递给服务端
+	clientTransport := &testingTransport{operation: operation, requestHeader: testingHeader(metadata.MD{})}
+	client := serviceSignClient(signer, "ping-service")(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	req := wrapperspb.String("ping")
+	_, err = client(transport.NewClientContext(context.Background(), clientTransport), req)
+	require.NoError(t, err)
+	require.Equal(t, "user-service", clientTransport.requestHeader.Get(servicesignutil.HeaderService))
+
+	var caller string
+	server := serviceVerify(verifier)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		caller, _ = servicesignutil.CallerFromContext(ctx)
+		return "ok", nil
+	})
+	// grpc 服务端：GRPCServerOption 记录的请求消息
+	statsHandler := servicesignutil.NewPayloadStatsHandler()
+	serverContext := func(header testingHeader, message proto.Message) context.Context {
+		ctx := statsHandler.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: operation})
+		data, err := servicesignutil.MarshalProto(message)
+		require.NoError(t, err)
+		statsHandler.HandleRPC(ctx, &stats.InPayload{Data: data})
+		return transport.NewServerContext(ctx, &testingTransport{
+			operation:     operation,
+			requestHeader: header,
+			replyHeader:   testingHeader(metadata.MD{}),
+		})
+	}
+	_, err = server(serverContext(clientTransport.requestHeader, req), req)
+	require.NoError(t, err)
+	require.Equal(t, "user-service", caller)
+
+	// 重复的请求、篡改的消息、未签名
+	_, err = server(serverContext(clientTransport.requestHeader, req), req)
+	require.Equal(t, int32(401), errors.FromError(err).Code)
+	_, err = client(transport.NewClientContext(context.Background(), clientTransport), req)
+	require.NoError(t, err)
+	_, err = server(serverContext(clientTransport.requestHeader, wrapperspb.String("tampered")), req)
+	require.Equal(t, int32(401), errors.FromError(err).Code)
+	_, err = server(serverContext(testingHeader(metadata.MD{}), req), req)
+	require.Equal(t, int32(401), errors.FromError(err).Code)
+
+	// 未记录请求消息：未配置 GRPCServerOption
+	_, err = client(transport.NewClientContext(context.Background(), clientTransport), req)
+	require.NoError(t, err)
+	_, err = server(transport.NewServerContext(context.Background(), &testingTransport{
+		operation:     operation,
+		requestHeader: clientTransport.requestHeader,
+		replyHeader:   testingHeader(metadata.MD{}),
+	}), req)
+	require.ErrorIs(t, err, servicesignutil.ErrBodyUnavailable)
+
+	// http：签名发送的请求体；服务端读取后重置请求体
+	const body = `{"value":"ping"}`
+	clientRequest, err := http.NewRequest(http.MethodPost, "http://127.0.0.1:8081/api/v1/ping?a=1", bytes.NewReader([]byte(body)))
+	require.NoError(t, err)
+	httpClientTransport := &testingHTTPTransport{
+		testingTransport: testingTransport{operation: operation, requestHeader: testingHeader(metadata.MD{})},
+		request:          clientRequest,
+	}
+	_, err = client(transport.NewClientContext(context.Background(), httpClientTransport), req)
+	require.NoError(t, err)
+	httpServerContext := func(target, body string) (context.Context, *http.Request) {
+		r := httptest.NewRequest(http.MethodPost, target, bytes.NewReader([]byte(body)))
+		return transport.NewServerContext(context.Background(), &testingHTTPTransport{
+			testingTransport: testingTransport{
+				operation:     operation,
+				requestHeader: httpClientTransport.requestHeader,
+				replyHeader:   testingHeader(metadata.MD{}),
+			},
+			request: r,
+		}), r
+	}
+	ctx, r := httpServerContext("/api/v1/ping?a=1", body)
+	_, err = server(ctx, req)
+	require.NoError(t, err)
+	remaining, err := io.ReadAll(r.Body)
+	require.NoError(t, err)
+	require.Equal(t, body, string(remaining))
+
+	_, err = client(transport.NewClientContext(context.Background(), httpClientTransport), req)
+	require.NoError(t, err)
+	ctx, _ = httpServerContext("/api/v1/ping?a=2", body)
+	_, err = server(ctx, req)
+	require.Equal(t, int32(401), errors.FromError(err).Code)
+}
+
+// testingHTTPTransport khttp.Transporter
+type testingHTTPTransport struct {
+	testingTransport
+	request *http.Request
+}
+
+func (t *testingHTTPTransport) Kind() transport.Kind   { return transport.KindHTTP }
+func (t *testingHTTPTransport) Request() *http.Request { return t.request }
+func (t *testingHTTPTransport) PathTemplate() string   { return t.request.URL.Path }
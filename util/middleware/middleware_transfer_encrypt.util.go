@@ -0,0 +1,43 @@
+package middlewareutil
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
+	setuputil "github.com/my-saas-platform/api-proto/util/setup"
+	transferencryptutil "github.com/my-saas-platform/api-proto/util/transferencrypt"
+	"google.golang.org/protobuf/proto"
+)
+
+// NewTransferDecryptMiddleware 传输解密中间件；解密请求中 (saas.api.options.optionv1.transfer_encrypt) = true 的字段；
+// 解密后的请求为副本，日志中间件记录的请求参数仍为密文；添加在日志中间件之后
+func NewTransferDecryptMiddleware(engineHandler setuputil.Engine) (middleware.Middleware, error) {
+	cipher, err := engineHandler.GetTransferCipher()
+	if err != nil {
+		return nil, err
+	}
+	return transferDecrypt(cipher), nil
+}
+
+// transferDecrypt ...
+func transferDecrypt(cipher *transferencryptutil.Cipher) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			msg, ok := req.(proto.Message)
+			if !ok {
+				return handler(ctx, req)
+			}
+			decrypted, err := cipher.DecryptMessage(msg)
+			if err != nil {
+				message := "传输加密的字段解密失败"
+				if fieldErr, ok := transferencryptutil.AsFieldError(err); ok {
+					message += "：" + fieldErr.Field
+				}
+				e := errorpkg.BadRequest(errorpkg.ERROR_BAD_REQUEST.String(), message)
+				return nil, errorpkg.WithStack(e)
+			}
+			return handler(ctx, decrypted)
+		}
+	}
+}
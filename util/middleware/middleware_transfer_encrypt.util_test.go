@@ -0,0 +1,85 @@
+package middlewareutil
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	optionv1 "github.com/my-saas-platform/api-proto/api/options/v1"
+	transferencryptutil "github.com/my-saas-platform/api-proto/util/transferencrypt"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// newTestingLoginReq message LoginReq { string password = 1 [(transfer_encrypt) = true]; }
+func newTestingLoginReq(t *testing.T, password string) *dynamicpb.Message {
+	opts := &descriptorpb.FieldOptions{}
+	proto.SetExtension(opts, optionv1.E_TransferEncrypt, true)
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("testing/middleware_transfer_encrypt.proto"),
+		Package: proto.String("testing"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("LoginReq"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:     proto.String("password"),
+				JsonName: proto.String("password"),
+				Number:   proto.Int32(1),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+				Options:  opts,
+			}},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	req := dynamicpb.NewMessage(file.Messages().Get(0))
+	req.Set(req.Descriptor().Fields().Get(0), protoreflect.ValueOfString(password))
+	return req
+}
+
+// go test -v ./util/middleware/ -count=1 -test.run=TestTransferDecryptMiddleware
+func TestTransferDecryptMiddleware(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	cipher, err := transferencryptutil.NewCipher(
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+	)
+	require.NoError(t, err)
+
+	var received string
+	handler := transferDecrypt(cipher)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		if msg, ok := req.(proto.Message); ok {
+			received = msg.ProtoReflect().Get(msg.ProtoReflect().Descriptor().Fields().Get(0)).String()
+		}
+		return "ok", nil
+	})
+
+	ciphertext, err := cipher.Encrypt(transferencryptutil.ModeOAEP, []byte("password"))
+	require.NoError(t, err)
+	req := newTestingLoginReq(t, ciphertext)
+	_, err = handler(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, "password", received)
+	// 日志中间件持有的请求仍为密文
+	require.Equal(t, ciphertext, req.Get(req.Descriptor().Fields().Get(0)).String())
+
+	// 解密失败
+	_, err = handler(context.Background(), newTestingLoginReq(t, "password"))
+	require.Error(t, err)
+	require.Equal(t, int32(400), errors.FromError(err).Code)
+
+	// 非 proto 消息
+	_, err = handler(context.Background(), "testing")
+	require.NoError(t, err)
+}
@@ -0,0 +1,149 @@
+package migrationutil
+
+import (
+	"bufio"
+	"context"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+var (
+	// _sqlFileRegexp 迁移文件名称；例：20240101120000_create_user.up.sql
+	_sqlFileRegexp = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+)
+
+// MigrateFunc 迁移函数；tx 为事务
+type MigrateFunc func(ctx context.Context, tx *gorm.DB) error
+
+// Migration 版本迁移；迁移与迁移记录在同一事务中执行
+// 注意：mysql 的 DDL 语句会隐式提交事务，失败时需手动处理已执行的语句
+type Migration struct {
+	// Version 版本；递增，推荐使用时间：20240101120000
+	Version int64
+	// Name 名称
+	Name string
+	// Up 运行迁移
+	Up MigrateFunc
+	// Down 回滚迁移
+	Down MigrateFunc
+}
+
+// NewMigration 迁移：go函数
+func NewMigration(version int64, name string, up, down MigrateFunc) *Migration {
+	return &Migration{
+		Version: version,
+		Name:    name,
+		Up:      up,
+		Down:    down,
+	}
+}
+
+// NewSQLMigration 迁移：sql语句；多条语句以行尾的分号分隔；downSQL 为空时不支持回滚
+func NewSQLMigration(version int64, name, upSQL, downSQL string) *Migration {
+	m := NewMigration(version, name, execSQL(upSQL), nil)
+	if strings.TrimSpace(downSQL) != "" {
+		m.Down = execSQL(downSQL)
+	}
+	return m
+}
+
+// LoadSQLMigrations 加载sql迁移文件；可使用 embed.FS
+// 文件名称：{version}_{name}.up.sql、{version}_{name}.down.sql
+// 例：20240101120000_create_user.up.sql
+func LoadSQLMigrations(fsys fs.FS, dir string) ([]*Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	var (
+		migrationMap = make(map[int64]*Migration)
+		sqlMap       = make(map[int64][2]string)
+	)
+	for i := range entries {
+		if entries[i].IsDir() {
+			continue
+		}
+		matches := _sqlFileRegexp.FindStringSubmatch(entries[i].Name())
+		if len(matches) != 4 {
+			continue
+		}
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		if m, ok := migrationMap[version]; ok && m.Name != matches[2] {
+			return nil, pkgerrors.Errorf("migration : 重复的版本 %d : %s、%s", version, m.Name, matches[2])
+		}
+		migrationMap[version] = &Migration{Version: version, Name: matches[2]}
+
+		content, err := fs.ReadFile(fsys, path.Join(dir, entries[i].Name()))
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		sqlPair := sqlMap[version]
+		if matches[3] == "up" {
+			sqlPair[0] = string(content)
+		} else {
+			sqlPair[1] = string(content)
+		}
+		sqlMap[version] = sqlPair
+	}
+
+	migrations := make([]*Migration, 0, len(migrationMap))
+	for version, m := range migrationMap {
+		sqlPair := sqlMap[version]
+		migrations = append(migrations, NewSQLMigration(version, m.Name, sqlPair[0], sqlPair[1]))
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// execSQL 执行sql语句
+func execSQL(sqlContent string) MigrateFunc {
+	statements := splitSQLStatements(sqlContent)
+	return func(ctx context.Context, tx *gorm.DB) error {
+		for i := range statements {
+			if err := tx.WithContext(ctx).Exec(statements[i]).Error; err != nil {
+				return pkgerrors.WithMessagef(err, "migration : sql statement[%d]", i)
+			}
+		}
+		return nil
+	}
+}
+
+// splitSQLStatements 拆分sql语句；以行尾的分号分隔，忽略 -- 注释行
+func splitSQLStatements(sqlContent string) []string {
+	var (
+		statements []string
+		builder    strings.Builder
+	)
+	scanner := bufio.NewScanner(strings.NewReader(sqlContent))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "--") {
+			continue
+		}
+		builder.WriteString(line)
+		builder.WriteString("\n")
+		if strings.HasSuffix(trimmed, ";") {
+			statements = append(statements, strings.TrimSpace(builder.String()))
+			builder.Reset()
+		}
+	}
+	if s := strings.TrimSpace(builder.String()); s != "" {
+		statements = append(statements, s)
+	}
+	return statements
+}
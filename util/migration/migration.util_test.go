@@ -0,0 +1,50 @@
+package migrationutil
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/migration/ -count=1 -test.run=TestSplitSQLStatements
+func TestSplitSQLStatements(t *testing.T) {
+	sqlContent := `
+-- 用户表
+CREATE TABLE users (
+    id INTEGER PRIMARY KEY,
+    name VARCHAR(64) NOT NULL
+);
+
+INSERT INTO users (id, name) VALUES (1, 'a;b');
+INSERT INTO users (id, name) VALUES (2, 'c')
+`
+	statements := splitSQLStatements(sqlContent)
+	require.Len(t, statements, 3)
+	require.Contains(t, statements[0], "CREATE TABLE users")
+	require.Equal(t, "INSERT INTO users (id, name) VALUES (1, 'a;b');", statements[1])
+	require.Equal(t, "INSERT INTO users (id, name) VALUES (2, 'c')", statements[2])
+}
+
+// go test -v ./util/migration/ -count=1 -test.run=TestLoadSQLMigrations
+func TestLoadSQLMigrations(t *testing.T) {
+	fsys := fstest.MapFS{
+		"migrations/20240102000000_add_email.up.sql":      {Data: []byte("ALTER TABLE users ADD COLUMN email VARCHAR(64);")},
+		"migrations/20240101000000_create_users.up.sql":   {Data: []byte("CREATE TABLE users (id INTEGER PRIMARY KEY);")},
+		"migrations/20240101000000_create_users.down.sql": {Data: []byte("DROP TABLE users;")},
+		"migrations/README.md":                            {Data: []byte("# migrations")},
+	}
+	migrations, err := LoadSQLMigrations(fsys, "migrations")
+	require.NoError(t, err)
+	require.Len(t, migrations, 2)
+	require.Equal(t, int64(20240101000000), migrations[0].Version)
+	require.Equal(t, "create_users", migrations[0].Name)
+	require.NotNil(t, migrations[0].Down)
+	require.Equal(t, "add_email", migrations[1].Name)
+	require.Nil(t, migrations[1].Down)
+
+	// 重复的版本
+	fsys["migrations/20240101000000_create_accounts.up.sql"] = &fstest.MapFile{Data: []byte("SELECT 1;")}
+	_, err = LoadSQLMigrations(fsys, "migrations")
+	require.Error(t, err)
+}
@@ -0,0 +1,86 @@
+package migrationutil
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// CommandStatus 迁移状态
+	CommandStatus = "status"
+	// CommandUp 执行全部未执行的迁移
+	CommandUp = "up"
+	// CommandDown 回滚最后一个已执行的迁移
+	CommandDown = "down"
+	// CommandRedo 回滚并重新执行最后一个已执行的迁移
+	CommandRedo = "redo"
+)
+
+// RunCommand 执行迁移命令；args[0] 为命令：status、up、down、redo
+func RunCommand(ctx context.Context, m *Migrator, args []string, w io.Writer) error {
+	if len(args) == 0 {
+		return pkgerrors.New("migration : 请指定命令：status、up、down、redo")
+	}
+
+	switch args[0] {
+	case CommandStatus:
+		statuses, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(tw, "VERSION\tNAME\tSTATUS\tAPPLIED_AT")
+		for i := range statuses {
+			status, appliedAt := "pending", "-"
+			if statuses[i].Applied {
+				status, appliedAt = "applied", statuses[i].AppliedAt.Format(time.RFC3339)
+			}
+			if statuses[i].Missing {
+				status = "missing"
+			}
+			_, _ = fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", statuses[i].Version, statuses[i].Name, status, appliedAt)
+		}
+		return tw.Flush()
+	case CommandUp:
+		applied, err := m.Up(ctx)
+		for i := range applied {
+			_, _ = fmt.Fprintf(w, "up : %d_%s\n", applied[i].Version, applied[i].Name)
+		}
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			_, _ = fmt.Fprintln(w, "up : 无未执行的迁移")
+		}
+		return nil
+	case CommandDown:
+		reverted, err := m.Down(ctx)
+		if err != nil {
+			return err
+		}
+		if reverted == nil {
+			_, _ = fmt.Fprintln(w, "down : 无已执行的迁移")
+			return nil
+		}
+		_, _ = fmt.Fprintf(w, "down : %d_%s\n", reverted.Version, reverted.Name)
+		return nil
+	case CommandRedo:
+		redone, err := m.Redo(ctx)
+		if err != nil {
+			return err
+		}
+		if redone == nil {
+			_, _ = fmt.Fprintln(w, "redo : 无已执行的迁移")
+			return nil
+		}
+		_, _ = fmt.Fprintf(w, "redo : %d_%s\n", redone.Version, redone.Name)
+		return nil
+	default:
+		return pkgerrors.New("migration : 不支持的命令 : " + args[0])
+	}
+}
@@ -0,0 +1,104 @@
+package migrationutil
+
+import (
+	"context"
+	"database/sql"
+	"hash/fnv"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+const (
+	// lockRetryInterval postgres 重试获取锁的间隔
+	lockRetryInterval = 500 * time.Millisecond
+	// lockReleaseTimeout 释放锁的超时时间
+	lockReleaseTimeout = 5 * time.Second
+)
+
+// acquireLock 获取数据库咨询锁；锁绑定在独占的连接上，释放锁后归还连接
+// mysql：GET_LOCK；postgres：pg_try_advisory_lock；其他数据库(如：sqlite)不加锁
+func acquireLock(ctx context.Context, db *gorm.DB, lockName string, timeout time.Duration) (unlock func(), err error) {
+	dialect := db.Dialector.Name()
+	if dialect != "mysql" && dialect != "postgres" {
+		return func() {}, nil
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	switch dialect {
+	case "mysql":
+		unlock, err = acquireMysqlLock(ctx, conn, lockName, timeout)
+	default:
+		unlock, err = acquirePostgresLock(ctx, conn, lockName, timeout)
+	}
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return func() {
+		unlock()
+		_ = conn.Close()
+	}, nil
+}
+
+// acquireMysqlLock GET_LOCK
+func acquireMysqlLock(ctx context.Context, conn *sql.Conn, lockName string, timeout time.Duration) (func(), error) {
+	var locked sql.NullInt64
+	err := conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", lockName, int64(timeout.Seconds())).Scan(&locked)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	if !locked.Valid || locked.Int64 != 1 {
+		return nil, pkgerrors.Errorf("migration : 获取迁移锁超时 : %s", lockName)
+	}
+	return func() {
+		releaseCtx, cancel := context.WithTimeout(context.Background(), lockReleaseTimeout)
+		defer cancel()
+		_, _ = conn.ExecContext(releaseCtx, "SELECT RELEASE_LOCK(?)", lockName)
+	}, nil
+}
+
+// acquirePostgresLock pg_try_advisory_lock；重试直到超时
+func acquirePostgresLock(ctx context.Context, conn *sql.Conn, lockName string, timeout time.Duration) (func(), error) {
+	lockKey := postgresLockKey(lockName)
+	deadline := time.Now().Add(timeout)
+	for {
+		var locked bool
+		err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", lockKey).Scan(&locked)
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		if locked {
+			break
+		}
+		if time.Now().After(deadline) {
+			return nil, pkgerrors.Errorf("migration : 获取迁移锁超时 : %s", lockName)
+		}
+		select {
+		case <-ctx.Done():
+			return nil, pkgerrors.WithStack(ctx.Err())
+		case <-time.After(lockRetryInterval):
+		}
+	}
+	return func() {
+		releaseCtx, cancel := context.WithTimeout(context.Background(), lockReleaseTimeout)
+		defer cancel()
+		_, _ = conn.ExecContext(releaseCtx, "SELECT pg_advisory_unlock($1)", lockKey)
+	}, nil
+}
+
+// postgresLockKey 锁名称转换为 bigint
+func postgresLockKey(lockName string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(lockName))
+	return int64(h.Sum64())
+}
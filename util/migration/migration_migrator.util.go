@@ -0,0 +1,298 @@
+package migrationutil
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const (
+	// DefaultTableName 迁移记录表
+	DefaultTableName = "srv_schema_migrations"
+	// DefaultLockName 迁移锁名称
+	DefaultLockName = "srv_schema_migrations"
+	// DefaultLockTimeout 获取迁移锁的超时时间
+	DefaultLockTimeout = time.Minute
+)
+
+// SchemaMigration 迁移记录
+type SchemaMigration struct {
+	Version   int64     `gorm:"column:version;primaryKey;autoIncrement:false"`
+	Name      string    `gorm:"column:name;type:varchar(255);not null"`
+	AppliedAt time.Time `gorm:"column:applied_at;not null"`
+}
+
+// MigrationStatus 迁移状态
+type MigrationStatus struct {
+	Version int64
+	Name    string
+	// Applied 已执行
+	Applied   bool
+	AppliedAt time.Time
+	// Missing 已执行但未注册；无法回滚
+	Missing bool
+}
+
+// options 迁移可选项
+type options struct {
+	tableName   string
+	lockName    string
+	lockTimeout time.Duration
+}
+
+// Option 迁移可选项
+type Option func(*options)
+
+// WithTableName 迁移记录表
+func WithTableName(tableName string) Option {
+	return func(o *options) {
+		o.tableName = tableName
+	}
+}
+
+// WithLockName 迁移锁名称；同一数据库的服务使用不同的锁名称
+func WithLockName(lockName string) Option {
+	return func(o *options) {
+		o.lockName = lockName
+	}
+}
+
+// WithLockTimeout 获取迁移锁的超时时间
+func WithLockTimeout(lockTimeout time.Duration) Option {
+	return func(o *options) {
+		o.lockTimeout = lockTimeout
+	}
+}
+
+// Migrator 数据库迁移；
+// 执行前获取数据库咨询锁(mysql：GET_LOCK；postgres：pg_advisory_lock)，多个副本同时启动时仅一个执行迁移
+type Migrator struct {
+	db         *gorm.DB
+	migrations []*Migration
+	opts       *options
+}
+
+// NewMigrator 数据库迁移
+func NewMigrator(db *gorm.DB, migrations []*Migration, opts ...Option) (*Migrator, error) {
+	migratorOpts := &options{
+		tableName:   DefaultTableName,
+		lockName:    DefaultLockName,
+		lockTimeout: DefaultLockTimeout,
+	}
+	for i := range opts {
+		opts[i](migratorOpts)
+	}
+
+	sorted := make([]*Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Version < sorted[j].Version
+	})
+	for i := range sorted {
+		if sorted[i].Version <= 0 {
+			return nil, pkgerrors.Errorf("migration : 无效的版本 %d : %s", sorted[i].Version, sorted[i].Name)
+		}
+		if sorted[i].Up == nil {
+			return nil, pkgerrors.Errorf("migration : 未设置 Up : %d_%s", sorted[i].Version, sorted[i].Name)
+		}
+		if i > 0 && sorted[i].Version == sorted[i-1].Version {
+			return nil, pkgerrors.Errorf("migration : 重复的版本 %d : %s、%s", sorted[i].Version, sorted[i-1].Name, sorted[i].Name)
+		}
+	}
+
+	return &Migrator{
+		// 迁移与记录均在主库执行
+		db:         db.Clauses(dbresolver.Write).Session(&gorm.Session{}),
+		migrations: sorted,
+		opts:       migratorOpts,
+	}, nil
+}
+
+// Migrations 已注册的迁移
+func (s *Migrator) Migrations() []*Migration {
+	return s.migrations
+}
+
+// Status 迁移状态
+func (s *Migrator) Status(ctx context.Context) ([]*MigrationStatus, error) {
+	if err := s.initializeSchema(ctx); err != nil {
+		return nil, err
+	}
+	records, err := s.appliedRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*MigrationStatus, 0, len(s.migrations))
+	for i := range s.migrations {
+		status := &MigrationStatus{
+			Version: s.migrations[i].Version,
+			Name:    s.migrations[i].Name,
+		}
+		if record, ok := records[status.Version]; ok {
+			status.Applied = true
+			status.AppliedAt = record.AppliedAt
+			delete(records, status.Version)
+		}
+		res = append(res, status)
+	}
+	for _, record := range records {
+		res = append(res, &MigrationStatus{
+			Version:   record.Version,
+			Name:      record.Name,
+			Applied:   true,
+			AppliedAt: record.AppliedAt,
+			Missing:   true,
+		})
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Version < res[j].Version
+	})
+	return res, nil
+}
+
+// Up 执行全部未执行的迁移；返回本次执行的迁移
+func (s *Migrator) Up(ctx context.Context) (applied []*Migration, err error) {
+	err = s.withLock(ctx, func() error {
+		records, err := s.appliedRecords(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range s.migrations {
+			if _, ok := records[s.migrations[i].Version]; ok {
+				continue
+			}
+			if err = s.up(ctx, s.migrations[i]); err != nil {
+				return err
+			}
+			applied = append(applied, s.migrations[i])
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down 回滚最后一个已执行的迁移；无已执行的迁移时返回 nil
+func (s *Migrator) Down(ctx context.Context) (reverted *Migration, err error) {
+	err = s.withLock(ctx, func() error {
+		reverted, err = s.lastApplied(ctx)
+		if err != nil || reverted == nil {
+			return err
+		}
+		return s.down(ctx, reverted)
+	})
+	return reverted, err
+}
+
+// Redo 回滚并重新执行最后一个已执行的迁移；无已执行的迁移时返回 nil
+func (s *Migrator) Redo(ctx context.Context) (redone *Migration, err error) {
+	err = s.withLock(ctx, func() error {
+		redone, err = s.lastApplied(ctx)
+		if err != nil || redone == nil {
+			return err
+		}
+		if err = s.down(ctx, redone); err != nil {
+			return err
+		}
+		return s.up(ctx, redone)
+	})
+	return redone, err
+}
+
+// withLock 获取迁移锁后执行
+func (s *Migrator) withLock(ctx context.Context, fn func() error) error {
+	unlock, err := acquireLock(ctx, s.db, s.opts.lockName, s.opts.lockTimeout)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err = s.initializeSchema(ctx); err != nil {
+		return err
+	}
+	return fn()
+}
+
+// initializeSchema 创建迁移记录表
+func (s *Migrator) initializeSchema(ctx context.Context) error {
+	migrator := s.db.WithContext(ctx).Table(s.opts.tableName).Migrator()
+	if migrator.HasTable(s.opts.tableName) {
+		return nil
+	}
+	if err := migrator.CreateTable(&SchemaMigration{}); err != nil {
+		return pkgerrors.WithMessage(err, "migration : 创建迁移记录表失败")
+	}
+	return nil
+}
+
+// appliedRecords 已执行的迁移
+func (s *Migrator) appliedRecords(ctx context.Context) (map[int64]*SchemaMigration, error) {
+	var records []*SchemaMigration
+	err := s.db.WithContext(ctx).Table(s.opts.tableName).Order("version").Find(&records).Error
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	res := make(map[int64]*SchemaMigration, len(records))
+	for i := range records {
+		res[records[i].Version] = records[i]
+	}
+	return res, nil
+}
+
+// lastApplied 最后一个已执行的迁移
+func (s *Migrator) lastApplied(ctx context.Context) (*Migration, error) {
+	var records []*SchemaMigration
+	err := s.db.WithContext(ctx).Table(s.opts.tableName).Order("version DESC").Limit(1).Find(&records).Error
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	for i := range s.migrations {
+		if s.migrations[i].Version == records[0].Version {
+			return s.migrations[i], nil
+		}
+	}
+	return nil, pkgerrors.Errorf("migration : 已执行但未注册的迁移 %d_%s", records[0].Version, records[0].Name)
+}
+
+// up 执行迁移并记录；同一事务
+func (s *Migrator) up(ctx context.Context, m *Migration) error {
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := m.Up(ctx, tx); err != nil {
+			return err
+		}
+		record := &SchemaMigration{
+			Version:   m.Version,
+			Name:      m.Name,
+			AppliedAt: time.Now(),
+		}
+		return tx.Table(s.opts.tableName).Create(record).Error
+	})
+	if err != nil {
+		return pkgerrors.WithMessagef(err, "migration : up %d_%s", m.Version, m.Name)
+	}
+	return nil
+}
+
+// down 回滚迁移并删除记录；同一事务
+func (s *Migrator) down(ctx context.Context, m *Migration) error {
+	if m.Down == nil {
+		return pkgerrors.Errorf("migration : 不支持回滚 %d_%s", m.Version, m.Name)
+	}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := m.Down(ctx, tx); err != nil {
+			return err
+		}
+		return tx.Table(s.opts.tableName).Where("version = ?", m.Version).Delete(&SchemaMigration{}).Error
+	})
+	if err != nil {
+		return pkgerrors.WithMessagef(err, "migration : down %d_%s", m.Version, m.Name)
+	}
+	return nil
+}
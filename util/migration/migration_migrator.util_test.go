@@ -0,0 +1,136 @@
+package migrationutil
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+func newTestingDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// 内存数据库：使用同一个连接
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	return db
+}
+
+func testingMigrations() []*Migration {
+	return []*Migration{
+		NewSQLMigration(2, "add_email", "ALTER TABLE users ADD COLUMN email VARCHAR(64);", "ALTER TABLE users DROP COLUMN email;"),
+		NewMigration(1, "create_users",
+			func(ctx context.Context, tx *gorm.DB) error {
+				return tx.Exec("CREATE TABLE users (id INTEGER PRIMARY KEY, name VARCHAR(64))").Error
+			},
+			func(ctx context.Context, tx *gorm.DB) error {
+				return tx.Exec("DROP TABLE users").Error
+			},
+		),
+	}
+}
+
+// go test -v ./util/migration/ -count=1 -test.run=TestMigrator
+func TestMigrator(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	m, err := NewMigrator(db, testingMigrations())
+	require.NoError(t, err)
+
+	// up
+	applied, err := m.Up(ctx)
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+	require.Equal(t, int64(1), applied[0].Version)
+	require.True(t, db.Migrator().HasColumn("users", "email"))
+
+	applied, err = m.Up(ctx)
+	require.NoError(t, err)
+	require.Empty(t, applied)
+
+	// status
+	statuses, err := m.Status(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.True(t, statuses[0].Applied)
+	require.True(t, statuses[1].Applied)
+
+	// down
+	reverted, err := m.Down(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), reverted.Version)
+	require.False(t, db.Migrator().HasColumn("users", "email"))
+
+	// redo
+	redone, err := m.Redo(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), redone.Version)
+	require.True(t, db.Migrator().HasTable("users"))
+
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[0].Applied)
+	require.False(t, statuses[1].Applied)
+
+	// 失败的迁移不记录
+	m, err = NewMigrator(db, append(testingMigrations(), NewSQLMigration(3, "broken", "SELECT * FROM not_exists;", "")))
+	require.NoError(t, err)
+	_, err = m.Up(ctx)
+	require.Error(t, err)
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[1].Applied)
+	require.False(t, statuses[2].Applied)
+
+	// 已执行但未注册
+	m, err = NewMigrator(db, testingMigrations()[1:])
+	require.NoError(t, err)
+	statuses, err = m.Status(ctx)
+	require.NoError(t, err)
+	require.True(t, statuses[1].Missing)
+	_, err = m.Down(ctx)
+	require.Error(t, err)
+}
+
+// go test -v ./util/migration/ -count=1 -test.run=TestNewMigrator_Invalid
+func TestNewMigrator_Invalid(t *testing.T) {
+	db := newTestingDB(t)
+	_, err := NewMigrator(db, append(testingMigrations(), NewSQLMigration(1, "duplicate", "SELECT 1;", "")))
+	require.Error(t, err)
+	_, err = NewMigrator(db, []*Migration{NewMigration(1, "no_up", nil, nil)})
+	require.Error(t, err)
+}
+
+// go test -v ./util/migration/ -count=1 -test.run=TestRunCommand
+func TestRunCommand(t *testing.T) {
+	ctx := context.Background()
+	m, err := NewMigrator(newTestingDB(t), testingMigrations())
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, RunCommand(ctx, m, []string{CommandStatus}, &buf))
+	require.Contains(t, buf.String(), "pending")
+
+	buf.Reset()
+	require.NoError(t, RunCommand(ctx, m, []string{CommandUp}, &buf))
+	require.Contains(t, buf.String(), "up : 2_add_email")
+
+	buf.Reset()
+	require.NoError(t, RunCommand(ctx, m, []string{CommandRedo}, &buf))
+	require.Contains(t, buf.String(), "redo : 2_add_email")
+
+	buf.Reset()
+	require.NoError(t, RunCommand(ctx, m, []string{CommandDown}, &buf))
+	require.Contains(t, buf.String(), "down : 2_add_email")
+
+	require.Error(t, RunCommand(ctx, m, nil, &buf))
+	require.Error(t, RunCommand(ctx, m, []string{"reset"}, &buf))
+}
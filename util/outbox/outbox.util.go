@@ -0,0 +1,321 @@
+// Package outboxutil 事务发件箱：事件与业务数据在同一事务中写入，后台转发到 rabbitmq
+package outboxutil
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	pkgerrors "github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const (
+	// DefaultTableName 事件表
+	DefaultTableName = "srv_outbox_events"
+	// DefaultLeaseTableName 转发租约表
+	DefaultLeaseTableName = "srv_outbox_leases"
+	// DefaultLeaseName 转发租约名称；同名的副本中仅一个转发
+	DefaultLeaseName = "outbox"
+	// DefaultLeaseTTL 转发租约时长
+	DefaultLeaseTTL = 30 * time.Second
+	// DefaultPollInterval 轮询间隔
+	DefaultPollInterval = time.Second
+	// DefaultBatchSize 每批转发的事件数量
+	DefaultBatchSize = 100
+	// DefaultPublishTimeout 发布超时时间；不超过租约时长的一半
+	DefaultPublishTimeout = 10 * time.Second
+
+	// releaseLeaseTimeout 停止时释放租约的超时时间
+	releaseLeaseTimeout = 5 * time.Second
+)
+
+var (
+	// ErrLeaseLost 转发租约已过期或被其他副本持有
+	ErrLeaseLost = pkgerrors.New("outbox : lease lost")
+)
+
+// Publisher 发布；*rabbitmqutil.Connection
+type Publisher interface {
+	Publish(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error
+}
+
+// Message 事件消息
+type Message struct {
+	Exchange   string
+	RoutingKey string
+	// MessageID 为空时生成uuid
+	MessageID   string
+	ContentType string
+	Headers     map[string]string
+	Body        []byte
+}
+
+// NewJSONMessage json 消息
+func NewJSONMessage(exchange, routingKey string, v interface{}) (*Message, error) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return &Message{Exchange: exchange, RoutingKey: routingKey, ContentType: "application/json", Body: body}, nil
+}
+
+// NewProtoMessage protobuf 消息
+func NewProtoMessage(exchange, routingKey string, m proto.Message) (*Message, error) {
+	body, err := proto.Marshal(m)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return &Message{Exchange: exchange, RoutingKey: routingKey, ContentType: "application/x-protobuf", Body: body}, nil
+}
+
+// Event 事件表
+type Event struct {
+	ID          uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	Exchange    string `gorm:"column:exchange;type:varchar(255);not null;default:''"`
+	RoutingKey  string `gorm:"column:routing_key;type:varchar(255);not null;default:''"`
+	MessageID   string `gorm:"column:message_id;type:varchar(255);not null;default:''"`
+	ContentType string `gorm:"column:content_type;type:varchar(255);not null;default:''"`
+	Headers     string `gorm:"column:headers;type:text"`
+	Body        []byte `gorm:"column:body"`
+	// Parked 无法发布(如：消息头无效)，转发时跳过
+	Parked    bool      `gorm:"column:parked;not null;default:false"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+// Lease 转发租约表；version 每次获取或续期时递增
+type Lease struct {
+	Name      string    `gorm:"column:name;primaryKey;type:varchar(255)"`
+	Owner     string    `gorm:"column:owner;type:varchar(255);not null;default:''"`
+	Version   uint64    `gorm:"column:version;not null;default:0"`
+	ExpiredAt time.Time `gorm:"column:expired_at"`
+}
+
+// options 可选项
+type options struct {
+	tableName      string
+	leaseTableName string
+	leaseName      string
+	leaseTTL       time.Duration
+	pollInterval   time.Duration
+	batchSize      int
+	publishTimeout time.Duration
+	owner          string
+	propagator     propagation.TextMapPropagator
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithTableName 事件表
+func WithTableName(tableName string) Option {
+	return func(o *options) {
+		o.tableName = tableName
+	}
+}
+
+// WithLeaseTableName 转发租约表
+func WithLeaseTableName(leaseTableName string) Option {
+	return func(o *options) {
+		o.leaseTableName = leaseTableName
+	}
+}
+
+// WithLeaseName 转发租约名称
+func WithLeaseName(leaseName string) Option {
+	return func(o *options) {
+		o.leaseName = leaseName
+	}
+}
+
+// WithLeaseTTL 转发租约时长；副本崩溃后，其他副本在租约过期后接管
+func WithLeaseTTL(leaseTTL time.Duration) Option {
+	return func(o *options) {
+		o.leaseTTL = leaseTTL
+	}
+}
+
+// WithPollInterval 轮询间隔
+func WithPollInterval(pollInterval time.Duration) Option {
+	return func(o *options) {
+		o.pollInterval = pollInterval
+	}
+}
+
+// WithBatchSize 每批转发的事件数量
+func WithBatchSize(batchSize int) Option {
+	return func(o *options) {
+		o.batchSize = batchSize
+	}
+}
+
+// WithPublishTimeout 发布超时时间
+func WithPublishTimeout(publishTimeout time.Duration) Option {
+	return func(o *options) {
+		o.publishTimeout = publishTimeout
+	}
+}
+
+// WithOwner 转发租约的持有者；默认 hostname:pid:uuid
+func WithOwner(owner string) Option {
+	return func(o *options) {
+		o.owner = owner
+	}
+}
+
+// WithPropagator 追踪上下文传递；默认 propagation.TraceContext 与 propagation.Baggage
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+// Outbox 事务发件箱
+type Outbox struct {
+	db        *gorm.DB
+	publisher Publisher
+	opts      *options
+
+	mutex        sync.Mutex
+	running      bool
+	cancel       context.CancelFunc
+	notifyChan   chan struct{}
+	closeChannel chan struct{}
+	doneChannel  chan struct{}
+	// leaseCreated 租约记录已创建
+	leaseCreated bool
+	// leaseDeadline 租约过期时间的本地估算：获取租约前的时间加租约时长
+	leaseDeadline time.Time
+}
+
+// NewOutbox 事务发件箱；转发使用主库
+func NewOutbox(db *gorm.DB, publisher Publisher, opts ...Option) *Outbox {
+	return &Outbox{
+		db:         db.Clauses(dbresolver.Write).Session(&gorm.Session{}),
+		publisher:  publisher,
+		opts:       newOptions(opts...),
+		notifyChan: make(chan struct{}, 1),
+	}
+}
+
+// newOptions ...
+func newOptions(opts ...Option) *options {
+	outboxOpts := &options{
+		tableName:      DefaultTableName,
+		leaseTableName: DefaultLeaseTableName,
+		leaseName:      DefaultLeaseName,
+		leaseTTL:       DefaultLeaseTTL,
+		pollInterval:   DefaultPollInterval,
+		batchSize:      DefaultBatchSize,
+		publishTimeout: DefaultPublishTimeout,
+	}
+	for i := range opts {
+		opts[i](outboxOpts)
+	}
+	if outboxOpts.owner == "" {
+		hostname, _ := os.Hostname()
+		outboxOpts.owner = hostname + ":" + strconv.Itoa(os.Getpid()) + ":" + uuid.NewString()
+	}
+	if outboxOpts.propagator == nil {
+		outboxOpts.propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+	if outboxOpts.leaseTTL <= 0 {
+		outboxOpts.leaseTTL = DefaultLeaseTTL
+	}
+	if outboxOpts.pollInterval <= 0 {
+		outboxOpts.pollInterval = DefaultPollInterval
+	}
+	if outboxOpts.batchSize <= 0 {
+		outboxOpts.batchSize = DefaultBatchSize
+	}
+	if outboxOpts.publishTimeout <= 0 {
+		outboxOpts.publishTimeout = DefaultPublishTimeout
+	}
+	return outboxOpts
+}
+
+// Add 写入事件；tx 需为业务数据的事务，事务提交后由转发发布；追踪上下文写入消息头
+//
+//	err = db.Transaction(func(tx *gorm.DB) error {
+//		if err := tx.Create(order).Error; err != nil {
+//			return err
+//		}
+//		return outbox.Add(ctx, tx, msg)
+//	})
+func (o *Outbox) Add(ctx context.Context, tx *gorm.DB, msgs ...*Message) error {
+	if len(msgs) == 0 {
+		return nil
+	}
+	events := make([]*Event, 0, len(msgs))
+	for _, msg := range msgs {
+		headers := make(map[string]string, len(msg.Headers)+2)
+		for k, v := range msg.Headers {
+			headers[k] = v
+		}
+		if trace.SpanContextFromContext(ctx).IsValid() {
+			o.opts.propagator.Inject(ctx, propagation.MapCarrier(headers))
+		}
+		event := &Event{
+			Exchange:    msg.Exchange,
+			RoutingKey:  msg.RoutingKey,
+			MessageID:   msg.MessageID,
+			ContentType: msg.ContentType,
+			Body:        msg.Body,
+		}
+		if event.MessageID == "" {
+			event.MessageID = uuid.NewString()
+		}
+		if len(headers) > 0 {
+			headersJSON, err := json.Marshal(headers)
+			if err != nil {
+				return pkgerrors.WithStack(err)
+			}
+			event.Headers = string(headersJSON)
+		}
+		events = append(events, event)
+	}
+	if err := tx.WithContext(ctx).Table(o.opts.tableName).Create(&events).Error; err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	return nil
+}
+
+// Notify 唤醒转发；事务提交后调用可减少发布延迟
+func (o *Outbox) Notify() {
+	select {
+	case o.notifyChan <- struct{}{}:
+	default:
+	}
+}
+
+// toPublishing ...
+func (e *Event) toPublishing() (amqp.Publishing, error) {
+	msg := amqp.Publishing{
+		MessageId:    e.MessageID,
+		ContentType:  e.ContentType,
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    e.CreatedAt,
+		Body:         e.Body,
+	}
+	if e.Headers == "" {
+		return msg, nil
+	}
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(e.Headers), &headers); err != nil {
+		return msg, pkgerrors.WithStack(err)
+	}
+	msg.Headers = make(amqp.Table, len(headers))
+	for k, v := range headers {
+		msg.Headers[k] = v
+	}
+	return msg, nil
+}
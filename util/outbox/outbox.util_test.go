@@ -0,0 +1,295 @@
+package outboxutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	migrationutil "github.com/my-saas-platform/api-proto/util/migration"
+	rabbitmqutil "github.com/my-saas-platform/api-proto/util/rabbitmq"
+	"github.com/my-saas-platform/api-proto/util/rabbitmq/rabbitmqtest"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+type testingOrder struct {
+	ID   uint64 `gorm:"primaryKey"`
+	Name string
+}
+
+func newTestingDB(t *testing.T) *gorm.DB {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.Silent),
+	})
+	require.NoError(t, err)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	// 内存数据库：使用同一个连接
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	migrator, err := migrationutil.NewMigrator(db, []*migrationutil.Migration{NewMigration(1)})
+	require.NoError(t, err)
+	_, err = migrator.Up(context.Background())
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&testingOrder{}))
+	return db
+}
+
+func newTestingPublisher(t *testing.T) (*rabbitmqtest.Server, *rabbitmqutil.Connection) {
+	server := rabbitmqtest.NewServer()
+	conn, err := rabbitmqutil.NewConnection("amqp://127.0.0.1:5672/", rabbitmqutil.WithDialer(server.Dial))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return server, conn
+}
+
+func countEvents(t *testing.T, db *gorm.DB) int64 {
+	var count int64
+	require.NoError(t, db.Table(DefaultTableName).Count(&count).Error)
+	return count
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_Add
+func TestOutbox_Add(t *testing.T) {
+	db := newTestingDB(t)
+	_, conn := newTestingPublisher(t)
+	outbox := NewOutbox(db, conn)
+
+	tp := sdktrace.NewTracerProvider()
+	ctx, span := tp.Tracer("testing").Start(context.Background(), "create order")
+	defer span.End()
+
+	msg, err := NewJSONMessage("", "testing.orders", map[string]interface{}{"id": 1})
+	require.NoError(t, err)
+
+	// 回滚：不写入事件
+	err = db.Transaction(func(tx *gorm.DB) error {
+		require.NoError(t, tx.Create(&testingOrder{Name: "rollback"}).Error)
+		require.NoError(t, outbox.Add(ctx, tx, msg))
+		return errors.New("rollback")
+	})
+	require.Error(t, err)
+	require.Equal(t, int64(0), countEvents(t, db))
+
+	// 提交
+	err = db.Transaction(func(tx *gorm.DB) error {
+		require.NoError(t, tx.Create(&testingOrder{Name: "commit"}).Error)
+		return outbox.Add(ctx, tx, msg)
+	})
+	require.NoError(t, err)
+
+	var events []*Event
+	require.NoError(t, db.Table(DefaultTableName).Find(&events).Error)
+	require.Len(t, events, 1)
+	require.NotEmpty(t, events[0].MessageID)
+	require.Equal(t, "application/json", events[0].ContentType)
+
+	publishing, err := events[0].toPublishing()
+	require.NoError(t, err)
+	require.Contains(t, publishing.Headers, "traceparent")
+	require.Contains(t, publishing.Headers["traceparent"], span.SpanContext().TraceID().String())
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_Relay
+func TestOutbox_Relay(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	server, conn := newTestingPublisher(t)
+	outbox := NewOutbox(db, conn, WithBatchSize(2))
+
+	for _, name := range []string{"first", "second", "third", "fourth", "fifth"} {
+		require.NoError(t, outbox.Add(ctx, db, &Message{RoutingKey: "testing.orders", MessageID: name, Body: []byte(name)}))
+	}
+	require.NoError(t, outbox.relay(ctx))
+	require.Equal(t, int64(0), countEvents(t, db))
+
+	var messageIDs []string
+	for _, p := range server.Published() {
+		messageIDs = append(messageIDs, p.Msg.MessageId)
+		require.Equal(t, amqp.Persistent, p.Msg.DeliveryMode)
+	}
+	require.Equal(t, []string{"first", "second", "third", "fourth", "fifth"}, messageIDs)
+}
+
+// failingPublisher 第 failAt 次发布失败
+type failingPublisher struct {
+	mutex      sync.Mutex
+	calls      int
+	failAt     int
+	messageIDs []string
+}
+
+func (p *failingPublisher) Publish(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.calls++
+	if p.calls == p.failAt {
+		return errors.New("publish failed")
+	}
+	p.messageIDs = append(p.messageIDs, msg.MessageId)
+	return nil
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_RelayFailed
+func TestOutbox_RelayFailed(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	publisher := &failingPublisher{failAt: 3}
+	outbox := NewOutbox(db, publisher)
+
+	for _, name := range []string{"first", "second", "third", "fourth"} {
+		require.NoError(t, outbox.Add(ctx, db, &Message{RoutingKey: "testing.orders", MessageID: name}))
+	}
+
+	// 发布失败时停止，已发布的事件删除
+	require.Error(t, outbox.relay(ctx))
+	require.Equal(t, []string{"first", "second"}, publisher.messageIDs)
+	require.Equal(t, int64(2), countEvents(t, db))
+
+	// 按顺序继续发布
+	require.NoError(t, outbox.relay(ctx))
+	require.Equal(t, []string{"first", "second", "third", "fourth"}, publisher.messageIDs)
+	require.Equal(t, int64(0), countEvents(t, db))
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_Lease
+func TestOutbox_Lease(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	publisherA, publisherB := &failingPublisher{}, &failingPublisher{}
+	outboxA := NewOutbox(db, publisherA, WithOwner("replica-a"), WithLeaseTTL(50*time.Millisecond))
+	outboxB := NewOutbox(db, publisherB, WithOwner("replica-b"), WithLeaseTTL(50*time.Millisecond))
+
+	leader, err := outboxA.acquireLease(ctx)
+	require.NoError(t, err)
+	require.True(t, leader)
+	leader, err = outboxA.acquireLease(ctx)
+	require.NoError(t, err)
+	require.True(t, leader)
+	leader, err = outboxB.acquireLease(ctx)
+	require.NoError(t, err)
+	require.False(t, leader)
+
+	// 未持有租约：不发布
+	require.NoError(t, outboxB.Add(ctx, db, &Message{RoutingKey: "testing.orders", MessageID: "first"}))
+	require.NoError(t, outboxB.relay(ctx))
+	require.Empty(t, publisherB.messageIDs)
+	require.Equal(t, int64(1), countEvents(t, db))
+
+	// 过期后接管
+	time.Sleep(60 * time.Millisecond)
+	leader, err = outboxB.acquireLease(ctx)
+	require.NoError(t, err)
+	require.True(t, leader)
+	leader, err = outboxA.acquireLease(ctx)
+	require.NoError(t, err)
+	require.False(t, leader)
+
+	// 释放后接管
+	require.NoError(t, outboxB.releaseLease(ctx))
+	leader, err = outboxA.acquireLease(ctx)
+	require.NoError(t, err)
+	require.True(t, leader)
+
+	var lease Lease
+	require.NoError(t, db.Table(DefaultLeaseTableName).Where("name = ?", DefaultLeaseName).First(&lease).Error)
+	require.Equal(t, "replica-a", lease.Owner)
+	require.Equal(t, uint64(5), lease.Version)
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_Start
+func TestOutbox_Start(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	server, conn := newTestingPublisher(t)
+	outbox := NewOutbox(db, conn, WithPollInterval(time.Hour))
+	outbox.Start()
+
+	require.NoError(t, outbox.Add(ctx, db, &Message{RoutingKey: "testing.orders", MessageID: "first"}))
+	outbox.Notify()
+	require.Eventually(t, func() bool { return len(server.Published()) == 1 }, time.Second, 5*time.Millisecond)
+	require.NoError(t, outbox.Stop(ctx))
+
+	// 停止后释放租约
+	var lease Lease
+	require.NoError(t, db.Table(DefaultLeaseTableName).Where("name = ?", DefaultLeaseName).First(&lease).Error)
+	require.Empty(t, lease.Owner)
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_RelayParked
+func TestOutbox_RelayParked(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	publisher := &failingPublisher{}
+	outbox := NewOutbox(db, publisher)
+
+	for _, name := range []string{"first", "second", "third"} {
+		require.NoError(t, outbox.Add(ctx, db, &Message{RoutingKey: "testing.orders", MessageID: name}))
+	}
+	require.NoError(t, db.Table(DefaultTableName).Where("message_id = ?", "second").Update("headers", "{invalid").Error)
+
+	// 无效的消息头：标记后跳过，不阻塞后续的事件
+	require.NoError(t, outbox.relay(ctx))
+	require.Equal(t, []string{"first", "third"}, publisher.messageIDs)
+	var events []*Event
+	require.NoError(t, db.Table(DefaultTableName).Find(&events).Error)
+	require.Len(t, events, 1)
+	require.Equal(t, "second", events[0].MessageID)
+	require.True(t, events[0].Parked)
+
+	require.NoError(t, outbox.relay(ctx))
+	require.Equal(t, []string{"first", "third"}, publisher.messageIDs)
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_RelayLeaseLost
+func TestOutbox_RelayLeaseLost(t *testing.T) {
+	ctx := context.Background()
+	db := newTestingDB(t)
+	publisherA := &failingPublisher{}
+	outboxA := NewOutbox(db, publisherA, WithOwner("replica-a"), WithLeaseTTL(50*time.Millisecond))
+	outboxB := NewOutbox(db, &failingPublisher{}, WithOwner("replica-b"), WithLeaseTTL(time.Minute))
+
+	require.NoError(t, outboxA.Add(ctx, db, &Message{RoutingKey: "testing.orders", MessageID: "first"}))
+	leader, err := outboxA.acquireLease(ctx)
+	require.NoError(t, err)
+	require.True(t, leader)
+
+	// 租约过期后被其他副本持有：不删除，由持有者重新发布
+	time.Sleep(60 * time.Millisecond)
+	leader, err = outboxB.acquireLease(ctx)
+	require.NoError(t, err)
+	require.True(t, leader)
+	err = outboxA.deleteEvents(ctx, []uint64{1})
+	require.ErrorIs(t, err, ErrLeaseLost)
+	require.Equal(t, int64(1), countEvents(t, db))
+
+	// 剩余时长不足时续期失败：不发布
+	_, err = outboxA.relayBatch(ctx)
+	require.ErrorIs(t, err, ErrLeaseLost)
+	require.Empty(t, publisherA.messageIDs)
+}
+
+// go test -v ./util/outbox/ -count=1 -test.run=TestOutbox_StopExpired
+func TestOutbox_StopExpired(t *testing.T) {
+	db := newTestingDB(t)
+	outbox := NewOutbox(db, &failingPublisher{}, WithPollInterval(time.Hour))
+	leader, err := outbox.acquireLease(context.Background())
+	require.NoError(t, err)
+	require.True(t, leader)
+	outbox.Start()
+
+	// ctx 已结束：仍释放租约
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_ = outbox.Stop(ctx)
+	var lease Lease
+	require.NoError(t, db.Table(DefaultLeaseTableName).Where("name = ?", DefaultLeaseName).First(&lease).Error)
+	require.Empty(t, lease.Owner)
+}
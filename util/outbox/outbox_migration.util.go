@@ -0,0 +1,31 @@
+package outboxutil
+
+import (
+	"context"
+
+	migrationutil "github.com/my-saas-platform/api-proto/util/migration"
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// NewMigration 迁移：创建事件表与转发租约表；表名可选项需与 NewOutbox 一致
+// 例：setuputil.WithMigrations(outboxutil.NewMigration(20240101000000))
+func NewMigration(version int64, opts ...Option) *migrationutil.Migration {
+	outboxOpts := newOptions(opts...)
+	up := func(ctx context.Context, tx *gorm.DB) error {
+		if err := tx.Table(outboxOpts.tableName).Migrator().CreateTable(&Event{}); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+		if err := tx.Table(outboxOpts.leaseTableName).Migrator().CreateTable(&Lease{}); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+		return nil
+	}
+	down := func(ctx context.Context, tx *gorm.DB) error {
+		if err := tx.Migrator().DropTable(outboxOpts.leaseTableName, outboxOpts.tableName); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+		return nil
+	}
+	return migrationutil.NewMigration(version, "create_outbox_tables", up, down)
+}
@@ -0,0 +1,261 @@
+package outboxutil
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Start 启动转发；持有租约的副本按写入顺序发布事件，发布成功后删除
+func (o *Outbox) Start() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if o.running {
+		return
+	}
+	o.running = true
+	var ctx context.Context
+	ctx, o.cancel = context.WithCancel(context.Background())
+	o.closeChannel = make(chan struct{})
+	o.doneChannel = make(chan struct{})
+	go o.run(ctx)
+}
+
+// Stop 停止转发并释放租约；ctx 结束时仍释放租约，其他副本无需等待过期
+func (o *Outbox) Stop(ctx context.Context) error {
+	o.mutex.Lock()
+	if !o.running {
+		o.mutex.Unlock()
+		return nil
+	}
+	o.running = false
+	o.cancel()
+	close(o.closeChannel)
+	doneChannel := o.doneChannel
+	o.mutex.Unlock()
+
+	var waitErr error
+	select {
+	case <-doneChannel:
+	case <-ctx.Done():
+		waitErr = pkgerrors.WithStack(ctx.Err())
+	}
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), releaseLeaseTimeout)
+	defer cancel()
+	if err := o.releaseLease(releaseCtx); err != nil {
+		return err
+	}
+	return waitErr
+}
+
+// run ...
+func (o *Outbox) run(ctx context.Context) {
+	defer close(o.doneChannel)
+	ticker := time.NewTicker(o.opts.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := o.relay(ctx); err != nil && ctx.Err() == nil {
+			logpkg.Warnw(
+				"outbox.status", "relay failed",
+				"outbox.error", err.Error(),
+			)
+		}
+		select {
+		case <-o.closeChannel:
+			return
+		case <-ticker.C:
+		case <-o.notifyChan:
+		}
+	}
+}
+
+// relay 持有租约时发布全部待发布的事件
+func (o *Outbox) relay(ctx context.Context) error {
+	for {
+		leader, err := o.acquireLease(ctx)
+		if err != nil || !leader {
+			return err
+		}
+		published, err := o.relayBatch(ctx)
+		if err != nil || published < o.opts.batchSize {
+			return err
+		}
+	}
+}
+
+// relayBatch 按ID顺序发布一批事件；发布失败或失去租约时停止，保证顺序
+func (o *Outbox) relayBatch(ctx context.Context) (int, error) {
+	var events []*Event
+	err := o.db.WithContext(ctx).Table(o.opts.tableName).
+		Where("parked = ?", false).
+		Order("id ASC").Limit(o.opts.batchSize).
+		Find(&events).Error
+	if err != nil {
+		return 0, pkgerrors.WithStack(err)
+	}
+
+	var (
+		relayed      int
+		publishedIDs = make([]uint64, 0, len(events))
+		relayErr     error
+	)
+	for _, event := range events {
+		// 租约剩余时长不足一次发布时续期；发布在租约过期前结束，其他副本不会同时发布
+		if relayErr = o.renewLeaseIfNeeded(ctx); relayErr != nil {
+			break
+		}
+		msg, err := event.toPublishing()
+		if err != nil {
+			if relayErr = o.parkEvent(ctx, event, err); relayErr != nil {
+				break
+			}
+			relayed++
+			continue
+		}
+		publishCtx, cancel := context.WithTimeout(ctx, o.publishTimeout())
+		err = o.publisher.Publish(publishCtx, event.Exchange, event.RoutingKey, msg)
+		cancel()
+		if err != nil {
+			relayErr = pkgerrors.WithMessagef(err, "outbox publish failed ; event_id = %d", event.ID)
+			break
+		}
+		publishedIDs = append(publishedIDs, event.ID)
+		relayed++
+	}
+
+	// 删除失败时重新发布：至少一次
+	if len(publishedIDs) > 0 {
+		if err = o.deleteEvents(ctx, publishedIDs); err != nil {
+			return relayed, err
+		}
+	}
+	return relayed, relayErr
+}
+
+// publishTimeout 单次发布的超时时间；不超过租约时长的一半
+func (o *Outbox) publishTimeout() time.Duration {
+	return min(o.opts.publishTimeout, o.opts.leaseTTL/2)
+}
+
+// renewLeaseIfNeeded 租约剩余时长(本地时钟估算，早于数据库中的过期时间)不足一次发布时续期
+func (o *Outbox) renewLeaseIfNeeded(ctx context.Context) error {
+	if time.Until(o.leaseDeadline) > o.publishTimeout() {
+		return nil
+	}
+	leader, err := o.acquireLease(ctx)
+	if err != nil {
+		return err
+	}
+	if !leader {
+		return pkgerrors.WithStack(ErrLeaseLost)
+	}
+	return nil
+}
+
+// deleteEvents 删除已发布的事件；仅在持有未过期的租约时删除
+func (o *Outbox) deleteEvents(ctx context.Context, ids []uint64) error {
+	db := o.db.WithContext(ctx)
+	leaseQuery := db.Session(&gorm.Session{}).Table(o.opts.leaseTableName).
+		Select("1").
+		Where("name = ? AND owner = ? AND expired_at > ?", o.opts.leaseName, o.opts.owner, o.dbNow())
+	result := db.Session(&gorm.Session{}).Table(o.opts.tableName).
+		Where("id IN ?", ids).
+		Where("EXISTS (?)", leaseQuery).
+		Delete(&Event{})
+	if result.Error != nil {
+		return pkgerrors.WithStack(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return pkgerrors.WithStack(ErrLeaseLost)
+	}
+	return nil
+}
+
+// parkEvent 无法发布的事件：标记后跳过，不阻塞后续的事件；需人工处理
+func (o *Outbox) parkEvent(ctx context.Context, event *Event, cause error) error {
+	logpkg.Errorw(
+		"outbox.status", "event parked",
+		"outbox.event_id", event.ID,
+		"outbox.message_id", event.MessageID,
+		"outbox.error", cause.Error(),
+	)
+	err := o.db.WithContext(ctx).Table(o.opts.tableName).
+		Where("id = ?", event.ID).
+		Update("parked", true).Error
+	return pkgerrors.WithStack(err)
+}
+
+// acquireLease 获取或续期租约；租约过期或属于当前副本时成功；过期时间使用数据库时钟
+func (o *Outbox) acquireLease(ctx context.Context) (bool, error) {
+	db := o.db.WithContext(ctx).Table(o.opts.leaseTableName)
+	if !o.leaseCreated {
+		err := db.Session(&gorm.Session{}).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&Lease{Name: o.opts.leaseName, ExpiredAt: time.Unix(0, 0).UTC()}).Error
+		if err != nil {
+			return false, pkgerrors.WithStack(err)
+		}
+		o.leaseCreated = true
+	}
+
+	startAt := time.Now()
+	result := db.Session(&gorm.Session{}).
+		Where("name = ? AND (owner = ? OR expired_at < ?)", o.opts.leaseName, o.opts.owner, o.dbNow()).
+		Updates(map[string]interface{}{
+			"owner":      o.opts.owner,
+			"version":    gorm.Expr("version + 1"),
+			"expired_at": o.dbNowAdd(o.opts.leaseTTL),
+		})
+	if result.Error != nil {
+		return false, pkgerrors.WithStack(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		o.leaseDeadline = time.Time{}
+		return false, nil
+	}
+	o.leaseDeadline = startAt.Add(o.opts.leaseTTL)
+	return true, nil
+}
+
+// releaseLease 释放租约；其他副本无需等待过期
+func (o *Outbox) releaseLease(ctx context.Context) error {
+	err := o.db.WithContext(ctx).Table(o.opts.leaseTableName).
+		Where("name = ? AND owner = ?", o.opts.leaseName, o.opts.owner).
+		Updates(map[string]interface{}{
+			"owner":      "",
+			"version":    gorm.Expr("version + 1"),
+			"expired_at": time.Unix(0, 0).UTC(),
+		}).Error
+	return pkgerrors.WithStack(err)
+}
+
+// dbNow 数据库的当前时间(UTC)；副本间的时钟偏差不影响租约
+func (o *Outbox) dbNow() interface{} {
+	switch o.db.Dialector.Name() {
+	case "mysql":
+		return gorm.Expr("UTC_TIMESTAMP(6)")
+	case "postgres":
+		return gorm.Expr("CURRENT_TIMESTAMP")
+	case "sqlite":
+		return gorm.Expr("STRFTIME('%Y-%m-%d %H:%M:%f', 'now')")
+	}
+	return time.Now().UTC()
+}
+
+// dbNowAdd 数据库的当前时间(UTC)加 d
+func (o *Outbox) dbNowAdd(d time.Duration) interface{} {
+	switch o.db.Dialector.Name() {
+	case "mysql":
+		return gorm.Expr("DATE_ADD(UTC_TIMESTAMP(6), INTERVAL ? MICROSECOND)", d.Microseconds())
+	case "postgres":
+		return gorm.Expr("CURRENT_TIMESTAMP + ? * INTERVAL '1 microsecond'", d.Microseconds())
+	case "sqlite":
+		return gorm.Expr("STRFTIME('%Y-%m-%d %H:%M:%f', 'now', ?)", fmt.Sprintf("%+.3f seconds", d.Seconds()))
+	}
+	return time.Now().UTC().Add(d)
+}
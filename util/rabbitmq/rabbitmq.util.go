@@ -0,0 +1,196 @@
+package rabbitmqutil
+
+import (
+	"context"
+	"crypto/tls"
+	strerrors "errors"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// DefaultChannelPoolSize 空闲channel池大小
+	DefaultChannelPoolSize = 16
+	// DefaultReconnectInitialBackoff 重连的初始间隔
+	DefaultReconnectInitialBackoff = 500 * time.Millisecond
+	// DefaultReconnectMaxBackoff 重连的最大间隔
+	DefaultReconnectMaxBackoff = 30 * time.Second
+)
+
+var (
+	// ErrClosed 链接已关闭
+	ErrClosed = strerrors.New("rabbitmq connection closed")
+	// ErrChannelClosed channel已关闭
+	ErrChannelClosed = strerrors.New("rabbitmq channel closed")
+	// ErrPublishNacked 服务端拒绝确认发布
+	ErrPublishNacked = strerrors.New("rabbitmq publish nacked")
+)
+
+// AMQPConnection amqp 链接；*amqp.Connection 的子集，便于替换为测试替身
+type AMQPConnection interface {
+	Channel() (AMQPChannel, error)
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	IsClosed() bool
+	Close() error
+}
+
+// AMQPChannel amqp channel；*amqp.Channel 的子集
+type AMQPChannel interface {
+	ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error
+	QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error)
+	QueueBind(name, key, exchange string, noWait bool, args amqp.Table) error
+	Qos(prefetchCount, prefetchSize int, global bool) error
+	Confirm(noWait bool) error
+	NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation
+	NotifyClose(receiver chan *amqp.Error) chan *amqp.Error
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+	Consume(queue, consumer string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error)
+	Cancel(consumer string, noWait bool) error
+	IsClosed() bool
+	Close() error
+}
+
+// Dialer 拨号
+type Dialer func(url string, config amqp.Config) (AMQPConnection, error)
+
+// DefaultDialer amqp.DialConfig
+func DefaultDialer(url string, config amqp.Config) (AMQPConnection, error) {
+	conn, err := amqp.DialConfig(url, config)
+	if err != nil {
+		return nil, err
+	}
+	return &amqpConnection{Connection: conn}, nil
+}
+
+// amqpConnection *amqp.Connection
+type amqpConnection struct {
+	*amqp.Connection
+}
+
+// Channel ...
+func (c *amqpConnection) Channel() (AMQPChannel, error) {
+	return c.Connection.Channel()
+}
+
+// Exchange 交换机
+type Exchange struct {
+	Name string
+	// Kind 类型；amqp.ExchangeDirect、amqp.ExchangeFanout、amqp.ExchangeTopic、amqp.ExchangeHeaders
+	Kind       string
+	Durable    bool
+	AutoDelete bool
+	Internal   bool
+	Args       amqp.Table
+}
+
+// Queue 队列
+type Queue struct {
+	Name       string
+	Durable    bool
+	AutoDelete bool
+	Exclusive  bool
+	Args       amqp.Table
+}
+
+// Binding 绑定
+type Binding struct {
+	Queue      string
+	Exchange   string
+	RoutingKey string
+	Args       amqp.Table
+}
+
+// Topology 拓扑；声明后在每次重连时重新声明
+type Topology struct {
+	Exchanges []*Exchange
+	Queues    []*Queue
+	Bindings  []*Binding
+}
+
+// declare 声明
+func (t *Topology) declare(ch AMQPChannel) error {
+	for _, e := range t.Exchanges {
+		if err := ch.ExchangeDeclare(e.Name, e.Kind, e.Durable, e.AutoDelete, e.Internal, false, e.Args); err != nil {
+			return err
+		}
+	}
+	for _, q := range t.Queues {
+		if _, err := ch.QueueDeclare(q.Name, q.Durable, q.AutoDelete, q.Exclusive, false, q.Args); err != nil {
+			return err
+		}
+	}
+	for _, b := range t.Bindings {
+		if err := ch.QueueBind(b.Queue, b.RoutingKey, b.Exchange, false, b.Args); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// options 可选项
+type options struct {
+	dialer                  Dialer
+	tlsConfig               *tls.Config
+	channelPoolSize         int
+	publisherConfirms       bool
+	reconnectInitialBackoff time.Duration
+	reconnectMaxBackoff     time.Duration
+	propagator              propagation.TextMapPropagator
+	tracerProvider          trace.TracerProvider
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithDialer 拨号；默认 DefaultDialer
+func WithDialer(dialer Dialer) Option {
+	return func(o *options) {
+		o.dialer = dialer
+	}
+}
+
+// WithTLSConfig tls
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(o *options) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithChannelPoolSize 空闲channel池大小
+func WithChannelPoolSize(size int) Option {
+	return func(o *options) {
+		o.channelPoolSize = size
+	}
+}
+
+// WithPublisherConfirms 发布确认；Publish 等待服务端确认
+func WithPublisherConfirms(enable bool) Option {
+	return func(o *options) {
+		o.publisherConfirms = enable
+	}
+}
+
+// WithReconnectBackoff 重连间隔；每次失败后翻倍，直到 maxBackoff
+func WithReconnectBackoff(initialBackoff, maxBackoff time.Duration) Option {
+	return func(o *options) {
+		o.reconnectInitialBackoff = initialBackoff
+		o.reconnectMaxBackoff = maxBackoff
+	}
+}
+
+// WithPropagator 追踪上下文传递；默认 propagation.TraceContext 与 propagation.Baggage
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+// WithTracerProvider 追踪；默认 otel.GetTracerProvider
+func WithTracerProvider(tracerProvider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.tracerProvider = tracerProvider
+	}
+}
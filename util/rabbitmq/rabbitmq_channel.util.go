@@ -0,0 +1,70 @@
+package rabbitmqutil
+
+import (
+	"context"
+
+	pkgerrors "github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+// Channel rabbitmq channel
+type Channel struct {
+	conn       *Connection
+	ch         AMQPChannel
+	generation uint64
+	pooled     bool
+	// broken 发生错误，不再归还到池
+	broken bool
+	// confirms 发布确认
+	confirms chan amqp.Confirmation
+}
+
+// AMQPChannel 原始channel；例：Consume、Qos
+func (c *Channel) AMQPChannel() AMQPChannel {
+	return c.ch
+}
+
+// Publish 发布消息；启用发布确认时等待服务端确认；追踪上下文写入消息头
+func (c *Channel) Publish(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
+	c.conn.opts.injectTraceContext(ctx, &msg)
+	if err := c.ch.PublishWithContext(ctx, exchange, routingKey, false, false, msg); err != nil {
+		c.broken = true
+		return pkgerrors.WithStack(err)
+	}
+	if c.confirms == nil {
+		return nil
+	}
+
+	select {
+	case confirm, ok := <-c.confirms:
+		if !ok {
+			c.broken = true
+			return pkgerrors.WithStack(ErrChannelClosed)
+		}
+		if !confirm.Ack {
+			return pkgerrors.WithStack(ErrPublishNacked)
+		}
+		return nil
+	case <-ctx.Done():
+		// 未收到的确认会错位，不再复用
+		c.broken = true
+		return pkgerrors.WithStack(ctx.Err())
+	}
+}
+
+// MarkBroken 不再复用；例：消费或声明失败后
+func (c *Channel) MarkBroken() {
+	c.broken = true
+}
+
+// Close 池中的channel归还到池；其他channel直接关闭
+func (c *Channel) Close() error {
+	if c.pooled {
+		c.conn.release(c)
+		return nil
+	}
+	if c.ch.IsClosed() {
+		return nil
+	}
+	return pkgerrors.WithStack(c.ch.Close())
+}
@@ -0,0 +1,375 @@
+package rabbitmqutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Connection rabbitmq 链接；
+// 断开后按退避间隔自动重连，重连后重新声明拓扑并通知 OnReconnect 注册的函数
+type Connection struct {
+	url  string
+	opts *options
+
+	mutex      sync.RWMutex
+	conn       AMQPConnection
+	generation uint64
+	// connected 链接可用时关闭
+	connected          chan struct{}
+	topologies         []*Topology
+	reconnectListeners []func()
+	closed             bool
+
+	pool         chan *Channel
+	closeChannel chan struct{}
+	doneChannel  chan struct{}
+}
+
+// NewConnection rabbitmq 链接；首次连接失败时返回错误
+func NewConnection(url string, opts ...Option) (*Connection, error) {
+	connOpts := &options{
+		dialer:                  DefaultDialer,
+		channelPoolSize:         DefaultChannelPoolSize,
+		reconnectInitialBackoff: DefaultReconnectInitialBackoff,
+		reconnectMaxBackoff:     DefaultReconnectMaxBackoff,
+	}
+	for i := range opts {
+		opts[i](connOpts)
+	}
+	if connOpts.channelPoolSize <= 0 {
+		connOpts.channelPoolSize = DefaultChannelPoolSize
+	}
+	if connOpts.reconnectInitialBackoff <= 0 {
+		connOpts.reconnectInitialBackoff = DefaultReconnectInitialBackoff
+	}
+	if connOpts.propagator == nil {
+		connOpts.propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+	if connOpts.reconnectMaxBackoff < connOpts.reconnectInitialBackoff {
+		connOpts.reconnectMaxBackoff = connOpts.reconnectInitialBackoff
+	}
+
+	c := &Connection{
+		url:          url,
+		opts:         connOpts,
+		connected:    make(chan struct{}),
+		pool:         make(chan *Channel, connOpts.channelPoolSize),
+		closeChannel: make(chan struct{}),
+		doneChannel:  make(chan struct{}),
+	}
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	c.setConnected(conn)
+
+	go c.watch(conn)
+	return c, nil
+}
+
+// DeclareTopology 声明拓扑；重连后自动重新声明
+func (c *Connection) DeclareTopology(ctx context.Context, topology *Topology) error {
+	ch, err := c.NewChannel(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = ch.Close() }()
+
+	if err = topology.declare(ch.ch); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	c.mutex.Lock()
+	c.topologies = append(c.topologies, topology)
+	c.mutex.Unlock()
+	return nil
+}
+
+// OnReconnect 注册重连后执行的函数；例：重新订阅
+func (c *Connection) OnReconnect(fn func()) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.reconnectListeners = append(c.reconnectListeners, fn)
+}
+
+// Channel 从池中获取channel；使用完毕后调用 Channel.Close 归还；链接断开时等待重连
+func (c *Connection) Channel(ctx context.Context) (*Channel, error) {
+	for {
+		conn, generation, err := c.waitConnected(ctx)
+		if err != nil {
+			return nil, err
+		}
+		select {
+		case ch := <-c.pool:
+			if ch.generation == generation && !ch.broken && !ch.ch.IsClosed() {
+				return ch, nil
+			}
+			_ = ch.ch.Close()
+			continue
+		default:
+		}
+		ch, err := c.newChannel(conn, generation, true)
+		if err != nil {
+			return nil, err
+		}
+		return ch, nil
+	}
+}
+
+// NewChannel 不使用池的channel；用于消费等独占的场景；Channel.Close 时关闭
+func (c *Connection) NewChannel(ctx context.Context) (*Channel, error) {
+	conn, generation, err := c.waitConnected(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return c.newChannel(conn, generation, false)
+}
+
+// Publish 发布消息；启用发布确认时等待服务端确认；追踪上下文写入消息头
+func (c *Connection) Publish(ctx context.Context, exchange, routingKey string, msg amqp.Publishing) error {
+	ch, err := c.Channel(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = ch.Close() }()
+	return ch.Publish(ctx, exchange, routingKey, msg)
+}
+
+// IsConnected 链接是否可用
+func (c *Connection) IsConnected() bool {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	return c.conn != nil && !c.closed
+}
+
+// Close 关闭；停止重连，关闭池中的channel与链接
+func (c *Connection) Close() error {
+	c.mutex.Lock()
+	if c.closed {
+		c.mutex.Unlock()
+		return nil
+	}
+	c.closed = true
+	conn := c.conn
+	c.conn = nil
+	c.mutex.Unlock()
+
+	close(c.closeChannel)
+	<-c.doneChannel
+	c.drainPool()
+	if conn == nil || conn.IsClosed() {
+		return nil
+	}
+	return pkgerrors.WithStack(conn.Close())
+}
+
+// dial 拨号
+func (c *Connection) dial() (AMQPConnection, error) {
+	conn, err := c.opts.dialer(c.url, amqp.Config{
+		TLSClientConfig: c.opts.tlsConfig,
+		Heartbeat:       10 * time.Second,
+		Locale:          "en_US",
+	})
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return conn, nil
+}
+
+// setConnected 链接可用
+func (c *Connection) setConnected(conn AMQPConnection) {
+	c.mutex.Lock()
+	c.conn = conn
+	c.generation++
+	close(c.connected)
+	listeners := make([]func(), len(c.reconnectListeners))
+	copy(listeners, c.reconnectListeners)
+	isReconnect := c.generation > 1
+	c.mutex.Unlock()
+
+	if isReconnect {
+		for i := range listeners {
+			listeners[i]()
+		}
+	}
+}
+
+// setDisconnected 链接断开
+func (c *Connection) setDisconnected() {
+	c.mutex.Lock()
+	c.conn = nil
+	c.connected = make(chan struct{})
+	c.mutex.Unlock()
+	c.drainPool()
+}
+
+// waitConnected 等待链接可用
+func (c *Connection) waitConnected(ctx context.Context) (AMQPConnection, uint64, error) {
+	for {
+		c.mutex.RLock()
+		var (
+			closed     = c.closed
+			conn       = c.conn
+			generation = c.generation
+			connected  = c.connected
+		)
+		c.mutex.RUnlock()
+		if closed {
+			return nil, 0, pkgerrors.WithStack(ErrClosed)
+		}
+		if conn != nil {
+			return conn, generation, nil
+		}
+		select {
+		case <-connected:
+		case <-c.closeChannel:
+		case <-ctx.Done():
+			return nil, 0, pkgerrors.WithStack(ctx.Err())
+		}
+	}
+}
+
+// newChannel ...
+func (c *Connection) newChannel(conn AMQPConnection, generation uint64, pooled bool) (*Channel, error) {
+	amqpCh, err := conn.Channel()
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	ch := &Channel{
+		conn:       c,
+		ch:         amqpCh,
+		generation: generation,
+		pooled:     pooled,
+	}
+	if c.opts.publisherConfirms {
+		if err = amqpCh.Confirm(false); err != nil {
+			_ = amqpCh.Close()
+			return nil, pkgerrors.WithStack(err)
+		}
+		ch.confirms = amqpCh.NotifyPublish(make(chan amqp.Confirmation, 1))
+	}
+	return ch, nil
+}
+
+// release 归还channel
+func (c *Connection) release(ch *Channel) {
+	c.mutex.RLock()
+	reusable := !c.closed && ch.generation == c.generation && c.conn != nil
+	c.mutex.RUnlock()
+	if reusable && !ch.broken && !ch.ch.IsClosed() {
+		select {
+		case c.pool <- ch:
+			return
+		default:
+		}
+	}
+	_ = ch.ch.Close()
+}
+
+// drainPool 关闭池中的channel
+func (c *Connection) drainPool() {
+	for {
+		select {
+		case ch := <-c.pool:
+			_ = ch.ch.Close()
+		default:
+			return
+		}
+	}
+}
+
+// watch 监听链接断开并重连
+func (c *Connection) watch(conn AMQPConnection) {
+	defer close(c.doneChannel)
+	for {
+		closeNotify := conn.NotifyClose(make(chan *amqp.Error, 1))
+		select {
+		case <-c.closeChannel:
+			return
+		case amqpErr := <-closeNotify:
+			reason := "connection closed"
+			if amqpErr != nil {
+				reason = amqpErr.Error()
+			}
+			logpkg.Warnw(
+				"rabbitmq.status", "disconnected",
+				"rabbitmq.error", reason,
+			)
+		}
+		c.setDisconnected()
+
+		var ok bool
+		conn, ok = c.reconnect()
+		if !ok {
+			return
+		}
+	}
+}
+
+// reconnect 按退避间隔重连并重新声明拓扑；关闭时返回 false
+func (c *Connection) reconnect() (AMQPConnection, bool) {
+	backoff := c.opts.reconnectInitialBackoff
+	for attempt := 1; ; attempt++ {
+		select {
+		case <-c.closeChannel:
+			return nil, false
+		case <-time.After(backoff):
+		}
+
+		conn, err := c.dial()
+		if err == nil {
+			if err = c.redeclare(conn); err == nil {
+				c.mutex.RLock()
+				closed := c.closed
+				c.mutex.RUnlock()
+				if closed {
+					_ = conn.Close()
+					return nil, false
+				}
+				c.setConnected(conn)
+				logpkg.Infow(
+					"rabbitmq.status", "reconnected",
+					"rabbitmq.attempt", attempt,
+				)
+				return conn, true
+			}
+			_ = conn.Close()
+		}
+		logpkg.Warnw(
+			"rabbitmq.status", "reconnect failed",
+			"rabbitmq.attempt", attempt,
+			"rabbitmq.error", err.Error(),
+		)
+		if backoff *= 2; backoff > c.opts.reconnectMaxBackoff {
+			backoff = c.opts.reconnectMaxBackoff
+		}
+	}
+}
+
+// redeclare 重新声明拓扑
+func (c *Connection) redeclare(conn AMQPConnection) error {
+	c.mutex.RLock()
+	topologies := make([]*Topology, len(c.topologies))
+	copy(topologies, c.topologies)
+	c.mutex.RUnlock()
+	if len(topologies) == 0 {
+		return nil
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	defer func() { _ = ch.Close() }()
+	for i := range topologies {
+		if err = topologies[i].declare(ch); err != nil {
+			return pkgerrors.WithStack(err)
+		}
+	}
+	return nil
+}
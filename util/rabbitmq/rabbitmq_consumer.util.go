@@ -0,0 +1,594 @@
+package rabbitmqutil
+
+import (
+	"context"
+	strerrors "errors"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// DefaultConsumerConcurrency 每个队列同时处理的消息数量
+	DefaultConsumerConcurrency = 1
+	// DefaultConsumerMaxAttempts 消息最多处理次数；超过后进入死信队列
+	DefaultConsumerMaxAttempts = 3
+	// DefaultConsumerRetryInitialBackoff 首次重试的延迟
+	DefaultConsumerRetryInitialBackoff = time.Second
+	// DefaultConsumerRetryMaxBackoff 重试的最大延迟
+	DefaultConsumerRetryMaxBackoff = time.Minute
+
+	// HeaderRetryAttempt 已失败的次数
+	HeaderRetryAttempt = "x-retry-attempt"
+	// HeaderOriginalExchange 首次投递的交换机；重试后按此匹配处理函数
+	HeaderOriginalExchange = "x-original-exchange"
+	// HeaderOriginalRoutingKey 首次投递的路由键；重试后按此路由到处理函数
+	HeaderOriginalRoutingKey = "x-original-routing-key"
+	// HeaderLastError 最后一次失败的错误
+	HeaderLastError = "x-last-error"
+
+	// consumerPublishTimeout 发布到重试队列与死信队列的超时时间
+	consumerPublishTimeout = 10 * time.Second
+)
+
+var (
+	// ErrConsumerStarted 已启动
+	ErrConsumerStarted = strerrors.New("rabbitmq consumer already started")
+	// ErrNoHandler 没有匹配交换机与路由键的处理函数
+	ErrNoHandler = strerrors.New("rabbitmq consumer no handler")
+)
+
+// Handler 处理消息；返回错误时重试，Permanent 错误直接进入死信队列
+type Handler func(ctx context.Context, d *amqp.Delivery) error
+
+// Subscription 订阅；同一队列可按交换机与路由键注册多个处理函数，按注册顺序匹配
+type Subscription struct {
+	// Queue 队列；不存在时声明
+	Queue string
+	// Exchange 交换机；仅处理该交换机投递的消息；
+	// 为空时不绑定，处理直接发布到队列(默认交换机)的消息；交换机需先通过 Connection.DeclareTopology 声明
+	Exchange string
+	// RoutingKey 路由键；支持 topic 通配符 * 与 #；为空时匹配全部消息
+	RoutingKey string
+	Handler    Handler
+}
+
+// RetryQueueName 重试队列；第 attempt 次失败后进入，过期后回到原队列
+func RetryQueueName(queue string, attempt int) string {
+	return queue + ".retry." + strconv.Itoa(attempt)
+}
+
+// DeadLetterQueueName 死信队列
+func DeadLetterQueueName(queue string) string {
+	return queue + ".dlq"
+}
+
+// permanentError 不重试的错误
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent 不重试，直接进入死信队列；例：消息格式错误
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+// IsPermanent 是否不重试
+func IsPermanent(err error) bool {
+	var e *permanentError
+	return strerrors.As(err, &e)
+}
+
+// deliveryContextKey ...
+type deliveryContextKey struct{}
+
+// DeliveryFromContext 处理中的消息
+func DeliveryFromContext(ctx context.Context) (*amqp.Delivery, bool) {
+	d, ok := ctx.Value(deliveryContextKey{}).(*amqp.Delivery)
+	return d, ok
+}
+
+// consumerOptions 可选项
+type consumerOptions struct {
+	concurrency         int
+	maxAttempts         int
+	retryInitialBackoff time.Duration
+	retryMaxBackoff     time.Duration
+}
+
+// ConsumerOption 可选项
+type ConsumerOption func(*consumerOptions)
+
+// WithConsumerConcurrency 每个队列同时处理的消息数量
+func WithConsumerConcurrency(concurrency int) ConsumerOption {
+	return func(o *consumerOptions) {
+		o.concurrency = concurrency
+	}
+}
+
+// WithConsumerMaxAttempts 消息最多处理次数；超过后进入死信队列
+func WithConsumerMaxAttempts(maxAttempts int) ConsumerOption {
+	return func(o *consumerOptions) {
+		o.maxAttempts = maxAttempts
+	}
+}
+
+// WithConsumerRetryBackoff 重试延迟；每次重试后翻倍，直到 maxBackoff
+func WithConsumerRetryBackoff(initialBackoff, maxBackoff time.Duration) ConsumerOption {
+	return func(o *consumerOptions) {
+		o.retryInitialBackoff = initialBackoff
+		o.retryMaxBackoff = maxBackoff
+	}
+}
+
+// Consumer 消费者；
+// 失败的消息按退避延迟经重试队列回到原队列，超过最多处理次数后进入死信队列；
+// 重试队列为声明了 x-message-ttl 的队列，过期后经 x-dead-letter-exchange 回到原队列
+type Consumer struct {
+	conn *Connection
+	opts *consumerOptions
+
+	mutex      sync.Mutex
+	queues     map[string]*consumerQueue
+	queueNames []string
+	started    bool
+	stopped    bool
+}
+
+// NewConsumer 消费者
+func NewConsumer(conn *Connection, opts ...ConsumerOption) *Consumer {
+	consumerOpts := &consumerOptions{
+		concurrency:         DefaultConsumerConcurrency,
+		maxAttempts:         DefaultConsumerMaxAttempts,
+		retryInitialBackoff: DefaultConsumerRetryInitialBackoff,
+		retryMaxBackoff:     DefaultConsumerRetryMaxBackoff,
+	}
+	for i := range opts {
+		opts[i](consumerOpts)
+	}
+	if consumerOpts.concurrency <= 0 {
+		consumerOpts.concurrency = DefaultConsumerConcurrency
+	}
+	if consumerOpts.maxAttempts <= 0 {
+		consumerOpts.maxAttempts = DefaultConsumerMaxAttempts
+	}
+	if consumerOpts.retryInitialBackoff <= 0 {
+		consumerOpts.retryInitialBackoff = DefaultConsumerRetryInitialBackoff
+	}
+	if consumerOpts.retryMaxBackoff < consumerOpts.retryInitialBackoff {
+		consumerOpts.retryMaxBackoff = consumerOpts.retryInitialBackoff
+	}
+	return &Consumer{
+		conn:   conn,
+		opts:   consumerOpts,
+		queues: make(map[string]*consumerQueue),
+	}
+}
+
+// Handle 注册处理函数；需在 Start 之前调用
+func (c *Consumer) Handle(sub *Subscription) error {
+	if sub == nil || sub.Queue == "" || sub.Handler == nil {
+		return pkgerrors.New("rabbitmq consumer : 订阅需配置 Queue 与 Handler")
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.started {
+		return pkgerrors.WithStack(ErrConsumerStarted)
+	}
+	q, ok := c.queues[sub.Queue]
+	if !ok {
+		q = &consumerQueue{consumer: c, name: sub.Queue}
+		c.queues[sub.Queue] = q
+		c.queueNames = append(c.queueNames, sub.Queue)
+	}
+	for _, s := range q.subscriptions {
+		if s.Exchange == sub.Exchange && s.RoutingKey == sub.RoutingKey {
+			return pkgerrors.Errorf("rabbitmq consumer : 重复的订阅 ; queue = %s ; exchange = %s ; routing_key = %s", sub.Queue, sub.Exchange, sub.RoutingKey)
+		}
+	}
+	q.subscriptions = append(q.subscriptions, sub)
+	return nil
+}
+
+// Start 声明队列、重试队列、死信队列与绑定，开始消费
+func (c *Consumer) Start(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.started {
+		return pkgerrors.WithStack(ErrConsumerStarted)
+	}
+	if c.stopped {
+		return pkgerrors.WithStack(ErrClosed)
+	}
+	for _, name := range c.queueNames {
+		if err := c.conn.DeclareTopology(ctx, c.topology(c.queues[name])); err != nil {
+			return err
+		}
+	}
+	c.started = true
+	for _, name := range c.queueNames {
+		c.queues[name].start()
+	}
+	return nil
+}
+
+// Stop 停止消费并等待处理中的消息；ctx 超时后关闭channel，未确认的消息由服务端重新投递
+func (c *Consumer) Stop(ctx context.Context) error {
+	c.mutex.Lock()
+	if c.stopped {
+		c.mutex.Unlock()
+		return nil
+	}
+	c.stopped = true
+	started := c.started
+	queues := make([]*consumerQueue, 0, len(c.queueNames))
+	for _, name := range c.queueNames {
+		queues = append(queues, c.queues[name])
+	}
+	c.mutex.Unlock()
+	if !started {
+		return nil
+	}
+
+	for i := range queues {
+		queues[i].stop()
+	}
+	for i := range queues {
+		select {
+		case <-queues[i].done:
+		case <-ctx.Done():
+			for j := range queues {
+				queues[j].forceClose()
+			}
+			return pkgerrors.WithStack(ctx.Err())
+		}
+	}
+	return nil
+}
+
+// topology 队列、重试队列、死信队列与绑定
+func (c *Consumer) topology(q *consumerQueue) *Topology {
+	t := &Topology{
+		Queues: []*Queue{{Name: q.name, Durable: true}},
+	}
+	for attempt := 1; attempt < c.opts.maxAttempts; attempt++ {
+		t.Queues = append(t.Queues, &Queue{
+			Name:    RetryQueueName(q.name, attempt),
+			Durable: true,
+			Args: amqp.Table{
+				"x-message-ttl":             c.retryBackoff(attempt).Milliseconds(),
+				"x-dead-letter-exchange":    "",
+				"x-dead-letter-routing-key": q.name,
+			},
+		})
+	}
+	t.Queues = append(t.Queues, &Queue{Name: DeadLetterQueueName(q.name), Durable: true})
+	for _, sub := range q.subscriptions {
+		if sub.Exchange == "" {
+			continue
+		}
+		t.Bindings = append(t.Bindings, &Binding{Queue: q.name, Exchange: sub.Exchange, RoutingKey: sub.RoutingKey})
+	}
+	return t
+}
+
+// retryBackoff 第 attempt 次失败后的重试延迟
+func (c *Consumer) retryBackoff(attempt int) time.Duration {
+	backoff := c.opts.retryInitialBackoff
+	for i := 1; i < attempt; i++ {
+		if backoff *= 2; backoff >= c.opts.retryMaxBackoff {
+			return c.opts.retryMaxBackoff
+		}
+	}
+	return backoff
+}
+
+// consumerQueue 队列消费
+type consumerQueue struct {
+	consumer      *Consumer
+	name          string
+	subscriptions []*Subscription
+
+	mutex    sync.Mutex
+	ch       *Channel
+	tag      string
+	stopping bool
+	runCtx   context.Context
+	cancel   context.CancelFunc
+	done     chan struct{}
+}
+
+// start ...
+func (q *consumerQueue) start() {
+	q.runCtx, q.cancel = context.WithCancel(context.Background())
+	q.tag = q.name + "." + strconv.FormatInt(time.Now().UnixNano(), 36)
+	q.done = make(chan struct{})
+	go q.run()
+}
+
+// run 消费；channel 关闭后(例：重连)重新订阅
+func (q *consumerQueue) run() {
+	defer close(q.done)
+	backoff := q.consumer.conn.opts.reconnectInitialBackoff
+	for {
+		ch, deliveries, err := q.subscribe()
+		if err != nil {
+			if q.isStopping() || strerrors.Is(err, ErrClosed) {
+				return
+			}
+			logpkg.Warnw(
+				"rabbitmq.queue", q.name,
+				"rabbitmq.status", "subscribe failed",
+				"rabbitmq.error", err.Error(),
+			)
+			select {
+			case <-q.runCtx.Done():
+				return
+			case <-time.After(backoff):
+			}
+			continue
+		}
+		q.work(deliveries)
+		_ = ch.Close()
+		if q.isStopping() {
+			return
+		}
+	}
+}
+
+// subscribe 订阅队列
+func (q *consumerQueue) subscribe() (*Channel, <-chan amqp.Delivery, error) {
+	ch, err := q.consumer.conn.NewChannel(q.runCtx)
+	if err != nil {
+		return nil, nil, err
+	}
+	amqpCh := ch.AMQPChannel()
+	if err = amqpCh.Qos(q.consumer.opts.concurrency, 0, false); err != nil {
+		_ = ch.Close()
+		return nil, nil, pkgerrors.WithStack(err)
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.stopping {
+		_ = ch.Close()
+		return nil, nil, pkgerrors.WithStack(ErrClosed)
+	}
+	deliveries, err := amqpCh.Consume(q.name, q.tag, false, false, false, false, nil)
+	if err != nil {
+		_ = ch.Close()
+		return nil, nil, pkgerrors.WithStack(err)
+	}
+	q.ch = ch
+	return ch, deliveries, nil
+}
+
+// work 并发处理，直到取消消费或channel关闭
+func (q *consumerQueue) work(deliveries <-chan amqp.Delivery) {
+	var wg sync.WaitGroup
+	for i := 0; i < q.consumer.opts.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for d := range deliveries {
+				d := d
+				q.handle(&d)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// isStopping ...
+func (q *consumerQueue) isStopping() bool {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.stopping
+}
+
+// stop 取消消费；处理中的消息继续处理
+func (q *consumerQueue) stop() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.stopping = true
+	q.cancel()
+	if q.ch != nil {
+		_ = q.ch.AMQPChannel().Cancel(q.tag, false)
+	}
+}
+
+// forceClose 关闭channel
+func (q *consumerQueue) forceClose() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if q.ch != nil {
+		_ = q.ch.AMQPChannel().Close()
+	}
+}
+
+// handle 处理消息
+func (q *consumerQueue) handle(d *amqp.Delivery) {
+	connOpts := q.consumer.conn.opts
+	exchange, routingKey := originalExchange(d), originalRoutingKey(d)
+
+	ctx := connOpts.extractTraceContext(context.Background(), d.Headers)
+	ctx, span := connOpts.tracer().Start(ctx, q.name+" process",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("messaging.system", "rabbitmq"),
+			attribute.String("messaging.operation", "process"),
+			attribute.String("messaging.source.name", q.name),
+			attribute.String("messaging.rabbitmq.exchange", exchange),
+			attribute.String("messaging.rabbitmq.destination.routing_key", routingKey),
+			attribute.String("messaging.message.id", d.MessageId),
+			attribute.Int("messaging.rabbitmq.retry_attempt", retryAttempt(d.Headers)),
+		),
+	)
+	defer span.End()
+	ctx = context.WithValue(ctx, deliveryContextKey{}, d)
+
+	err := q.dispatch(ctx, exchange, routingKey, d)
+	if err == nil {
+		_ = d.Ack(false)
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	q.retry(ctx, d, err)
+}
+
+// dispatch 按交换机与路由键调用处理函数
+func (q *consumerQueue) dispatch(ctx context.Context, exchange, routingKey string, d *amqp.Delivery) (err error) {
+	var handler Handler
+	for _, sub := range q.subscriptions {
+		if sub.Exchange != exchange {
+			continue
+		}
+		if sub.RoutingKey == "" || topicMatch(sub.RoutingKey, routingKey) {
+			handler = sub.Handler
+			break
+		}
+	}
+	if handler == nil {
+		return Permanent(pkgerrors.WithMessagef(ErrNoHandler, "exchange = %s ; routing_key = %s", exchange, routingKey))
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = pkgerrors.Errorf("rabbitmq consumer : panic : %v", r)
+		}
+	}()
+	return handler(ctx, d)
+}
+
+// retry 发布到重试队列或死信队列后确认；发布失败时重新入队
+func (q *consumerQueue) retry(ctx context.Context, d *amqp.Delivery, handleErr error) {
+	attempt := retryAttempt(d.Headers) + 1
+	target := RetryQueueName(q.name, attempt)
+	if IsPermanent(handleErr) || attempt >= q.consumer.opts.maxAttempts {
+		target = DeadLetterQueueName(q.name)
+	}
+
+	headers := make(amqp.Table, len(d.Headers)+4)
+	for k, v := range d.Headers {
+		headers[k] = v
+	}
+	if _, ok := headers[HeaderOriginalRoutingKey]; !ok {
+		headers[HeaderOriginalExchange] = d.Exchange
+		headers[HeaderOriginalRoutingKey] = d.RoutingKey
+	}
+	headers[HeaderRetryAttempt] = int64(attempt)
+	headers[HeaderLastError] = handleErr.Error()
+	msg := amqp.Publishing{
+		Headers:         headers,
+		ContentType:     d.ContentType,
+		ContentEncoding: d.ContentEncoding,
+		DeliveryMode:    amqp.Persistent,
+		Priority:        d.Priority,
+		CorrelationId:   d.CorrelationId,
+		ReplyTo:         d.ReplyTo,
+		MessageId:       d.MessageId,
+		Timestamp:       d.Timestamp,
+		Type:            d.Type,
+		UserId:          d.UserId,
+		AppId:           d.AppId,
+		Body:            d.Body,
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, consumerPublishTimeout)
+	defer cancel()
+	if err := q.consumer.conn.Publish(publishCtx, "", target, msg); err != nil {
+		logpkg.Errorw(
+			"rabbitmq.queue", q.name,
+			"rabbitmq.status", "retry publish failed",
+			"rabbitmq.target", target,
+			"rabbitmq.error", err.Error(),
+		)
+		_ = d.Nack(false, true)
+		return
+	}
+	_ = d.Ack(false)
+	logpkg.Warnw(
+		"rabbitmq.queue", q.name,
+		"rabbitmq.status", "handle failed",
+		"rabbitmq.attempt", attempt,
+		"rabbitmq.target", target,
+		"rabbitmq.error", handleErr.Error(),
+	)
+}
+
+// originalExchange 首次投递的交换机
+func originalExchange(d *amqp.Delivery) string {
+	if _, ok := d.Headers[HeaderOriginalRoutingKey]; ok {
+		exchange, _ := d.Headers[HeaderOriginalExchange].(string)
+		return exchange
+	}
+	return d.Exchange
+}
+
+// originalRoutingKey 首次投递的路由键
+func originalRoutingKey(d *amqp.Delivery) string {
+	if key, ok := d.Headers[HeaderOriginalRoutingKey].(string); ok {
+		return key
+	}
+	return d.RoutingKey
+}
+
+// retryAttempt 已失败的次数
+func retryAttempt(headers amqp.Table) int {
+	switch v := headers[HeaderRetryAttempt].(type) {
+	case int:
+		return v
+	case int16:
+		return int(v)
+	case int32:
+		return int(v)
+	case int64:
+		return int(v)
+	case uint8:
+		return int(v)
+	case string:
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+	return 0
+}
+
+// topicMatch topic 路由；* 匹配一个单词，# 匹配零个或多个单词
+func topicMatch(pattern, routingKey string) bool {
+	return matchWords(strings.Split(pattern, "."), strings.Split(routingKey, "."))
+}
+
+// matchWords ...
+func matchWords(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if matchWords(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(words) > 0 && matchWords(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && matchWords(pattern[1:], words[1:])
+	}
+}
@@ -0,0 +1,384 @@
+package rabbitmqutil_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	rabbitmqutil "github.com/my-saas-platform/api-proto/util/rabbitmq"
+	"github.com/my-saas-platform/api-proto/util/rabbitmq/rabbitmqtest"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type testingEvent struct {
+	ID int `json:"id"`
+}
+
+func newTestingConsumer(t *testing.T, conn *rabbitmqutil.Connection, opts ...rabbitmqutil.ConsumerOption) *rabbitmqutil.Consumer {
+	opts = append([]rabbitmqutil.ConsumerOption{
+		rabbitmqutil.WithConsumerRetryBackoff(10*time.Millisecond, 20*time.Millisecond),
+	}, opts...)
+	consumer := rabbitmqutil.NewConsumer(conn, opts...)
+	t.Cleanup(func() { _ = consumer.Stop(context.Background()) })
+	return consumer
+}
+
+func newTestingEventsServer(t *testing.T) (*rabbitmqtest.Server, *rabbitmqutil.Connection) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	server := rabbitmqtest.NewServer()
+	server.RunExpiration(ctx)
+	conn := newTestingConnection(t, server)
+	require.NoError(t, conn.DeclareTopology(ctx, &rabbitmqutil.Topology{
+		Exchanges: []*rabbitmqutil.Exchange{{Name: "testing.events", Kind: amqp.ExchangeTopic, Durable: true}},
+	}))
+	return server, conn
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_Handle
+func TestConsumer_Handle(t *testing.T) {
+	ctx := context.Background()
+	server, conn := newTestingEventsServer(t)
+	consumer := newTestingConsumer(t, conn)
+
+	var (
+		created = make(chan *testingEvent, 1)
+		deleted = make(chan string, 1)
+	)
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.user", Exchange: "testing.events", RoutingKey: "user.created",
+		Handler: rabbitmqutil.JSONHandler(func(ctx context.Context, msg *testingEvent) error {
+			created <- msg
+			return nil
+		}),
+	}))
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.user", Exchange: "testing.events", RoutingKey: "user.*.deleted",
+		Handler: rabbitmqutil.ProtoHandler(func(ctx context.Context, msg *wrapperspb.StringValue) error {
+			d, ok := rabbitmqutil.DeliveryFromContext(ctx)
+			require.True(t, ok)
+			require.Equal(t, "user.admin.deleted", d.RoutingKey)
+			deleted <- msg.GetValue()
+			return nil
+		}),
+	}))
+	require.Error(t, consumer.Handle(&rabbitmqutil.Subscription{Queue: "testing.user", Exchange: "testing.events", RoutingKey: "user.created", Handler: func(ctx context.Context, d *amqp.Delivery) error { return nil }}))
+	// 相同的路由键：按交换机匹配
+	audited := make(chan string, 1)
+	require.NoError(t, conn.DeclareTopology(ctx, &rabbitmqutil.Topology{
+		Exchanges: []*rabbitmqutil.Exchange{{Name: "testing.audit", Kind: amqp.ExchangeTopic, Durable: true}},
+	}))
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.user", Exchange: "testing.audit", RoutingKey: "user.#",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			audited <- d.Exchange + ":" + d.RoutingKey
+			return nil
+		},
+	}))
+	require.NoError(t, consumer.Start(ctx))
+	require.ErrorIs(t, consumer.Start(ctx), rabbitmqutil.ErrConsumerStarted)
+	require.True(t, server.HasBinding("testing.user", "testing.events", "user.*.deleted"))
+	require.True(t, server.HasQueue(rabbitmqutil.RetryQueueName("testing.user", 2)))
+	require.False(t, server.HasQueue(rabbitmqutil.RetryQueueName("testing.user", 3)))
+	require.True(t, server.HasQueue(rabbitmqutil.DeadLetterQueueName("testing.user")))
+
+	require.NoError(t, conn.Publish(ctx, "testing.events", "user.created", amqp.Publishing{
+		ContentType: rabbitmqutil.ContentTypeJSON,
+		Body:        []byte(`{"id":1}`),
+	}))
+	body, err := proto.Marshal(wrapperspb.String("admin"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Publish(ctx, "testing.events", "user.admin.deleted", amqp.Publishing{
+		ContentType: rabbitmqutil.ContentTypeProtobuf,
+		Body:        body,
+	}))
+
+	select {
+	case msg := <-created:
+		require.Equal(t, 1, msg.ID)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	select {
+	case value := <-deleted:
+		require.Equal(t, "admin", value)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	require.NoError(t, conn.Publish(ctx, "testing.audit", "user.created", amqp.Publishing{Body: []byte("audit")}))
+	select {
+	case value := <-audited:
+		require.Equal(t, "testing.audit:user.created", value)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	require.Empty(t, created)
+	require.NoError(t, consumer.Stop(ctx))
+	require.Empty(t, server.Messages("testing.user"))
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_Retry
+func TestConsumer_Retry(t *testing.T) {
+	ctx := context.Background()
+	server, conn := newTestingEventsServer(t)
+	consumer := newTestingConsumer(t, conn, rabbitmqutil.WithConsumerMaxAttempts(3))
+
+	var (
+		failedCounter    int64
+		recoveredCounter int64
+	)
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.retry", Exchange: "testing.events", RoutingKey: "order.failed",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			atomic.AddInt64(&failedCounter, 1)
+			return errors.New("failed")
+		},
+	}))
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.retry", Exchange: "testing.events", RoutingKey: "order.recovered",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			if atomic.AddInt64(&recoveredCounter, 1) < 2 {
+				panic("recovered")
+			}
+			return nil
+		},
+	}))
+	require.NoError(t, consumer.Start(ctx))
+
+	require.NoError(t, conn.Publish(ctx, "testing.events", "order.failed", amqp.Publishing{MessageId: "failed", Body: []byte("failed")}))
+	require.NoError(t, conn.Publish(ctx, "testing.events", "order.recovered", amqp.Publishing{MessageId: "recovered", Body: []byte("recovered")}))
+
+	dlq := rabbitmqutil.DeadLetterQueueName("testing.retry")
+	require.Eventually(t, func() bool { return len(server.Messages(dlq)) == 1 }, 2*time.Second, 5*time.Millisecond)
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&recoveredCounter) == 2 }, 2*time.Second, 5*time.Millisecond)
+	require.Equal(t, int64(3), atomic.LoadInt64(&failedCounter))
+
+	dead := server.Messages(dlq)[0]
+	require.Equal(t, "failed", dead.MessageId)
+	require.Equal(t, int64(3), dead.Headers[rabbitmqutil.HeaderRetryAttempt])
+	require.Equal(t, "order.failed", dead.Headers[rabbitmqutil.HeaderOriginalRoutingKey])
+	require.Equal(t, "testing.events", dead.Headers[rabbitmqutil.HeaderOriginalExchange])
+	require.Equal(t, "failed", dead.Headers[rabbitmqutil.HeaderLastError])
+
+	// 重试延迟：10ms、20ms
+	var retried []string
+	for _, p := range server.Published() {
+		if p.Exchange == "" {
+			retried = append(retried, p.RoutingKey)
+		}
+	}
+	require.Contains(t, retried, rabbitmqutil.RetryQueueName("testing.retry", 1))
+	require.Contains(t, retried, rabbitmqutil.RetryQueueName("testing.retry", 2))
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_Permanent
+func TestConsumer_Permanent(t *testing.T) {
+	ctx := context.Background()
+	server, conn := newTestingEventsServer(t)
+	consumer := newTestingConsumer(t, conn)
+
+	var counter int64
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.permanent", Exchange: "testing.events", RoutingKey: "user.#",
+		Handler: rabbitmqutil.JSONHandler(func(ctx context.Context, msg *testingEvent) error {
+			atomic.AddInt64(&counter, 1)
+			return nil
+		}),
+	}))
+	require.NoError(t, consumer.Start(ctx))
+
+	// 解码失败
+	require.NoError(t, conn.Publish(ctx, "testing.events", "user.created", amqp.Publishing{Body: []byte("invalid")}))
+	dlq := rabbitmqutil.DeadLetterQueueName("testing.permanent")
+	require.Eventually(t, func() bool { return len(server.Messages(dlq)) == 1 }, time.Second, 5*time.Millisecond)
+	require.Equal(t, int64(1), server.Messages(dlq)[0].Headers[rabbitmqutil.HeaderRetryAttempt])
+	require.Equal(t, int64(0), atomic.LoadInt64(&counter))
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_Concurrency
+func TestConsumer_Concurrency(t *testing.T) {
+	ctx := context.Background()
+	_, conn := newTestingEventsServer(t)
+	consumer := newTestingConsumer(t, conn, rabbitmqutil.WithConsumerConcurrency(2))
+
+	var (
+		running, maxRunning, handled int64
+		release                      = make(chan struct{})
+	)
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.concurrency",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			n := atomic.AddInt64(&running, 1)
+			for {
+				m := atomic.LoadInt64(&maxRunning)
+				if n <= m || atomic.CompareAndSwapInt64(&maxRunning, m, n) {
+					break
+				}
+			}
+			<-release
+			atomic.AddInt64(&running, -1)
+			atomic.AddInt64(&handled, 1)
+			return nil
+		},
+	}))
+	require.NoError(t, consumer.Start(ctx))
+	for i := 0; i < 5; i++ {
+		require.NoError(t, conn.Publish(ctx, "", "testing.concurrency", amqp.Publishing{Body: []byte("concurrency")}))
+	}
+
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&running) == 2 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, int64(2), atomic.LoadInt64(&maxRunning))
+	close(release)
+	require.Eventually(t, func() bool { return atomic.LoadInt64(&handled) == 5 }, time.Second, time.Millisecond)
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_Stop
+func TestConsumer_Stop(t *testing.T) {
+	ctx := context.Background()
+	server, conn := newTestingEventsServer(t)
+	consumer := newTestingConsumer(t, conn)
+
+	var (
+		started = make(chan struct{})
+		once    sync.Once
+		handled int64
+	)
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.drain",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			once.Do(func() { close(started) })
+			time.Sleep(50 * time.Millisecond)
+			atomic.AddInt64(&handled, 1)
+			return nil
+		},
+	}))
+	require.NoError(t, consumer.Start(ctx))
+	require.NoError(t, conn.Publish(ctx, "", "testing.drain", amqp.Publishing{Body: []byte("drain")}))
+	<-started
+
+	// 等待处理中的消息
+	require.NoError(t, consumer.Stop(ctx))
+	require.Equal(t, int64(1), atomic.LoadInt64(&handled))
+	require.Empty(t, server.Messages("testing.drain"))
+
+	// 停止后不再消费
+	require.NoError(t, conn.Publish(ctx, "", "testing.drain", amqp.Publishing{Body: []byte("stopped")}))
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, int64(1), atomic.LoadInt64(&handled))
+	require.Len(t, server.Messages("testing.drain"), 1)
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_StopTimeout
+func TestConsumer_StopTimeout(t *testing.T) {
+	ctx := context.Background()
+	server, conn := newTestingEventsServer(t)
+	consumer := newTestingConsumer(t, conn)
+
+	var (
+		started = make(chan struct{})
+		release = make(chan struct{})
+	)
+	defer close(release)
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.timeout",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			close(started)
+			<-release
+			return nil
+		},
+	}))
+	require.NoError(t, consumer.Start(ctx))
+	require.NoError(t, conn.Publish(ctx, "", "testing.timeout", amqp.Publishing{Body: []byte("timeout")}))
+	<-started
+
+	// 超时后关闭channel，未确认的消息重新入队
+	stopCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, consumer.Stop(stopCtx), context.DeadlineExceeded)
+	require.Len(t, server.Messages("testing.timeout"), 1)
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_Reconnect
+func TestConsumer_Reconnect(t *testing.T) {
+	ctx := context.Background()
+	server, conn := newTestingEventsServer(t)
+	consumer := newTestingConsumer(t, conn)
+
+	handled := make(chan string, 2)
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.reconnect", Exchange: "testing.events", RoutingKey: "user.#",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			handled <- string(d.Body)
+			return nil
+		},
+	}))
+	require.NoError(t, consumer.Start(ctx))
+	require.NoError(t, conn.Publish(ctx, "testing.events", "user.created", amqp.Publishing{Body: []byte("before")}))
+	require.Equal(t, "before", <-handled)
+
+	server.Restart()
+	require.Eventually(t, func() bool {
+		return conn.IsConnected() && server.HasBinding("testing.reconnect", "testing.events", "user.#")
+	}, time.Second, time.Millisecond)
+	require.NoError(t, conn.Publish(ctx, "testing.events", "user.created", amqp.Publishing{Body: []byte("after")}))
+	select {
+	case body := <-handled:
+		require.Equal(t, "after", body)
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+}
+
+// go test -v ./util/rabbitmq/ -count=1 -test.run=TestConsumer_Tracing
+func TestConsumer_Tracing(t *testing.T) {
+	ctx := context.Background()
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	server := rabbitmqtest.NewServer()
+	conn := newTestingConnection(t, server, rabbitmqutil.WithTracerProvider(tp))
+	consumer := newTestingConsumer(t, conn)
+
+	handled := make(chan trace.SpanContext, 1)
+	require.NoError(t, consumer.Handle(&rabbitmqutil.Subscription{
+		Queue: "testing.tracing",
+		Handler: func(ctx context.Context, d *amqp.Delivery) error {
+			handled <- trace.SpanContextFromContext(ctx)
+			return nil
+		},
+	}))
+	require.NoError(t, consumer.Start(ctx))
+
+	publishCtx, span := tp.Tracer("testing").Start(ctx, "publish")
+	require.NoError(t, conn.Publish(publishCtx, "", "testing.tracing", amqp.Publishing{Body: []byte("tracing")}))
+	span.End()
+	require.Contains(t, server.Published()[0].Msg.Headers, "traceparent")
+
+	select {
+	case sc := <-handled:
+		require.Equal(t, span.SpanContext().TraceID(), sc.TraceID())
+		require.NotEqual(t, span.SpanContext().SpanID(), sc.SpanID())
+	case <-time.After(time.Second):
+		t.Fatal("timeout")
+	}
+	require.NoError(t, consumer.Stop(ctx))
+
+	var processSpan sdktrace.ReadOnlySpan
+	for _, s := range recorder.Ended() {
+		if s.Name() == "testing.tracing process" {
+			processSpan = s
+		}
+	}
+	require.NotNil(t, processSpan)
+	require.Equal(t, trace.SpanKindConsumer, processSpan.SpanKind())
+	require.Equal(t, span.SpanContext().SpanID(), processSpan.Parent().SpanID())
+}
@@ -0,0 +1,54 @@
+package rabbitmqutil
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+	amqp "github.com/rabbitmq/amqp091-go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// ContentTypeJSON json
+	ContentTypeJSON = "application/json"
+	// ContentTypeProtobuf protobuf
+	ContentTypeProtobuf = "application/x-protobuf"
+)
+
+// ProtoHandler protobuf 消息；ContentType 为 application/json 时使用 protojson 解码，其他使用 protobuf 解码；
+// 解码失败时直接进入死信队列
+func ProtoHandler[T proto.Message](fn func(ctx context.Context, msg T) error) Handler {
+	return func(ctx context.Context, d *amqp.Delivery) error {
+		var zero T
+		msg := zero.ProtoReflect().New().Interface().(T)
+		var err error
+		if isJSONContentType(d.ContentType) {
+			err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(d.Body, msg)
+		} else {
+			err = proto.Unmarshal(d.Body, msg)
+		}
+		if err != nil {
+			return Permanent(pkgerrors.WithMessage(err, "rabbitmq consumer : 解码 protobuf 消息失败"))
+		}
+		return fn(ctx, msg)
+	}
+}
+
+// JSONHandler json 消息；解码失败时直接进入死信队列
+func JSONHandler[T any](fn func(ctx context.Context, msg *T) error) Handler {
+	return func(ctx context.Context, d *amqp.Delivery) error {
+		msg := new(T)
+		if err := json.Unmarshal(d.Body, msg); err != nil {
+			return Permanent(pkgerrors.WithMessage(err, "rabbitmq consumer : 解码 json 消息失败"))
+		}
+		return fn(ctx, msg)
+	}
+}
+
+// isJSONContentType ...
+func isJSONContentType(contentType string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(contentType)), ContentTypeJSON)
+}
@@ -0,0 +1,77 @@
+package rabbitmqutil
+
+import (
+	"context"
+	"fmt"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName 追踪名称
+const tracerName = "github.com/my-saas-platform/api-proto/util/rabbitmq"
+
+var _ propagation.TextMapCarrier = headerCarrier{}
+
+// headerCarrier 消息头；传递追踪上下文
+type headerCarrier amqp.Table
+
+// Get ...
+func (c headerCarrier) Get(key string) string {
+	switch v := c[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Set ...
+func (c headerCarrier) Set(key, value string) {
+	c[key] = value
+}
+
+// Keys ...
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// tracer ...
+func (o *options) tracer() trace.Tracer {
+	tp := o.tracerProvider
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return tp.Tracer(tracerName)
+}
+
+// injectTraceContext 追踪上下文写入消息头；不修改原消息头
+func (o *options) injectTraceContext(ctx context.Context, msg *amqp.Publishing) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	headers := make(amqp.Table, len(msg.Headers)+2)
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	o.propagator.Inject(ctx, headerCarrier(headers))
+	msg.Headers = headers
+}
+
+// extractTraceContext 从消息头读取追踪上下文
+func (o *options) extractTraceContext(ctx context.Context, headers amqp.Table) context.Context {
+	if len(headers) == 0 {
+		return ctx
+	}
+	return o.propagator.Extract(ctx, headerCarrier(headers))
+}
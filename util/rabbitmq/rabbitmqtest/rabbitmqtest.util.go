@@ -0,0 +1,395 @@
+// Package rabbitmqtest 进程内的 rabbitmq 测试替身；
+// 支持交换机(direct、fanout、topic)、队列、绑定、发布确认、消费确认、死信与消息过期，
+// 可模拟服务端重启与拨号失败
+package rabbitmqtest
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	rabbitmqutil "github.com/my-saas-platform/api-proto/util/rabbitmq"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+var _ rabbitmqutil.Dialer = (*Server)(nil).Dial
+
+// Server 服务端
+type Server struct {
+	mutex     sync.Mutex
+	exchanges map[string]*exchange
+	queues    map[string]*queue
+	bindings  []*rabbitmqutil.Binding
+	conns     map[*Connection]struct{}
+	dialErr   error
+	dialCount int
+	nack      bool
+	published []*Published
+}
+
+// Published 发布记录
+type Published struct {
+	Exchange   string
+	RoutingKey string
+	Msg        amqp.Publishing
+}
+
+type exchange struct {
+	kind string
+}
+
+type queue struct {
+	name      string
+	args      amqp.Table
+	messages  []*message
+	consumers []*consumer
+	next      int
+}
+
+type message struct {
+	exchange    string
+	routingKey  string
+	msg         amqp.Publishing
+	enqueuedAt  time.Time
+	redelivered bool
+}
+
+// NewServer 服务端
+func NewServer() *Server {
+	return &Server{
+		exchanges: map[string]*exchange{"": {kind: amqp.ExchangeDirect}},
+		queues:    make(map[string]*queue),
+		conns:     make(map[*Connection]struct{}),
+	}
+}
+
+// Dial 实现 rabbitmqutil.Dialer
+func (s *Server) Dial(url string, config amqp.Config) (rabbitmqutil.AMQPConnection, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.dialCount++
+	if s.dialErr != nil {
+		return nil, s.dialErr
+	}
+	conn := &Connection{server: s}
+	s.conns[conn] = struct{}{}
+	return conn, nil
+}
+
+// SetDialError 拨号返回错误；nil 时恢复
+func (s *Server) SetDialError(err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.dialErr = err
+}
+
+// SetNack 拒绝确认发布
+func (s *Server) SetNack(nack bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.nack = nack
+}
+
+// DialCount 拨号次数
+func (s *Server) DialCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.dialCount
+}
+
+// Restart 模拟服务端重启：强制关闭全部链接并清空交换机、队列与绑定
+func (s *Server) Restart() {
+	s.mutex.Lock()
+	conns := make([]*Connection, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.exchanges = map[string]*exchange{"": {kind: amqp.ExchangeDirect}}
+	s.queues = make(map[string]*queue)
+	s.bindings = nil
+	s.mutex.Unlock()
+
+	for i := range conns {
+		conns[i].shutdown(&amqp.Error{Code: amqp.ConnectionForced, Reason: "CONNECTION_FORCED - broker restart", Server: true})
+	}
+}
+
+// HasExchange 交换机是否存在
+func (s *Server) HasExchange(name string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, ok := s.exchanges[name]
+	return ok
+}
+
+// HasQueue 队列是否存在
+func (s *Server) HasQueue(name string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	_, ok := s.queues[name]
+	return ok
+}
+
+// HasBinding 绑定是否存在
+func (s *Server) HasBinding(queueName, exchangeName, routingKey string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, b := range s.bindings {
+		if b.Queue == queueName && b.Exchange == exchangeName && b.RoutingKey == routingKey {
+			return true
+		}
+	}
+	return false
+}
+
+// Messages 队列中未投递的消息
+func (s *Server) Messages(queueName string) []amqp.Publishing {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	q, ok := s.queues[queueName]
+	if !ok {
+		return nil
+	}
+	s.expireLocked(q)
+	res := make([]amqp.Publishing, 0, len(q.messages))
+	for i := range q.messages {
+		res = append(res, q.messages[i].msg)
+	}
+	return res
+}
+
+// Published 全部发布记录
+func (s *Server) Published() []*Published {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]*Published, len(s.published))
+	copy(res, s.published)
+	return res
+}
+
+// ConnectionCount 打开的链接数量
+func (s *Server) ConnectionCount() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return len(s.conns)
+}
+
+// publishLocked 路由消息
+func (s *Server) publishLocked(exchangeName, routingKey string, msg amqp.Publishing) error {
+	ex, ok := s.exchanges[exchangeName]
+	if !ok {
+		return &amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no exchange '" + exchangeName + "'"}
+	}
+	s.published = append(s.published, &Published{Exchange: exchangeName, RoutingKey: routingKey, Msg: msg})
+
+	var targets []string
+	if exchangeName == "" {
+		targets = append(targets, routingKey)
+	}
+	for _, b := range s.bindings {
+		if b.Exchange != exchangeName {
+			continue
+		}
+		switch ex.kind {
+		case amqp.ExchangeFanout:
+			targets = append(targets, b.Queue)
+		case amqp.ExchangeTopic:
+			if topicMatch(b.RoutingKey, routingKey) {
+				targets = append(targets, b.Queue)
+			}
+		default:
+			if b.RoutingKey == routingKey {
+				targets = append(targets, b.Queue)
+			}
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range targets {
+		q, ok := s.queues[name]
+		if !ok || seen[name] {
+			continue
+		}
+		seen[name] = true
+		q.messages = append(q.messages, &message{
+			exchange:   exchangeName,
+			routingKey: routingKey,
+			msg:        msg,
+			enqueuedAt: time.Now(),
+		})
+		s.dispatchLocked(q)
+	}
+	return nil
+}
+
+// deadLetterLocked 死信；x-dead-letter-exchange、x-dead-letter-routing-key
+func (s *Server) deadLetterLocked(q *queue, m *message, reason string) {
+	dlx, ok := q.args["x-dead-letter-exchange"].(string)
+	if !ok {
+		return
+	}
+	routingKey := m.routingKey
+	if key, ok := q.args["x-dead-letter-routing-key"].(string); ok {
+		routingKey = key
+	}
+	msg := m.msg
+	headers := amqp.Table{}
+	for k, v := range msg.Headers {
+		headers[k] = v
+	}
+	var deaths []interface{}
+	if xDeath, ok := headers["x-death"].([]interface{}); ok {
+		deaths = xDeath
+	}
+	headers["x-death"] = append([]interface{}{amqp.Table{
+		"queue":        q.name,
+		"reason":       reason,
+		"exchange":     m.exchange,
+		"routing-keys": []interface{}{m.routingKey},
+		"count":        int64(len(deaths) + 1),
+	}}, deaths...)
+	headers["x-first-death-reason"] = reason
+	msg.Headers = headers
+	// 死信不再过期
+	msg.Expiration = ""
+	_ = s.publishLocked(dlx, routingKey, msg)
+}
+
+// expireLocked 过期；x-message-ttl、Publishing.Expiration
+func (s *Server) expireLocked(q *queue) {
+	now := time.Now()
+	kept := q.messages[:0]
+	var expired []*message
+	for _, m := range q.messages {
+		if ttl, ok := messageTTL(q, m); ok && !now.Before(m.enqueuedAt.Add(ttl)) {
+			expired = append(expired, m)
+			continue
+		}
+		kept = append(kept, m)
+	}
+	q.messages = kept
+	for _, m := range expired {
+		s.deadLetterLocked(q, m, "expired")
+	}
+}
+
+// nextExpiration 最近的过期时间
+func (s *Server) nextExpiration() (time.Time, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var (
+		next  time.Time
+		found bool
+	)
+	for _, q := range s.queues {
+		for _, m := range q.messages {
+			ttl, ok := messageTTL(q, m)
+			if !ok {
+				continue
+			}
+			if at := m.enqueuedAt.Add(ttl); !found || at.Before(next) {
+				next, found = at, true
+			}
+		}
+	}
+	return next, found
+}
+
+// Tick 处理已过期的消息；消息过期依赖调用 Tick 或 RunExpiration
+func (s *Server) Tick() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, q := range s.queues {
+		s.expireLocked(q)
+	}
+}
+
+// RunExpiration 后台处理过期消息，直到ctx取消
+func (s *Server) RunExpiration(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(5 * time.Millisecond)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if next, ok := s.nextExpiration(); ok && !time.Now().Before(next) {
+					s.Tick()
+				}
+			}
+		}
+	}()
+}
+
+// dispatchLocked 投递到消费者
+func (s *Server) dispatchLocked(q *queue) {
+	for len(q.messages) > 0 && len(q.consumers) > 0 {
+		var target *consumer
+		for i := 0; i < len(q.consumers); i++ {
+			c := q.consumers[(q.next+i)%len(q.consumers)]
+			if c.hasCapacity() {
+				target = c
+				q.next = (q.next + i + 1) % len(q.consumers)
+				break
+			}
+		}
+		if target == nil {
+			return
+		}
+		m := q.messages[0]
+		if !target.deliver(q, m) {
+			return
+		}
+		q.messages = q.messages[1:]
+	}
+}
+
+// removeConnLocked ...
+func (s *Server) removeConnLocked(conn *Connection) {
+	delete(s.conns, conn)
+}
+
+// messageTTL ...
+func messageTTL(q *queue, m *message) (time.Duration, bool) {
+	if m.msg.Expiration != "" {
+		if ms, err := strconv.ParseInt(m.msg.Expiration, 10, 64); err == nil {
+			return time.Duration(ms) * time.Millisecond, true
+		}
+	}
+	switch ttl := q.args["x-message-ttl"].(type) {
+	case int:
+		return time.Duration(ttl) * time.Millisecond, true
+	case int32:
+		return time.Duration(ttl) * time.Millisecond, true
+	case int64:
+		return time.Duration(ttl) * time.Millisecond, true
+	}
+	return 0, false
+}
+
+// topicMatch topic 路由；* 匹配一个单词，# 匹配零个或多个单词
+func topicMatch(pattern, routingKey string) bool {
+	return matchWords(strings.Split(pattern, "."), strings.Split(routingKey, "."))
+}
+
+func matchWords(pattern, words []string) bool {
+	if len(pattern) == 0 {
+		return len(words) == 0
+	}
+	switch pattern[0] {
+	case "#":
+		for i := 0; i <= len(words); i++ {
+			if matchWords(pattern[1:], words[i:]) {
+				return true
+			}
+		}
+		return false
+	case "*":
+		return len(words) > 0 && matchWords(pattern[1:], words[1:])
+	default:
+		return len(words) > 0 && pattern[0] == words[0] && matchWords(pattern[1:], words[1:])
+	}
+}
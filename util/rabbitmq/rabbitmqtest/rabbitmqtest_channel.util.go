@@ -0,0 +1,544 @@
+package rabbitmqtest
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	rabbitmqutil "github.com/my-saas-platform/api-proto/util/rabbitmq"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+var (
+	_ rabbitmqutil.AMQPConnection = (*Connection)(nil)
+	_ rabbitmqutil.AMQPChannel    = (*Channel)(nil)
+	_ amqp.Acknowledger           = (*Channel)(nil)
+)
+
+// consumerBuffer 消费者投递缓冲
+const consumerBuffer = 256
+
+// Connection 链接
+type Connection struct {
+	server    *Server
+	channels  []*Channel
+	notifies  []chan *amqp.Error
+	closed    bool
+	queueSeq  int
+	consumeID int
+}
+
+// Channel ...
+func (c *Connection) Channel() (rabbitmqutil.AMQPChannel, error) {
+	s := c.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		return nil, amqp.ErrClosed
+	}
+	ch := &Channel{
+		conn:    c,
+		unacked: make(map[uint64]*pending),
+	}
+	c.channels = append(c.channels, ch)
+	return ch, nil
+}
+
+// NotifyClose ...
+func (c *Connection) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	s := c.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		close(receiver)
+		return receiver
+	}
+	c.notifies = append(c.notifies, receiver)
+	return receiver
+}
+
+// IsClosed ...
+func (c *Connection) IsClosed() bool {
+	s := c.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return c.closed
+}
+
+// Close ...
+func (c *Connection) Close() error {
+	if c.IsClosed() {
+		return amqp.ErrClosed
+	}
+	c.shutdown(nil)
+	return nil
+}
+
+// shutdown 关闭链接；err 不为空时通知
+func (c *Connection) shutdown(err *amqp.Error) {
+	s := c.server
+	s.mutex.Lock()
+	if c.closed {
+		s.mutex.Unlock()
+		return
+	}
+	c.closed = true
+	s.removeConnLocked(c)
+	channels := c.channels
+	c.channels = nil
+	notifies := c.notifies
+	c.notifies = nil
+	var closers []func()
+	for i := range channels {
+		closers = append(closers, channels[i].shutdownLocked(err))
+	}
+	s.mutex.Unlock()
+
+	for i := range closers {
+		closers[i]()
+	}
+	notify(notifies, err)
+}
+
+// pending 未确认的消息
+type pending struct {
+	queue    *queue
+	message  *message
+	consumer *consumer
+}
+
+// consumer 消费者
+type consumer struct {
+	ch         *Channel
+	tag        string
+	autoAck    bool
+	deliveries chan amqp.Delivery
+	canceled   bool
+}
+
+// hasCapacity 是否可以投递
+func (c *consumer) hasCapacity() bool {
+	if c.canceled || c.ch.closed || len(c.deliveries) >= cap(c.deliveries) {
+		return false
+	}
+	return c.autoAck || c.ch.prefetchCount <= 0 || len(c.ch.unacked) < c.ch.prefetchCount
+}
+
+// deliver 投递
+func (c *consumer) deliver(q *queue, m *message) bool {
+	ch := c.ch
+	ch.deliveryTag++
+	msg := m.msg
+	delivery := amqp.Delivery{
+		Acknowledger:    ch,
+		Headers:         msg.Headers,
+		ContentType:     msg.ContentType,
+		ContentEncoding: msg.ContentEncoding,
+		DeliveryMode:    msg.DeliveryMode,
+		Priority:        msg.Priority,
+		CorrelationId:   msg.CorrelationId,
+		ReplyTo:         msg.ReplyTo,
+		Expiration:      msg.Expiration,
+		MessageId:       msg.MessageId,
+		Timestamp:       msg.Timestamp,
+		Type:            msg.Type,
+		UserId:          msg.UserId,
+		AppId:           msg.AppId,
+		ConsumerTag:     c.tag,
+		DeliveryTag:     ch.deliveryTag,
+		Redelivered:     m.redelivered,
+		Exchange:        m.exchange,
+		RoutingKey:      m.routingKey,
+		Body:            msg.Body,
+	}
+	select {
+	case c.deliveries <- delivery:
+	default:
+		ch.deliveryTag--
+		return false
+	}
+	if !c.autoAck {
+		ch.unacked[delivery.DeliveryTag] = &pending{queue: q, message: m, consumer: c}
+	}
+	return true
+}
+
+// Channel channel
+type Channel struct {
+	conn     *Connection
+	closed   bool
+	notifies []chan *amqp.Error
+	// confirmMutex 保证确认按顺序发送，且不会发送到已关闭的通道
+	confirmMutex  sync.Mutex
+	confirms      []chan amqp.Confirmation
+	confirmMode   bool
+	publishSeq    uint64
+	prefetchCount int
+	deliveryTag   uint64
+	consumers     []*consumer
+	unacked       map[uint64]*pending
+}
+
+// ExchangeDeclare ...
+func (c *Channel) ExchangeDeclare(name, kind string, durable, autoDelete, internal, noWait bool, args amqp.Table) error {
+	s := c.conn.server
+	s.mutex.Lock()
+	if c.closed {
+		s.mutex.Unlock()
+		return amqp.ErrClosed
+	}
+	if ex, ok := s.exchanges[name]; ok {
+		if ex.kind != kind {
+			return c.failLocked(&amqp.Error{Code: amqp.PreconditionFailed, Reason: "PRECONDITION_FAILED - inequivalent arg 'type' for exchange '" + name + "'"})
+		}
+		s.mutex.Unlock()
+		return nil
+	}
+	s.exchanges[name] = &exchange{kind: kind}
+	s.mutex.Unlock()
+	return nil
+}
+
+// QueueDeclare ...
+func (c *Channel) QueueDeclare(name string, durable, autoDelete, exclusive, noWait bool, args amqp.Table) (amqp.Queue, error) {
+	s := c.conn.server
+	s.mutex.Lock()
+	if c.closed {
+		s.mutex.Unlock()
+		return amqp.Queue{}, amqp.ErrClosed
+	}
+	if name == "" {
+		c.conn.queueSeq++
+		name = "amq.gen-" + strconv.Itoa(c.conn.queueSeq)
+	}
+	q, ok := s.queues[name]
+	if !ok {
+		q = &queue{name: name, args: args}
+		s.queues[name] = q
+	}
+	res := amqp.Queue{Name: name, Messages: len(q.messages), Consumers: len(q.consumers)}
+	s.mutex.Unlock()
+	return res, nil
+}
+
+// QueueBind ...
+func (c *Channel) QueueBind(name, key, exchangeName string, noWait bool, args amqp.Table) error {
+	s := c.conn.server
+	s.mutex.Lock()
+	if c.closed {
+		s.mutex.Unlock()
+		return amqp.ErrClosed
+	}
+	if _, ok := s.queues[name]; !ok {
+		return c.failLocked(&amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no queue '" + name + "'"})
+	}
+	if _, ok := s.exchanges[exchangeName]; !ok {
+		return c.failLocked(&amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no exchange '" + exchangeName + "'"})
+	}
+	for _, b := range s.bindings {
+		if b.Queue == name && b.Exchange == exchangeName && b.RoutingKey == key {
+			s.mutex.Unlock()
+			return nil
+		}
+	}
+	s.bindings = append(s.bindings, &rabbitmqutil.Binding{Queue: name, Exchange: exchangeName, RoutingKey: key, Args: args})
+	s.mutex.Unlock()
+	return nil
+}
+
+// Qos ...
+func (c *Channel) Qos(prefetchCount, prefetchSize int, global bool) error {
+	s := c.conn.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	c.prefetchCount = prefetchCount
+	return nil
+}
+
+// Confirm ...
+func (c *Channel) Confirm(noWait bool) error {
+	s := c.conn.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	c.confirmMode = true
+	return nil
+}
+
+// NotifyPublish ...
+func (c *Channel) NotifyPublish(confirm chan amqp.Confirmation) chan amqp.Confirmation {
+	s := c.conn.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		close(confirm)
+		return confirm
+	}
+	c.confirms = append(c.confirms, confirm)
+	return confirm
+}
+
+// NotifyClose ...
+func (c *Channel) NotifyClose(receiver chan *amqp.Error) chan *amqp.Error {
+	s := c.conn.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		close(receiver)
+		return receiver
+	}
+	c.notifies = append(c.notifies, receiver)
+	return receiver
+}
+
+// PublishWithContext 发布；交换机不存在时与服务端一致，异步关闭channel
+func (c *Channel) PublishWithContext(ctx context.Context, exchangeName, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+	s := c.conn.server
+	s.mutex.Lock()
+	if c.closed {
+		s.mutex.Unlock()
+		return amqp.ErrClosed
+	}
+	if err := s.publishLocked(exchangeName, key, msg); err != nil {
+		_ = c.failLocked(err.(*amqp.Error))
+		return nil
+	}
+	var (
+		confirms []chan amqp.Confirmation
+		confirm  amqp.Confirmation
+	)
+	if c.confirmMode {
+		c.publishSeq++
+		confirms = c.confirms
+		confirm = amqp.Confirmation{DeliveryTag: c.publishSeq, Ack: !s.nack}
+	}
+	c.confirmMutex.Lock()
+	s.mutex.Unlock()
+
+	defer c.confirmMutex.Unlock()
+	for i := range confirms {
+		confirms[i] <- confirm
+	}
+	return nil
+}
+
+// Consume ...
+func (c *Channel) Consume(queueName, consumerTag string, autoAck, exclusive, noLocal, noWait bool, args amqp.Table) (<-chan amqp.Delivery, error) {
+	s := c.conn.server
+	s.mutex.Lock()
+	if c.closed {
+		s.mutex.Unlock()
+		return nil, amqp.ErrClosed
+	}
+	q, ok := s.queues[queueName]
+	if !ok {
+		return nil, c.failLocked(&amqp.Error{Code: amqp.NotFound, Reason: "NOT_FOUND - no queue '" + queueName + "'"})
+	}
+	if consumerTag == "" {
+		c.conn.consumeID++
+		consumerTag = "ctag-" + strconv.Itoa(c.conn.consumeID)
+	}
+	cons := &consumer{
+		ch:         c,
+		tag:        consumerTag,
+		autoAck:    autoAck,
+		deliveries: make(chan amqp.Delivery, consumerBuffer),
+	}
+	c.consumers = append(c.consumers, cons)
+	q.consumers = append(q.consumers, cons)
+	s.dispatchLocked(q)
+	s.mutex.Unlock()
+	return cons.deliveries, nil
+}
+
+// Cancel 取消消费；未确认的消息保留到确认或channel关闭
+func (c *Channel) Cancel(consumerTag string, noWait bool) error {
+	s := c.conn.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	kept := c.consumers[:0]
+	for _, cons := range c.consumers {
+		if cons.tag != consumerTag {
+			kept = append(kept, cons)
+			continue
+		}
+		cons.cancelLocked(s)
+	}
+	c.consumers = kept
+	return nil
+}
+
+// IsClosed ...
+func (c *Channel) IsClosed() bool {
+	s := c.conn.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return c.closed
+}
+
+// Close ...
+func (c *Channel) Close() error {
+	s := c.conn.server
+	s.mutex.Lock()
+	if c.closed {
+		s.mutex.Unlock()
+		return amqp.ErrClosed
+	}
+	closer := c.shutdownLocked(nil)
+	s.mutex.Unlock()
+	closer()
+	return nil
+}
+
+// Ack 实现 amqp.Acknowledger
+func (c *Channel) Ack(tag uint64, multiple bool) error {
+	return c.settle(tag, multiple, func(s *Server, p *pending) {})
+}
+
+// Nack 实现 amqp.Acknowledger
+func (c *Channel) Nack(tag uint64, multiple bool, requeue bool) error {
+	return c.settle(tag, multiple, func(s *Server, p *pending) {
+		s.rejectLocked(p, requeue)
+	})
+}
+
+// Reject 实现 amqp.Acknowledger
+func (c *Channel) Reject(tag uint64, requeue bool) error {
+	return c.settle(tag, false, func(s *Server, p *pending) {
+		s.rejectLocked(p, requeue)
+	})
+}
+
+// settle 确认
+func (c *Channel) settle(tag uint64, multiple bool, fn func(s *Server, p *pending)) error {
+	s := c.conn.server
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if c.closed {
+		return amqp.ErrClosed
+	}
+	var settled []*pending
+	if multiple {
+		for t, p := range c.unacked {
+			if t <= tag {
+				settled = append(settled, p)
+				delete(c.unacked, t)
+			}
+		}
+	} else if p, ok := c.unacked[tag]; ok {
+		settled = append(settled, p)
+		delete(c.unacked, tag)
+	} else {
+		return &amqp.Error{Code: amqp.PreconditionFailed, Reason: "PRECONDITION_FAILED - unknown delivery tag " + strconv.FormatUint(tag, 10)}
+	}
+
+	queues := make(map[*queue]struct{})
+	for _, p := range settled {
+		fn(s, p)
+		queues[p.queue] = struct{}{}
+	}
+	for q := range queues {
+		s.dispatchLocked(q)
+	}
+	return nil
+}
+
+// failLocked 服务端关闭channel；调用前持有锁，返回时已释放
+func (c *Channel) failLocked(err *amqp.Error) error {
+	closer := c.shutdownLocked(err)
+	c.conn.server.mutex.Unlock()
+	closer()
+	return err
+}
+
+// shutdownLocked 关闭channel：未确认的消息重新入队；返回的函数在释放锁后发送通知
+func (c *Channel) shutdownLocked(err *amqp.Error) func() {
+	s := c.conn.server
+	if c.closed {
+		return func() {}
+	}
+	c.closed = true
+	for _, cons := range c.consumers {
+		cons.cancelLocked(s)
+	}
+	c.consumers = nil
+
+	queues := make(map[*queue]struct{})
+	for tag, p := range c.unacked {
+		s.rejectLocked(p, true)
+		queues[p.queue] = struct{}{}
+		delete(c.unacked, tag)
+	}
+	for q := range queues {
+		s.dispatchLocked(q)
+	}
+
+	notifies := c.notifies
+	c.notifies = nil
+	confirms := c.confirms
+	c.confirms = nil
+	return func() {
+		c.confirmMutex.Lock()
+		for i := range confirms {
+			close(confirms[i])
+		}
+		c.confirmMutex.Unlock()
+		notify(notifies, err)
+	}
+}
+
+// cancelLocked 取消消费者
+func (c *consumer) cancelLocked(s *Server) {
+	if c.canceled {
+		return
+	}
+	c.canceled = true
+	close(c.deliveries)
+	for _, q := range s.queues {
+		kept := q.consumers[:0]
+		for _, cons := range q.consumers {
+			if cons != c {
+				kept = append(kept, cons)
+			}
+		}
+		q.consumers = kept
+		if q.next >= len(q.consumers) {
+			q.next = 0
+		}
+	}
+}
+
+// rejectLocked 重新入队或死信
+func (s *Server) rejectLocked(p *pending, requeue bool) {
+	// 队列已删除(例：服务端重启)
+	if s.queues[p.queue.name] != p.queue {
+		return
+	}
+	if requeue {
+		p.message.redelivered = true
+		p.queue.messages = append([]*message{p.message}, p.queue.messages...)
+		return
+	}
+	s.deadLetterLocked(p.queue, p.message, "rejected")
+}
+
+// notify 发送关闭通知
+func notify(receivers []chan *amqp.Error, err *amqp.Error) {
+	for i := range receivers {
+		if err != nil {
+			select {
+			case receivers[i] <- err:
+			default:
+			}
+		}
+		close(receivers[i])
+	}
+}
@@ -0,0 +1,308 @@
+package ratelimitutil
+
+import (
+	"context"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// DefaultKeyPrefix redis键前缀
+	DefaultKeyPrefix = "ratelimit:"
+	// DefaultWindow 窗口
+	DefaultWindow = time.Second
+	// DefaultBreakerBackoff redis 失败后跳过 redis 的时间；连续失败时加倍
+	DefaultBreakerBackoff = time.Second
+	// DefaultBreakerMaxBackoff 跳过 redis 的最长时间
+	DefaultBreakerMaxBackoff = 30 * time.Second
+
+	// AlgorithmFixedWindow 固定窗口
+	AlgorithmFixedWindow = "fixed_window"
+	// AlgorithmSlidingWindow 滑动窗口；记录窗口内每个请求的时间
+	AlgorithmSlidingWindow = "sliding_window"
+	// AlgorithmTokenBucket 令牌桶；每个窗口补充 limit 个令牌，容量为 burst
+	AlgorithmTokenBucket = "token_bucket"
+)
+
+var (
+	// ErrUnknownAlgorithm 未知的算法
+	ErrUnknownAlgorithm = pkgerrors.New("ratelimit : unknown algorithm")
+)
+
+var (
+	// _fixedWindowScript 固定窗口；返回 {allowed, remaining, retry_after_ms}
+	_fixedWindowScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local count = redis.call("INCR", KEYS[1])
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl < 0 then
+	redis.call("PEXPIRE", KEYS[1], window)
+	ttl = window
+end
+if count > limit then
+	return {0, 0, ttl}
+end
+return {1, limit - count, 0}
+`)
+	// _slidingWindowScript 滑动窗口；返回 {allowed, remaining, retry_after_ms}
+	_slidingWindowScript = redis.NewScript(`
+local limit = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+local count = redis.call("ZCARD", KEYS[1])
+if count >= limit then
+	local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+	local retry = window
+	if oldest[2] then
+		retry = tonumber(oldest[2]) + window - now
+	end
+	return {0, 0, retry}
+end
+redis.call("ZADD", KEYS[1], now, ARGV[4])
+redis.call("PEXPIRE", KEYS[1], window)
+return {1, limit - count - 1, 0}
+`)
+	// _tokenBucketScript 令牌桶；返回 {allowed, remaining, retry_after_ms}
+	_tokenBucketScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local state = redis.call("HMGET", KEYS[1], "tokens", "ts")
+local tokens = tonumber(state[1]) or capacity
+local ts = tonumber(state[2]) or now
+if now > ts then
+	tokens = math.min(capacity, tokens + (now - ts) * rate)
+end
+local allowed = 0
+local retry = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry = math.ceil((1 - tokens) / rate)
+end
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "ts", now)
+redis.call("PEXPIRE", KEYS[1], math.max(1, math.ceil(capacity / rate)))
+return {allowed, math.floor(tokens), retry}
+`)
+)
+
+// Rule 限流规则
+type Rule struct {
+	// Algorithm 算法；默认 AlgorithmFixedWindow
+	Algorithm string
+	// Limit 窗口内允许的请求数；令牌桶为每个窗口补充的令牌数；0 时不限流
+	Limit int64
+	// Window 窗口；默认 DefaultWindow
+	Window time.Duration
+	// Burst 令牌桶的容量；默认 Limit
+	Burst int64
+}
+
+// normalize 默认值
+func (r Rule) normalize() Rule {
+	if r.Algorithm == "" {
+		r.Algorithm = AlgorithmFixedWindow
+	}
+	if r.Window <= 0 {
+		r.Window = DefaultWindow
+	}
+	if r.Burst <= 0 {
+		r.Burst = r.Limit
+	}
+	return r
+}
+
+// Result 限流结果
+type Result struct {
+	Allowed bool
+	// Limit 窗口内允许的请求数；令牌桶为容量
+	Limit     int64
+	Remaining int64
+	// RetryAfter 被拒绝时，下次可能允许的等待时间
+	RetryAfter time.Duration
+}
+
+// options 可选项
+type options struct {
+	keyPrefix         string
+	breakerBackoff    time.Duration
+	breakerMaxBackoff time.Duration
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithKeyPrefix redis键前缀；例：go-srv-saas:DEVELOP:user-service:ratelimit:
+func WithKeyPrefix(keyPrefix string) Option {
+	return func(o *options) {
+		o.keyPrefix = keyPrefix
+	}
+}
+
+// WithBreaker redis 失败后跳过 redis 的时间：backoff，连续失败时加倍，最长 maxBackoff；
+// 默认 DefaultBreakerBackoff、DefaultBreakerMaxBackoff
+func WithBreaker(backoff, maxBackoff time.Duration) Option {
+	return func(o *options) {
+		if backoff > 0 {
+			o.breakerBackoff = backoff
+		}
+		if maxBackoff > 0 {
+			o.breakerMaxBackoff = maxBackoff
+		}
+	}
+}
+
+// Limiter 限流；redis 不可用时使用进程内限流(每个副本单独计数)
+type Limiter struct {
+	redisCC redis.UniversalClient
+	opts    *options
+	local   *localLimiter
+	// now 当前时间；测试时替换
+	now func() time.Time
+
+	// memberPrefix 滑动窗口成员的前缀；与 sequence 组成唯一的成员
+	memberPrefix string
+	sequence     atomic.Uint64
+	// fallback 正在使用进程内限流
+	fallback atomic.Bool
+	// breakerUntil 熔断：此时间前(UnixNano)跳过 redis；breakerFailures 连续失败次数
+	breakerUntil    atomic.Int64
+	breakerFailures atomic.Int32
+}
+
+// NewLimiter 限流；redisCC 为 nil 时仅使用进程内限流
+func NewLimiter(redisCC redis.UniversalClient, opts ...Option) *Limiter {
+	limiterOpts := &options{
+		keyPrefix:         DefaultKeyPrefix,
+		breakerBackoff:    DefaultBreakerBackoff,
+		breakerMaxBackoff: DefaultBreakerMaxBackoff,
+	}
+	for i := range opts {
+		opts[i](limiterOpts)
+	}
+	limiterOpts.breakerMaxBackoff = max(limiterOpts.breakerMaxBackoff, limiterOpts.breakerBackoff)
+	return &Limiter{
+		redisCC:      redisCC,
+		opts:         limiterOpts,
+		local:        newLocalLimiter(),
+		now:          time.Now,
+		memberPrefix: uuid.NewString(),
+	}
+}
+
+// Allow 请求一次；key 为限流的对象；例：/saas.api.ping.servicev1.SrvPingV1/Ping:ip:127.0.0.1
+func (l *Limiter) Allow(ctx context.Context, key string, rule Rule) (*Result, error) {
+	rule = rule.normalize()
+	if rule.Limit <= 0 {
+		return &Result{Allowed: true}, nil
+	}
+	switch rule.Algorithm {
+	case AlgorithmFixedWindow, AlgorithmSlidingWindow, AlgorithmTokenBucket:
+	default:
+		return nil, pkgerrors.WithMessage(ErrUnknownAlgorithm, rule.Algorithm)
+	}
+	// 键包含算法；配置变更算法时不与原有的数据类型冲突
+	key = rule.Algorithm + ":" + key
+
+	now := l.now()
+	if l.redisCC == nil || !l.breakerAllow(now) {
+		return l.local.allow(key, rule, now), nil
+	}
+	result, err := l.allowRemote(ctx, key, rule, now)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, pkgerrors.WithStack(ctx.Err())
+		}
+		backoff := l.breakerOpen(now)
+		if !l.fallback.Swap(true) {
+			logpkg.Warnw(
+				"ratelimit.status", "redis unavailable, fallback to local",
+				"ratelimit.error", err.Error(),
+				"ratelimit.backoff", backoff.String(),
+			)
+		}
+		return l.local.allow(key, rule, now), nil
+	}
+	l.breakerClose()
+	if l.fallback.Swap(false) {
+		logpkg.Warnw("ratelimit.status", "redis recovered")
+	}
+	return result, nil
+}
+
+// breakerAllow 熔断：是否使用 redis；熔断到期后仅一个请求尝试 redis，其他请求在尝试期间使用进程内限流
+func (l *Limiter) breakerAllow(now time.Time) bool {
+	until := l.breakerUntil.Load()
+	if until == 0 {
+		return true
+	}
+	if now.UnixNano() < until {
+		return false
+	}
+	return l.breakerUntil.CompareAndSwap(until, now.Add(l.opts.breakerBackoff).UnixNano())
+}
+
+// breakerOpen 熔断：redis 失败后跳过 redis；连续失败时加倍
+func (l *Limiter) breakerOpen(now time.Time) time.Duration {
+	failures := l.breakerFailures.Add(1)
+	backoff := l.opts.breakerBackoff
+	for i := int32(1); i < failures && backoff < l.opts.breakerMaxBackoff; i++ {
+		backoff *= 2
+	}
+	backoff = min(backoff, l.opts.breakerMaxBackoff)
+	l.breakerUntil.Store(now.Add(backoff).UnixNano())
+	return backoff
+}
+
+// breakerClose 熔断：redis 恢复
+func (l *Limiter) breakerClose() {
+	if l.breakerUntil.Load() != 0 {
+		l.breakerUntil.Store(0)
+		l.breakerFailures.Store(0)
+	}
+}
+
+// allowRemote redis
+func (l *Limiter) allowRemote(ctx context.Context, key string, rule Rule, now time.Time) (*Result, error) {
+	var (
+		redisKey = l.opts.keyPrefix + key
+		windowMs = rule.Window.Milliseconds()
+		nowMs    = now.UnixMilli()
+		result   = &Result{Limit: rule.Limit}
+		values   []int64
+		err      error
+	)
+	if windowMs <= 0 {
+		windowMs = 1
+	}
+	switch rule.Algorithm {
+	case AlgorithmSlidingWindow:
+		member := l.memberPrefix + ":" + strconv.FormatUint(l.sequence.Add(1), 10)
+		values, err = _slidingWindowScript.Run(ctx, l.redisCC, []string{redisKey}, rule.Limit, windowMs, nowMs, member).Int64Slice()
+	case AlgorithmTokenBucket:
+		result.Limit = rule.Burst
+		rate := float64(rule.Limit) / float64(windowMs)
+		values, err = _tokenBucketScript.Run(ctx, l.redisCC, []string{redisKey}, rule.Burst, strconv.FormatFloat(rate, 'f', -1, 64), nowMs).Int64Slice()
+	default:
+		values, err = _fixedWindowScript.Run(ctx, l.redisCC, []string{redisKey}, rule.Limit, windowMs).Int64Slice()
+	}
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	if len(values) != 3 {
+		return nil, pkgerrors.Errorf("ratelimit : unexpected script result %v", values)
+	}
+	result.Allowed = values[0] == 1
+	result.Remaining = values[1]
+	result.RetryAfter = time.Duration(values[2]) * time.Millisecond
+	return result, nil
+}
@@ -0,0 +1,177 @@
+package ratelimitutil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestingLimiter(t *testing.T, server *miniredis.Miniredis) *Limiter {
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+	return NewLimiter(client, WithKeyPrefix("testing:ratelimit:"))
+}
+
+// testingClock 可控的时间
+type testingClock struct {
+	now time.Time
+}
+
+func (c *testingClock) Now() time.Time {
+	return c.now
+}
+
+// go test -v ./util/ratelimit/ -count=1 -test.run=TestLimiter_Allow
+func TestLimiter_Allow(t *testing.T) {
+	tests := []struct {
+		name string
+		rule Rule
+		// remaining 依次允许的请求的剩余数
+		remaining []int64
+		// retryAfter 第一次拒绝的等待时间
+		retryAfter time.Duration
+		// advance 经过的时间；之后允许一个请求
+		advance time.Duration
+	}{
+		{
+			name:       "#fixed_window",
+			rule:       Rule{Limit: 3, Window: time.Second},
+			remaining:  []int64{2, 1, 0},
+			retryAfter: time.Second,
+			advance:    time.Second,
+		},
+		{
+			name:       "#sliding_window",
+			rule:       Rule{Algorithm: AlgorithmSlidingWindow, Limit: 3, Window: time.Second},
+			remaining:  []int64{2, 1, 0},
+			retryAfter: time.Second,
+			advance:    time.Second,
+		},
+		{
+			name:       "#token_bucket",
+			rule:       Rule{Algorithm: AlgorithmTokenBucket, Limit: 2, Window: time.Second, Burst: 3},
+			remaining:  []int64{2, 1, 0},
+			retryAfter: 500 * time.Millisecond,
+			advance:    500 * time.Millisecond,
+		},
+	}
+	ctx := context.Background()
+	for _, tt := range tests {
+		for _, tier := range []string{"redis", "local"} {
+			t.Run(tt.name+"#"+tier, func(t *testing.T) {
+				server := miniredis.RunT(t)
+				limiter := newTestingLimiter(t, server)
+				if tier == "local" {
+					limiter = NewLimiter(nil)
+				}
+				clock := &testingClock{now: time.UnixMilli(time.Now().UnixMilli())}
+				limiter.now = clock.Now
+
+				for _, remaining := range tt.remaining {
+					result, err := limiter.Allow(ctx, "testing", tt.rule)
+					require.NoError(t, err)
+					require.True(t, result.Allowed)
+					require.Equal(t, remaining, result.Remaining)
+				}
+				result, err := limiter.Allow(ctx, "testing", tt.rule)
+				require.NoError(t, err)
+				require.False(t, result.Allowed)
+				require.InDelta(t, tt.retryAfter, result.RetryAfter, float64(10*time.Millisecond))
+
+				// 其他键不受影响
+				result, err = limiter.Allow(ctx, "testing-other", tt.rule)
+				require.NoError(t, err)
+				require.True(t, result.Allowed)
+
+				clock.now = clock.now.Add(tt.advance)
+				server.FastForward(tt.advance)
+				result, err = limiter.Allow(ctx, "testing", tt.rule)
+				require.NoError(t, err)
+				require.True(t, result.Allowed)
+			})
+		}
+	}
+}
+
+// go test -v ./util/ratelimit/ -count=1 -test.run=TestLimiter_Fallback
+func TestLimiter_Fallback(t *testing.T) {
+	server := miniredis.RunT(t)
+	limiter := newTestingLimiter(t, server)
+	ctx := context.Background()
+	rule := Rule{Limit: 2, Window: time.Minute}
+
+	result, err := limiter.Allow(ctx, "testing", rule)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), result.Remaining)
+	require.True(t, server.Exists("testing:ratelimit:fixed_window:testing"))
+
+	// redis 不可用：进程内限流
+	server.Close()
+	for i := 0; i < 2; i++ {
+		result, err = limiter.Allow(ctx, "testing", rule)
+		require.NoError(t, err)
+		require.True(t, result.Allowed)
+	}
+	result, err = limiter.Allow(ctx, "testing", rule)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.True(t, limiter.fallback.Load())
+
+	// 熔断：失败后跳过 redis
+	require.Equal(t, int32(1), limiter.breakerFailures.Load())
+	require.NoError(t, server.Restart())
+	result, err = limiter.Allow(ctx, "testing", rule)
+	require.NoError(t, err)
+	require.False(t, result.Allowed)
+	require.True(t, limiter.fallback.Load())
+
+	// 恢复：熔断到期后继续使用 redis 的计数
+	now := time.Now().Add(DefaultBreakerBackoff)
+	limiter.now = func() time.Time { return now }
+	result, err = limiter.Allow(ctx, "testing", rule)
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+	require.Equal(t, int64(0), result.Remaining)
+	require.False(t, limiter.fallback.Load())
+	require.Equal(t, int32(0), limiter.breakerFailures.Load())
+}
+
+// go test -v ./util/ratelimit/ -count=1 -test.run=TestLimiter_Breaker
+func TestLimiter_Breaker(t *testing.T) {
+	server := miniredis.RunT(t)
+	limiter := newTestingLimiter(t, server)
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+	ctx := context.Background()
+	rule := Rule{Limit: 100, Window: time.Minute}
+
+	// 连续失败：加倍，最长 DefaultBreakerMaxBackoff
+	server.Close()
+	for _, backoff := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 16 * time.Second, 30 * time.Second, 30 * time.Second} {
+		_, err := limiter.Allow(ctx, "testing", rule)
+		require.NoError(t, err)
+		require.Equal(t, now.Add(backoff).UnixNano(), limiter.breakerUntil.Load())
+		// 熔断期间不尝试 redis
+		_, err = limiter.Allow(ctx, "testing", rule)
+		require.NoError(t, err)
+		require.Equal(t, now.Add(backoff).UnixNano(), limiter.breakerUntil.Load())
+		now = now.Add(backoff)
+	}
+}
+
+// go test -v ./util/ratelimit/ -count=1 -test.run=TestLimiter_Rule
+func TestLimiter_Rule(t *testing.T) {
+	limiter := NewLimiter(nil)
+	ctx := context.Background()
+
+	result, err := limiter.Allow(ctx, "testing", Rule{})
+	require.NoError(t, err)
+	require.True(t, result.Allowed)
+
+	_, err = limiter.Allow(ctx, "testing", Rule{Algorithm: "testing", Limit: 1})
+	require.ErrorIs(t, err, ErrUnknownAlgorithm)
+}
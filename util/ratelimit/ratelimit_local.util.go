@@ -0,0 +1,126 @@
+package ratelimitutil
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// localSweepInterval 清理过期状态的间隔
+	localSweepInterval = time.Minute
+)
+
+// localState 进程内的限流状态
+type localState struct {
+	// 固定窗口
+	count   int64
+	resetAt time.Time
+	// 滑动窗口
+	requests []time.Time
+	// 令牌桶
+	tokens float64
+	ts     time.Time
+
+	expiresAt time.Time
+}
+
+// localLimiter 进程内限流
+type localLimiter struct {
+	mutex     sync.Mutex
+	states    map[string]*localState
+	nextSweep time.Time
+}
+
+// newLocalLimiter ...
+func newLocalLimiter() *localLimiter {
+	return &localLimiter{
+		states: make(map[string]*localState),
+	}
+}
+
+// allow ...
+func (l *localLimiter) allow(key string, rule Rule, now time.Time) *Result {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.sweep(now)
+	state, ok := l.states[key]
+	if !ok || !now.Before(state.expiresAt) {
+		state = &localState{}
+		l.states[key] = state
+	}
+
+	switch rule.Algorithm {
+	case AlgorithmSlidingWindow:
+		return state.slidingWindow(rule, now)
+	case AlgorithmTokenBucket:
+		return state.tokenBucket(rule, now)
+	default:
+		return state.fixedWindow(rule, now)
+	}
+}
+
+// sweep 清理过期状态
+func (l *localLimiter) sweep(now time.Time) {
+	if now.Before(l.nextSweep) {
+		return
+	}
+	l.nextSweep = now.Add(localSweepInterval)
+	for key, state := range l.states {
+		if !now.Before(state.expiresAt) {
+			delete(l.states, key)
+		}
+	}
+}
+
+// fixedWindow 固定窗口
+func (s *localState) fixedWindow(rule Rule, now time.Time) *Result {
+	if !now.Before(s.resetAt) {
+		s.count = 0
+		s.resetAt = now.Add(rule.Window)
+	}
+	s.count++
+	s.expiresAt = s.resetAt
+	if s.count > rule.Limit {
+		return &Result{Limit: rule.Limit, RetryAfter: s.resetAt.Sub(now)}
+	}
+	return &Result{Allowed: true, Limit: rule.Limit, Remaining: rule.Limit - s.count}
+}
+
+// slidingWindow 滑动窗口
+func (s *localState) slidingWindow(rule Rule, now time.Time) *Result {
+	windowStart := now.Add(-rule.Window)
+	i := 0
+	for i < len(s.requests) && !s.requests[i].After(windowStart) {
+		i++
+	}
+	s.requests = s.requests[i:]
+	if int64(len(s.requests)) >= rule.Limit {
+		return &Result{Limit: rule.Limit, RetryAfter: s.requests[0].Add(rule.Window).Sub(now)}
+	}
+	s.requests = append(s.requests, now)
+	s.expiresAt = now.Add(rule.Window)
+	return &Result{Allowed: true, Limit: rule.Limit, Remaining: rule.Limit - int64(len(s.requests))}
+}
+
+// tokenBucket 令牌桶
+func (s *localState) tokenBucket(rule Rule, now time.Time) *Result {
+	rate := float64(rule.Limit) / float64(rule.Window) // 每纳秒补充的令牌
+	capacity := float64(rule.Burst)
+	if s.ts.IsZero() {
+		s.tokens = capacity
+		s.ts = now
+	}
+	if now.After(s.ts) {
+		s.tokens = math.Min(capacity, s.tokens+float64(now.Sub(s.ts))*rate)
+		s.ts = now
+	}
+	s.expiresAt = now.Add(time.Duration(capacity / rate))
+	if s.tokens < 1 {
+		retryAfter := time.Duration(math.Ceil((1 - s.tokens) / rate))
+		return &Result{Limit: rule.Burst, RetryAfter: retryAfter}
+	}
+	s.tokens--
+	return &Result{Allowed: true, Limit: rule.Burst, Remaining: int64(s.tokens)}
+}
@@ -0,0 +1,397 @@
+package scheduleutil
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	// DefaultTimeout 任务默认超时时间
+	DefaultTimeout = time.Minute
+	// DefaultHistorySize 每个任务保留的运行记录数量
+	DefaultHistorySize = 100
+	// DefaultStopTimeout 停止时等待运行中任务的时间
+	DefaultStopTimeout = 30 * time.Second
+
+	// RunStatusSuccess 成功
+	RunStatusSuccess = "success"
+	// RunStatusFailed 失败
+	RunStatusFailed = "failed"
+	// RunStatusTimeout 超时
+	RunStatusTimeout = "timeout"
+	// RunStatusPanic 发生Panic
+	RunStatusPanic = "panic"
+)
+
+var (
+	// _parser cron表达式；秒可选；例：*/5 * * * *、0 */5 * * * *、@every 10s
+	_parser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+)
+
+// TaskFunc 任务函数；ctx 在超时或停止时取消
+type TaskFunc func(ctx context.Context) error
+
+// Task 定时任务
+type Task struct {
+	// Name 名称；唯一
+	Name string
+	// Spec cron表达式；秒可选；例：*/5 * * * *、0 */5 * * * *
+	// 注意：@every 按各副本的启动时间计算，不保证仅一个副本运行
+	Spec string
+	// Timeout 单次运行的超时时间；默认 DefaultTimeout
+	Timeout time.Duration
+	// Run 任务函数
+	Run TaskFunc
+}
+
+// RunRecord 运行记录
+type RunRecord struct {
+	TaskName   string        `json:"task_name"`
+	Tick       time.Time     `json:"tick"`
+	StartedAt  time.Time     `json:"started_at"`
+	FinishedAt time.Time     `json:"finished_at"`
+	Duration   time.Duration `json:"duration"`
+	Status     string        `json:"status"`
+	Error      string        `json:"error,omitempty"`
+	// Hostname 运行的副本
+	Hostname string `json:"hostname"`
+}
+
+// TaskStatus 任务状态
+type TaskStatus struct {
+	Name    string
+	Spec    string
+	Timeout time.Duration
+	// Next 下次运行时间
+	Next time.Time
+	// LastRun 最后一次运行记录；可能由其他副本运行
+	LastRun *RunRecord
+}
+
+// options 可选项
+type options struct {
+	keyPrefix   string
+	historySize int64
+	stopTimeout time.Duration
+	location    *time.Location
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithKeyPrefix redis键前缀；例：go-srv-saas:DEVELOP:user-service:
+func WithKeyPrefix(keyPrefix string) Option {
+	return func(o *options) {
+		o.keyPrefix = keyPrefix
+	}
+}
+
+// WithHistorySize 每个任务保留的运行记录数量
+func WithHistorySize(historySize int64) Option {
+	return func(o *options) {
+		o.historySize = historySize
+	}
+}
+
+// WithStopTimeout 停止时等待运行中任务的时间；超时后取消任务的ctx
+func WithStopTimeout(stopTimeout time.Duration) Option {
+	return func(o *options) {
+		o.stopTimeout = stopTimeout
+	}
+}
+
+// WithLocation cron表达式的时区
+func WithLocation(location *time.Location) Option {
+	return func(o *options) {
+		o.location = location
+	}
+}
+
+// scheduledTask 已注册的任务
+type scheduledTask struct {
+	task     *Task
+	schedule cron.Schedule
+	entryID  cron.EntryID
+}
+
+// Scheduler 分布式定时任务；
+// 每次触发时以 redis SET NX 竞争锁，同一触发时间仅一个副本运行
+type Scheduler struct {
+	redisCC  redis.UniversalClient
+	opts     *options
+	cron     *cron.Cron
+	hostname string
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mutex sync.RWMutex
+	tasks map[string]*scheduledTask
+
+	stopOnce sync.Once
+}
+
+// NewScheduler 定时任务
+func NewScheduler(redisCC redis.UniversalClient, opts ...Option) *Scheduler {
+	schedulerOpts := &options{
+		historySize: DefaultHistorySize,
+		stopTimeout: DefaultStopTimeout,
+		location:    time.Local,
+	}
+	for i := range opts {
+		opts[i](schedulerOpts)
+	}
+	hostname, _ := os.Hostname()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Scheduler{
+		redisCC:  redisCC,
+		opts:     schedulerOpts,
+		cron:     cron.New(cron.WithParser(_parser), cron.WithLocation(schedulerOpts.location)),
+		hostname: hostname,
+		ctx:      ctx,
+		cancel:   cancel,
+		tasks:    make(map[string]*scheduledTask),
+	}
+}
+
+// AddTask 注册任务；启动前后均可注册
+func (s *Scheduler) AddTask(task *Task) error {
+	if task.Name == "" {
+		return pkgerrors.New("schedule : 未设置任务名称")
+	}
+	if task.Run == nil {
+		return pkgerrors.New("schedule : 未设置任务函数 : " + task.Name)
+	}
+	schedule, err := _parser.Parse(task.Spec)
+	if err != nil {
+		return pkgerrors.WithMessage(err, "schedule : 无效的cron表达式 : "+task.Name)
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.tasks[task.Name]; ok {
+		return pkgerrors.New("schedule : 重复的任务名称 : " + task.Name)
+	}
+	st := &scheduledTask{
+		task:     task,
+		schedule: schedule,
+	}
+	st.entryID = s.cron.Schedule(schedule, cron.FuncJob(func() {
+		tick, ok := s.scheduledTick(st)
+		if !ok {
+			logpkg.Warnw(
+				"schedule.task", st.task.Name,
+				"schedule.error", "scheduled tick not found",
+			)
+			return
+		}
+		s.runTick(st, tick)
+	}))
+	s.tasks[task.Name] = st
+	return nil
+}
+
+// Start 启动
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+// Stop 停止；等待运行中的任务，超过 stopTimeout 后取消任务的ctx
+func (s *Scheduler) Stop() {
+	s.stopOnce.Do(func() {
+		stopCtx := s.cron.Stop()
+		select {
+		case <-stopCtx.Done():
+		case <-time.After(s.opts.stopTimeout):
+			s.cancel()
+			<-stopCtx.Done()
+		}
+		s.cancel()
+	})
+}
+
+// Tasks 任务状态
+func (s *Scheduler) Tasks(ctx context.Context) ([]*TaskStatus, error) {
+	s.mutex.RLock()
+	tasks := make([]*scheduledTask, 0, len(s.tasks))
+	for _, st := range s.tasks {
+		tasks = append(tasks, st)
+	}
+	s.mutex.RUnlock()
+	sort.Slice(tasks, func(i, j int) bool {
+		return tasks[i].task.Name < tasks[j].task.Name
+	})
+
+	res := make([]*TaskStatus, 0, len(tasks))
+	for i := range tasks {
+		status := &TaskStatus{
+			Name:    tasks[i].task.Name,
+			Spec:    tasks[i].task.Spec,
+			Timeout: s.taskTimeout(tasks[i].task),
+			Next:    s.cron.Entry(tasks[i].entryID).Next,
+		}
+		history, err := s.History(ctx, tasks[i].task.Name, 1)
+		if err != nil {
+			return nil, err
+		}
+		if len(history) > 0 {
+			status.LastRun = history[0]
+		}
+		res = append(res, status)
+	}
+	return res, nil
+}
+
+// History 运行记录；按时间倒序；limit <= 0 时返回全部保留的记录
+func (s *Scheduler) History(ctx context.Context, taskName string, limit int64) ([]*RunRecord, error) {
+	stop := limit - 1
+	if limit <= 0 {
+		stop = -1
+	}
+	values, err := s.redisCC.LRange(ctx, s.historyKey(taskName), 0, stop).Result()
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	res := make([]*RunRecord, 0, len(values))
+	for i := range values {
+		record := &RunRecord{}
+		if err = json.Unmarshal([]byte(values[i]), record); err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		res = append(res, record)
+	}
+	return res, nil
+}
+
+// runTick 一次触发；以计划时间 tick 竞争锁，成功后运行
+func (s *Scheduler) runTick(st *scheduledTask, tick time.Time) {
+	timeout := s.taskTimeout(st.task)
+
+	// 锁不主动释放，过期前同一触发时间的其他副本无法获取
+	lockTTL := timeout + time.Minute
+	locked, err := s.redisCC.SetNX(s.ctx, s.lockKey(st.task.Name, tick), s.hostname, lockTTL).Result()
+	if err != nil {
+		logpkg.Errorw(
+			"schedule.task", st.task.Name,
+			"schedule.tick", tick.Format(time.RFC3339),
+			"schedule.error", "lock failed : "+err.Error(),
+		)
+		return
+	}
+	if !locked {
+		return
+	}
+
+	record := s.run(st.task, tick, timeout)
+	if record.Status != RunStatusSuccess {
+		logpkg.Errorw(
+			"schedule.task", record.TaskName,
+			"schedule.tick", record.Tick.Format(time.RFC3339),
+			"schedule.status", record.Status,
+			"schedule.error", record.Error,
+		)
+	}
+	if err = s.saveRecord(record); err != nil {
+		logpkg.Errorw(
+			"schedule.task", record.TaskName,
+			"schedule.error", "save record failed : "+err.Error(),
+		)
+	}
+}
+
+// run 运行任务；超时与Panic
+func (s *Scheduler) run(task *Task, tick time.Time, timeout time.Duration) (record *RunRecord) {
+	ctx, cancel := context.WithTimeout(s.ctx, timeout)
+	defer cancel()
+
+	record = &RunRecord{
+		TaskName:  task.Name,
+		Tick:      tick,
+		StartedAt: time.Now(),
+		Hostname:  s.hostname,
+	}
+	defer func() {
+		if panicRecover := recover(); panicRecover != nil {
+			record.Status = RunStatusPanic
+			record.Error = fmt.Sprintf("%v\n%s", panicRecover, debug.Stack())
+		}
+		record.FinishedAt = time.Now()
+		record.Duration = record.FinishedAt.Sub(record.StartedAt)
+	}()
+
+	err := task.Run(ctx)
+	switch {
+	case err == nil:
+		record.Status = RunStatusSuccess
+	case pkgerrors.Is(err, context.DeadlineExceeded) || pkgerrors.Is(ctx.Err(), context.DeadlineExceeded):
+		record.Status = RunStatusTimeout
+		record.Error = err.Error()
+	default:
+		record.Status = RunStatusFailed
+		record.Error = err.Error()
+	}
+	return record
+}
+
+// saveRecord 保存运行记录
+func (s *Scheduler) saveRecord(record *RunRecord) error {
+	buf, err := json.Marshal(record)
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	key := s.historyKey(record.TaskName)
+	_, err = s.redisCC.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, buf)
+		pipe.LTrim(ctx, key, 0, s.opts.historySize-1)
+		return nil
+	})
+	return pkgerrors.WithStack(err)
+}
+
+// taskTimeout 超时时间
+func (s *Scheduler) taskTimeout(task *Task) time.Duration {
+	if task.Timeout > 0 {
+		return task.Timeout
+	}
+	return DefaultTimeout
+}
+
+// lockKey 锁
+func (s *Scheduler) lockKey(taskName string, tick time.Time) string {
+	return s.opts.keyPrefix + "schedule:lock:" + taskName + ":" + strconv.FormatInt(tick.Unix(), 10)
+}
+
+// historyKey 运行记录
+func (s *Scheduler) historyKey(taskName string) string {
+	return s.opts.keyPrefix + "schedule:history:" + taskName
+}
+
+// scheduledTick 本次触发的计划时间：cron 的 Entry.Prev；
+// 不使用当前时间，副本的调度延迟不影响锁，同一触发时间仅一个副本运行
+func (s *Scheduler) scheduledTick(st *scheduledTask) (time.Time, bool) {
+	s.mutex.RLock()
+	entryID := st.entryID
+	s.mutex.RUnlock()
+
+	tick := s.cron.Entry(entryID).Prev
+	if tick.IsZero() {
+		return time.Time{}, false
+	}
+	return tick.In(s.opts.location), true
+}
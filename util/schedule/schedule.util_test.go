@@ -0,0 +1,166 @@
+package scheduleutil
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/robfig/cron/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestingRedis(t *testing.T) redis.UniversalClient {
+	mr := miniredis.RunT(t)
+	redisCC := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = redisCC.Close() })
+	return redisCC
+}
+
+// go test -v ./util/schedule/ -count=1 -test.run=TestScheduler_AddTask
+func TestScheduler_AddTask(t *testing.T) {
+	s := NewScheduler(newTestingRedis(t))
+	run := func(ctx context.Context) error { return nil }
+
+	require.NoError(t, s.AddTask(&Task{Name: "minutely", Spec: "* * * * *", Run: run}))
+	require.NoError(t, s.AddTask(&Task{Name: "secondly", Spec: "*/5 * * * * *", Run: run}))
+	require.Error(t, s.AddTask(&Task{Name: "minutely", Spec: "* * * * *", Run: run}))
+	require.Error(t, s.AddTask(&Task{Name: "invalid", Spec: "* * *", Run: run}))
+	require.Error(t, s.AddTask(&Task{Name: "no_run", Spec: "* * * * *"}))
+	require.Error(t, s.AddTask(&Task{Spec: "* * * * *", Run: run}))
+}
+
+// go test -v ./util/schedule/ -count=1 -test.run=TestScheduler_RunTick
+func TestScheduler_RunTick(t *testing.T) {
+	ctx := context.Background()
+	redisCC := newTestingRedis(t)
+	s1 := NewScheduler(redisCC, WithKeyPrefix("testing:"), WithHistorySize(2))
+	s2 := NewScheduler(redisCC, WithKeyPrefix("testing:"), WithHistorySize(2))
+
+	var counter int64
+	tasks := map[string]*Task{
+		"counter": {Name: "counter", Spec: "0 * * * * *", Run: func(ctx context.Context) error {
+			atomic.AddInt64(&counter, 1)
+			return nil
+		}},
+		"failed": {Name: "failed", Spec: "0 * * * * *", Run: func(ctx context.Context) error {
+			return errors.New("failed")
+		}},
+		"panic": {Name: "panic", Spec: "0 * * * * *", Run: func(ctx context.Context) error {
+			panic("panic")
+		}},
+		"timeout": {Name: "timeout", Spec: "0 * * * * *", Timeout: 10 * time.Millisecond, Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	}
+	for _, task := range tasks {
+		require.NoError(t, s1.AddTask(task))
+		require.NoError(t, s2.AddTask(task))
+	}
+
+	// 同一触发时间仅一个副本运行
+	tick := time.Date(2024, 1, 1, 0, 1, 0, 0, time.Local)
+	for i := 0; i < 2; i++ {
+		s1.runTick(s1.tasks["counter"], tick)
+		s2.runTick(s2.tasks["counter"], tick)
+	}
+	require.Equal(t, int64(1), atomic.LoadInt64(&counter))
+	s2.runTick(s2.tasks["counter"], tick.Add(time.Minute))
+	s2.runTick(s2.tasks["counter"], tick.Add(2*time.Minute))
+	require.Equal(t, int64(3), atomic.LoadInt64(&counter))
+
+	// 运行记录
+	history, err := s1.History(ctx, "counter", 0)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	require.Equal(t, tick.Add(2*time.Minute).Unix(), history[0].Tick.Unix())
+	require.Equal(t, RunStatusSuccess, history[0].Status)
+
+	// 失败、Panic、超时
+	for name, status := range map[string]string{"failed": RunStatusFailed, "panic": RunStatusPanic, "timeout": RunStatusTimeout} {
+		s1.runTick(s1.tasks[name], tick)
+		history, err = s2.History(ctx, name, 1)
+		require.NoError(t, err)
+		require.Len(t, history, 1)
+		require.Equal(t, status, history[0].Status, name)
+		require.NotEmpty(t, history[0].Error)
+	}
+
+	// 任务状态
+	statuses, err := s2.Tasks(ctx)
+	require.NoError(t, err)
+	require.Len(t, statuses, 4)
+	require.Equal(t, "counter", statuses[0].Name)
+	require.NotNil(t, statuses[0].LastRun)
+}
+
+// go test -v ./util/schedule/ -count=1 -test.run=TestScheduler_Skew
+func TestScheduler_Skew(t *testing.T) {
+	ctx := context.Background()
+	redisCC := newTestingRedis(t)
+	s1 := NewScheduler(redisCC, WithKeyPrefix("testing:"))
+	s2 := NewScheduler(redisCC, WithKeyPrefix("testing:"))
+	// s2 的任务延迟超过1秒运行
+	s2.cron = cron.New(cron.WithParser(_parser), cron.WithLocation(s2.opts.location), cron.WithChain(func(job cron.Job) cron.Job {
+		return cron.FuncJob(func() {
+			time.Sleep(1500 * time.Millisecond)
+			job.Run()
+		})
+	}))
+
+	var counter int64
+	task := &Task{Name: "counter", Spec: "*/2 * * * * *", Run: func(ctx context.Context) error {
+		atomic.AddInt64(&counter, 1)
+		return nil
+	}}
+	require.NoError(t, s1.AddTask(task))
+	require.NoError(t, s2.AddTask(task))
+	s1.Start()
+	s2.Start()
+	time.Sleep(4500 * time.Millisecond)
+	s1.Stop()
+	s2.Stop()
+
+	// 同一触发时间仅运行一次；触发时间为计划时间
+	history, err := s1.History(ctx, "counter", 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, history)
+	require.Len(t, history, int(atomic.LoadInt64(&counter)))
+	ticks := make(map[int64]bool)
+	for i := range history {
+		require.Zero(t, history[i].Tick.Unix()%2, history[i].Tick)
+		require.False(t, ticks[history[i].Tick.Unix()], history[i].Tick)
+		ticks[history[i].Tick.Unix()] = true
+	}
+}
+
+// go test -v ./util/schedule/ -count=1 -test.run=TestScheduler_Stop
+func TestScheduler_Stop(t *testing.T) {
+	s := NewScheduler(newTestingRedis(t), WithStopTimeout(50*time.Millisecond))
+	var (
+		started     = make(chan struct{})
+		startedOnce sync.Once
+		canceled    int64
+	)
+	require.NoError(t, s.AddTask(&Task{Name: "blocking", Spec: "* * * * * *", Run: func(ctx context.Context) error {
+		startedOnce.Do(func() { close(started) })
+		<-ctx.Done()
+		atomic.StoreInt64(&canceled, 1)
+		return ctx.Err()
+	}}))
+	s.Start()
+	select {
+	case <-started:
+	case <-time.After(3 * time.Second):
+		t.Fatal("task not started")
+	}
+
+	// 超过 stopTimeout 后取消运行中的任务
+	s.Stop()
+	require.Equal(t, int64(1), atomic.LoadInt64(&canceled))
+}
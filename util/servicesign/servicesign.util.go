@@ -0,0 +1,271 @@
+package servicesignutil
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// SignatureVersion 签名的版本
+	SignatureVersion = "SERVICE-SIGN-V1"
+
+	// HeaderService 调用方的服务名称
+	HeaderService = "X-Service-Name"
+	// HeaderTimestamp 签名的时间；毫秒
+	HeaderTimestamp = "X-Service-Timestamp"
+	// HeaderNonce 随机数；有效时间内拒绝重复的请求
+	HeaderNonce = "X-Service-Nonce"
+	// HeaderBodyDigest 请求消息的摘要：SHA-256(hex)
+	HeaderBodyDigest = "X-Service-Body-Digest"
+	// HeaderSignature 签名(base64)
+	HeaderSignature = "X-Service-Signature"
+
+	// DefaultReplayWindow 时间戳的有效时间
+	DefaultReplayWindow = 5 * time.Minute
+	// DefaultKeyPrefix 随机数的 redis 键前缀
+	DefaultKeyPrefix = "service_sign:"
+)
+
+var (
+	// ErrMissingSignature 未签名
+	ErrMissingSignature = pkgerrors.New("service sign : missing signature")
+	// ErrUntrustedService 调用方不在 trusted_public_keys 中
+	ErrUntrustedService = pkgerrors.New("service sign : untrusted service")
+	// ErrInvalidSignature 签名或摘要错误
+	ErrInvalidSignature = pkgerrors.New("service sign : invalid signature")
+	// ErrExpired 时间戳超出有效时间
+	ErrExpired = pkgerrors.New("service sign : timestamp expired")
+	// ErrReplayed 重复的请求
+	ErrReplayed = pkgerrors.New("service sign : replayed request")
+	// ErrBodyUnavailable 服务端未获取到发送的请求消息；grpc 服务需配置 GRPCServerOption
+	ErrBodyUnavailable = pkgerrors.New("service sign : request body unavailable")
+)
+
+// Header 请求头；transport.Header
+type Header interface {
+	Get(key string) string
+	Set(key, value string)
+}
+
+// Request 签名的请求
+type Request struct {
+	// Audience 被调用方的服务名称；仅用于签名，服务端使用 NewVerifier 的服务名称
+	Audience string
+	// Method http 方法；grpc 为空
+	Method string
+	// Path http 路径；grpc 为 operation
+	Path string
+	// Query http 查询参数(URL.RawQuery)；grpc 为空
+	Query string
+	// Body 发送的请求消息；http 为请求体，grpc 为 protobuf 编码(见 Codec)
+	Body []byte
+}
+
+// BodyDigest 请求消息的摘要：SHA-256(hex)；使用发送的字节，不重新编码，与双方的消息定义无关
+func BodyDigest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// canonical 签名的内容
+func canonical(service, audience, timestamp, nonce, digest string, req *Request) []byte {
+	return []byte(strings.Join([]string{
+		SignatureVersion,
+		service,
+		audience,
+		strings.ToUpper(req.Method),
+		req.Path,
+		req.Query,
+		timestamp,
+		nonce,
+		digest,
+	}, "\n"))
+}
+
+// Signer 调用方签名
+type Signer struct {
+	service string
+	signer  crypto.Signer
+	now     func() time.Time
+}
+
+// NewSigner 调用方签名；service 为本服务的名称 app.server_name
+func NewSigner(service, privateKeyPEM string) (*Signer, error) {
+	if service == "" {
+		return nil, pkgerrors.New("service sign : service name is empty")
+	}
+	signer, err := ParsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return &Signer{
+		service: service,
+		signer:  signer,
+		now:     time.Now,
+	}, nil
+}
+
+// Service 本服务的名称
+func (s *Signer) Service() string {
+	return s.service
+}
+
+// Sign 签名，并设置请求头；req.Audience 为被调用方的服务名称，签名仅对该服务有效
+func (s *Signer) Sign(header Header, req *Request) error {
+	if req.Audience == "" {
+		return pkgerrors.New("service sign : audience is empty")
+	}
+	digest := BodyDigest(req.Body)
+	nonceBytes := make([]byte, 16)
+	if _, err := rand.Read(nonceBytes); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	var (
+		timestamp = strconv.FormatInt(s.now().UnixMilli(), 10)
+		nonce     = hex.EncodeToString(nonceBytes)
+	)
+	signature, err := sign(s.signer, canonical(s.service, req.Audience, timestamp, nonce, digest, req))
+	if err != nil {
+		return err
+	}
+	header.Set(HeaderService, s.service)
+	header.Set(HeaderTimestamp, timestamp)
+	header.Set(HeaderNonce, nonce)
+	header.Set(HeaderBodyDigest, digest)
+	header.Set(HeaderSignature, base64.StdEncoding.EncodeToString(signature))
+	return nil
+}
+
+// options 可选项
+type options struct {
+	replayWindow time.Duration
+	nonceStore   NonceStore
+}
+
+// Option 可选项
+type Option func(*options)
+
+// WithReplayWindow 时间戳的有效时间；默认 DefaultReplayWindow
+func WithReplayWindow(window time.Duration) Option {
+	return func(o *options) {
+		if window > 0 {
+			o.replayWindow = window
+		}
+	}
+}
+
+// WithNonceStore 随机数存储；默认 NewMemoryNonceStore
+func WithNonceStore(store NonceStore) Option {
+	return func(o *options) {
+		o.nonceStore = store
+	}
+}
+
+// Verifier 服务端验证签名
+type Verifier struct {
+	service    string
+	publicKeys map[string]crypto.PublicKey
+	opts       *options
+	now        func() time.Time
+}
+
+// NewVerifier 服务端验证签名；service 为本服务的名称 app.server_name，仅接受签名给本服务的请求；
+// trustedPublicKeys 的 key 为调用方的服务名称
+func NewVerifier(service string, trustedPublicKeys map[string]string, opts ...Option) (*Verifier, error) {
+	if service == "" {
+		return nil, pkgerrors.New("service sign : service name is empty")
+	}
+	verifierOpts := &options{
+		replayWindow: DefaultReplayWindow,
+	}
+	for i := range opts {
+		opts[i](verifierOpts)
+	}
+	if verifierOpts.nonceStore == nil {
+		verifierOpts.nonceStore = NewMemoryNonceStore()
+	}
+	publicKeys := make(map[string]crypto.PublicKey, len(trustedPublicKeys))
+	for service, publicKeyPEM := range trustedPublicKeys {
+		publicKey, err := ParsePublicKey(publicKeyPEM)
+		if err != nil {
+			return nil, pkgerrors.WithMessage(err, service)
+		}
+		publicKeys[service] = publicKey
+	}
+	return &Verifier{
+		service:    service,
+		publicKeys: publicKeys,
+		opts:       verifierOpts,
+		now:        time.Now,
+	}, nil
+}
+
+// Verify 验证签名、摘要、时间戳与随机数；返回调用方的服务名称
+func (v *Verifier) Verify(ctx context.Context, header Header, req *Request) (string, error) {
+	var (
+		service   = header.Get(HeaderService)
+		timestamp = header.Get(HeaderTimestamp)
+		nonce     = header.Get(HeaderNonce)
+		digest    = header.Get(HeaderBodyDigest)
+		encoded   = header.Get(HeaderSignature)
+	)
+	if service == "" || timestamp == "" || nonce == "" || digest == "" || encoded == "" {
+		return "", ErrMissingSignature
+	}
+	publicKey, ok := v.publicKeys[service]
+	if !ok {
+		return "", pkgerrors.WithMessage(ErrUntrustedService, service)
+	}
+
+	millis, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+	if skew := v.now().Sub(time.UnixMilli(millis)); skew > v.opts.replayWindow || skew < -v.opts.replayWindow {
+		return "", ErrExpired
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", ErrInvalidSignature
+	}
+	if !verify(publicKey, canonical(service, v.service, timestamp, nonce, digest, req), signature) {
+		return "", ErrInvalidSignature
+	}
+	if BodyDigest(req.Body) != digest {
+		return "", ErrInvalidSignature
+	}
+
+	// 签名有效后记录随机数；时间戳允许前后偏差，记录两倍的有效时间
+	fresh, err := v.opts.nonceStore.Remember(ctx, service+":"+nonce, 2*v.opts.replayWindow)
+	if err != nil {
+		return "", err
+	}
+	if !fresh {
+		return "", ErrReplayed
+	}
+	return service, nil
+}
+
+// callerKey 调用方
+type callerKey struct{}
+
+// NewCallerContext 验证通过的调用方
+func NewCallerContext(ctx context.Context, service string) context.Context {
+	return context.WithValue(ctx, callerKey{}, service)
+}
+
+// CallerFromContext 验证通过的调用方的服务名称
+func CallerFromContext(ctx context.Context) (string, bool) {
+	service, ok := ctx.Value(callerKey{}).(string)
+	return service, ok && service != ""
+}
@@ -0,0 +1,157 @@
+package servicesignutil
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// newTestingKeyPair PEM：PKIX 公钥、PKCS#8 私钥
+func newTestingKeyPair(t *testing.T, privateKey crypto.Signer) (string, string) {
+	publicDER, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	require.NoError(t, err)
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}))
+}
+
+// go test -v ./util/servicesign/ -count=1 -test.run=TestSignAndVerify
+func TestSignAndVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecdsaKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	_, ed25519Key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	keys := map[string]crypto.Signer{
+		"rsa-service":     rsaKey,
+		"ecdsa-service":   ecdsaKey,
+		"ed25519-service": ed25519Key,
+	}
+	trusted := make(map[string]string)
+	signers := make(map[string]*Signer)
+	for service, key := range keys {
+		publicKeyPEM, privateKeyPEM := newTestingKeyPair(t, key)
+		trusted[service] = publicKeyPEM
+		signers[service], err = NewSigner(service, privateKeyPEM)
+		require.NoError(t, err)
+	}
+	verifier, err := NewVerifier("ping-service", trusted, WithReplayWindow(time.Minute))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	for service, signer := range signers {
+		req := &Request{Audience: "ping-service", Method: http.MethodPost, Path: "/api/v1/ping", Query: "a=1", Body: []byte(`{"value":"hello"}`)}
+		header := http.Header{}
+		require.NoError(t, signer.Sign(header, req))
+		caller, err := verifier.Verify(ctx, header, req)
+		require.NoError(t, err, service)
+		require.Equal(t, service, caller)
+
+		// 重复的请求
+		_, err = verifier.Verify(ctx, header, req)
+		require.ErrorIs(t, err, ErrReplayed)
+
+		// 篡改：消息、路径、查询参数
+		header = http.Header{}
+		require.NoError(t, signer.Sign(header, req))
+		_, err = verifier.Verify(ctx, header, &Request{Method: req.Method, Path: req.Path, Query: req.Query, Body: []byte(`{"value":"tampered"}`)})
+		require.ErrorIs(t, err, ErrInvalidSignature)
+		_, err = verifier.Verify(ctx, header, &Request{Method: req.Method, Path: "/api/v1/other", Query: req.Query, Body: req.Body})
+		require.ErrorIs(t, err, ErrInvalidSignature)
+		_, err = verifier.Verify(ctx, header, &Request{Method: req.Method, Path: req.Path, Query: "a=2", Body: req.Body})
+		require.ErrorIs(t, err, ErrInvalidSignature)
+
+		// 签名给其他服务
+		header = http.Header{}
+		require.NoError(t, signer.Sign(header, &Request{Audience: "other-service", Method: req.Method, Path: req.Path, Query: req.Query, Body: req.Body}))
+		_, err = verifier.Verify(ctx, header, req)
+		require.ErrorIs(t, err, ErrInvalidSignature)
+	}
+
+	// 未指定被调用方
+	require.Error(t, signers["ed25519-service"].Sign(http.Header{}, &Request{}))
+
+	// 未签名、不受信任
+	_, err = verifier.Verify(ctx, http.Header{}, &Request{})
+	require.ErrorIs(t, err, ErrMissingSignature)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherPrivateKeyPEM := newTestingKeyPair(t, otherKey)
+	other, err := NewSigner("other-service", otherPrivateKeyPEM)
+	require.NoError(t, err)
+	header := http.Header{}
+	require.NoError(t, other.Sign(header, &Request{Audience: "ping-service", Path: "/saas.api.ping.servicev1.SrvPingV1/Ping"}))
+	_, err = verifier.Verify(ctx, header, &Request{Path: "/saas.api.ping.servicev1.SrvPingV1/Ping"})
+	require.ErrorIs(t, err, ErrUntrustedService)
+
+	// 时间戳过期
+	signer := signers["ed25519-service"]
+	signer.now = func() time.Time { return time.Now().Add(-2 * time.Minute) }
+	header = http.Header{}
+	require.NoError(t, signer.Sign(header, &Request{Audience: "ping-service"}))
+	_, err = verifier.Verify(ctx, header, &Request{})
+	require.ErrorIs(t, err, ErrExpired)
+}
+
+// go test -v ./util/servicesign/ -count=1 -test.run=TestPayloadStatsHandler
+func TestPayloadStatsHandler(t *testing.T) {
+	// 客户端：确定性的编码
+	data, err := Codec{}.Marshal(wrapperspb.String("hello"))
+	require.NoError(t, err)
+	message := &wrapperspb.StringValue{}
+	require.NoError(t, Codec{}.Unmarshal(data, message))
+	require.Equal(t, "hello", message.GetValue())
+
+	// 服务端：记录第一个请求消息
+	handler := NewPayloadStatsHandler()
+	_, ok := PayloadFromContext(context.Background())
+	require.False(t, ok)
+	ctx := handler.TagRPC(context.Background(), &stats.RPCTagInfo{FullMethodName: "/saas.api.ping.servicev1.SrvPingV1/Ping"})
+	_, ok = PayloadFromContext(ctx)
+	require.False(t, ok)
+	handler.HandleRPC(ctx, &stats.InPayload{Data: data})
+	handler.HandleRPC(ctx, &stats.InPayload{Data: []byte("other")})
+	payload, ok := PayloadFromContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, data, payload)
+}
+
+// go test -v ./util/servicesign/ -count=1 -test.run=TestRedisNonceStore
+func TestRedisNonceStore(t *testing.T) {
+	server := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: server.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+	store := NewRedisNonceStore(client, "testing:service_sign:")
+	ctx := context.Background()
+
+	fresh, err := store.Remember(ctx, "nonce", time.Minute)
+	require.NoError(t, err)
+	require.True(t, fresh)
+	fresh, err = store.Remember(ctx, "nonce", time.Minute)
+	require.NoError(t, err)
+	require.False(t, fresh)
+	require.Equal(t, time.Minute, server.TTL("testing:service_sign:nonce"))
+
+	server.FastForward(time.Minute)
+	fresh, err = store.Remember(ctx, "nonce", time.Minute)
+	require.NoError(t, err)
+	require.True(t, fresh)
+}
@@ -0,0 +1,93 @@
+package servicesignutil
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// ParsePrivateKey PEM 格式的私钥：PKCS#8(RSA、ECDSA、Ed25519)、PKCS#1(RSA)、SEC 1(ECDSA)
+func ParsePrivateKey(privateKeyPEM string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(privateKeyPEM)))
+	if block == nil {
+		return nil, pkgerrors.New("service sign : invalid private key pem")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, pkgerrors.Errorf("service sign : unsupported private key type %T", key)
+		}
+		return signer, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, pkgerrors.New("service sign : unsupported private key format")
+}
+
+// ParsePublicKey PEM 格式的公钥：PKIX(RSA、ECDSA、Ed25519)、PKCS#1(RSA)
+func ParsePublicKey(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(publicKeyPEM)))
+	if block == nil {
+		return nil, pkgerrors.New("service sign : invalid public key pem")
+	}
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		switch key.(type) {
+		case *rsa.PublicKey, *ecdsa.PublicKey, ed25519.PublicKey:
+			return key, nil
+		}
+		return nil, pkgerrors.Errorf("service sign : unsupported public key type %T", key)
+	}
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return nil, pkgerrors.New("service sign : unsupported public key format")
+}
+
+// sign RSA：PSS(SHA-256)；ECDSA：ASN.1(SHA-256)；Ed25519：原文
+func sign(signer crypto.Signer, message []byte) ([]byte, error) {
+	var (
+		signature []byte
+		err       error
+	)
+	switch signer.Public().(type) {
+	case ed25519.PublicKey:
+		signature, err = signer.Sign(rand.Reader, message, crypto.Hash(0))
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		signature, err = signer.Sign(rand.Reader, digest[:], &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256})
+	default:
+		digest := sha256.Sum256(message)
+		signature, err = signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return signature, nil
+}
+
+// verify 与 sign 对应
+func verify(publicKey crypto.PublicKey, message, signature []byte) bool {
+	switch key := publicKey.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, message, signature)
+	case *rsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return rsa.VerifyPSS(key, crypto.SHA256, digest[:], signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA256}) == nil
+	case *ecdsa.PublicKey:
+		digest := sha256.Sum256(message)
+		return ecdsa.VerifyASN1(key, digest[:], signature)
+	}
+	return false
+}
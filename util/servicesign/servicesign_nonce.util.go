@@ -0,0 +1,77 @@
+package servicesignutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+// NonceStore 记录已使用的随机数，拒绝重复的请求
+type NonceStore interface {
+	// Remember 记录随机数；已存在时返回 false
+	Remember(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
+
+// redisNonceStore 多个副本共享
+type redisNonceStore struct {
+	redisCC   redis.UniversalClient
+	keyPrefix string
+}
+
+// NewRedisNonceStore redis；键为 keyPrefix+nonce
+func NewRedisNonceStore(redisCC redis.UniversalClient, keyPrefix string) NonceStore {
+	return &redisNonceStore{
+		redisCC:   redisCC,
+		keyPrefix: keyPrefix,
+	}
+}
+
+// Remember ...
+func (s *redisNonceStore) Remember(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	ok, err := s.redisCC.SetNX(ctx, s.keyPrefix+nonce, 1, ttl).Result()
+	if err != nil {
+		return false, pkgerrors.WithStack(err)
+	}
+	return ok, nil
+}
+
+// memoryNonceStore 进程内；仅拒绝发往同一个副本的重复请求
+type memoryNonceStore struct {
+	mu     sync.Mutex
+	nonces map[string]time.Time
+	// lastSweep 最近一次清理过期的随机数
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryNonceStore 进程内
+func NewMemoryNonceStore() NonceStore {
+	return &memoryNonceStore{
+		nonces: make(map[string]time.Time),
+		now:    time.Now,
+	}
+}
+
+// Remember ...
+func (s *memoryNonceStore) Remember(_ context.Context, nonce string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= ttl {
+		for k, expireAt := range s.nonces {
+			if !now.Before(expireAt) {
+				delete(s.nonces, k)
+			}
+		}
+		s.lastSweep = now
+	}
+	if expireAt, ok := s.nonces[nonce]; ok && now.Before(expireAt) {
+		return false, nil
+	}
+	s.nonces[nonce] = now.Add(ttl)
+	return true, nil
+}
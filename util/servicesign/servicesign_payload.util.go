@@ -0,0 +1,118 @@
+package servicesignutil
+
+import (
+	"context"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ encoding.Codec = Codec{}
+
+// Codec grpc 客户端：确定性的 protobuf 编码；发送的字节与签名的摘要一致
+//
+//	grpc.WithOptions(stdgrpc.WithDefaultCallOptions(stdgrpc.ForceCodec(servicesignutil.Codec{})))
+type Codec struct{}
+
+// Marshal ...
+func (Codec) Marshal(v interface{}) ([]byte, error) {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return nil, pkgerrors.Errorf("service sign : message is not proto.Message : %T", v)
+	}
+	return MarshalProto(m)
+}
+
+// Unmarshal ...
+func (Codec) Unmarshal(data []byte, v interface{}) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return pkgerrors.Errorf("service sign : message is not proto.Message : %T", v)
+	}
+	return proto.Unmarshal(data, m)
+}
+
+// Name 同 grpc 默认的 proto 编码
+func (Codec) Name() string {
+	return "proto"
+}
+
+// MarshalProto 确定性的 protobuf 编码；grpc 客户端签名的请求消息
+func MarshalProto(m proto.Message) ([]byte, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return data, nil
+}
+
+// payloadKey 接收的请求消息
+type payloadKey struct{}
+
+// payload 接收的请求消息
+type payload struct {
+	mutex sync.Mutex
+	data  []byte
+	ok    bool
+}
+
+var _ stats.Handler = (*payloadStatsHandler)(nil)
+
+// payloadStatsHandler grpc 服务端：记录接收的请求消息(解码前的字节)
+type payloadStatsHandler struct{}
+
+// NewPayloadStatsHandler grpc 服务端：记录接收的请求消息，用于验证摘要
+func NewPayloadStatsHandler() stats.Handler {
+	return &payloadStatsHandler{}
+}
+
+// GRPCServerOption grpc 服务端：记录接收的请求消息，用于验证摘要；
+// 例：kgrpc.Options(servicesignutil.GRPCServerOption())
+func GRPCServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(NewPayloadStatsHandler())
+}
+
+// TagRPC ...
+func (h *payloadStatsHandler) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, payloadKey{}, &payload{})
+}
+
+// HandleRPC 记录第一个请求消息
+func (h *payloadStatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	in, ok := s.(*stats.InPayload)
+	if !ok {
+		return
+	}
+	p, ok := ctx.Value(payloadKey{}).(*payload)
+	if !ok {
+		return
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if !p.ok {
+		p.data, p.ok = in.Data, true
+	}
+}
+
+// TagConn ...
+func (h *payloadStatsHandler) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+// HandleConn ...
+func (h *payloadStatsHandler) HandleConn(context.Context, stats.ConnStats) {}
+
+// PayloadFromContext grpc 服务端接收的请求消息；需配置 GRPCServerOption
+func PayloadFromContext(ctx context.Context) ([]byte, bool) {
+	p, ok := ctx.Value(payloadKey{}).(*payload)
+	if !ok {
+		return nil, false
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.data, p.ok
+}
@@ -3,8 +3,10 @@ package setuputil
 import (
 	"flag"
 	stdlog "log"
+	"time"
 
 	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
+	migrationutil "github.com/my-saas-platform/api-proto/util/migration"
 )
 
 var (
@@ -19,6 +21,13 @@ func init() {
 type options struct {
 	configPath       string
 	consulConfigPath string
+
+	// migrations 数据库迁移
+	migrations       []*migrationutil.Migration
+	migrationOptions []migrationutil.Option
+
+	// snowflakeNodeAllocatorFunc 雪花算法节点ID分配
+	snowflakeNodeAllocatorFunc SnowflakeNodeAllocatorFunc
 }
 
 // Option is config option.
@@ -38,43 +47,61 @@ func WithConsulConfigPath(consulConfigPath string) Option {
 	}
 }
 
+// WithMigrations 数据库迁移；配置 setting.enable_migrate_db = true 时在启动时执行
+func WithMigrations(migrations ...*migrationutil.Migration) Option {
+	return func(o *options) {
+		o.migrations = append(o.migrations, migrations...)
+	}
+}
+
+// WithMigrationOptions 数据库迁移可选项
+func WithMigrationOptions(migrationOptions ...migrationutil.Option) Option {
+	return func(o *options) {
+		o.migrationOptions = append(o.migrationOptions, migrationOptions...)
+	}
+}
+
 // New 启动与配置
 func New(opts ...Option) (engineHandler Engine, err error) {
+	// 启动选项
+	setupOpts := newSetupOptions(opts...)
+
+	// 配置方式
+	configHandler, err := newConfigHandler(setupOpts)
+	if err != nil {
+		return engineHandler, err
+	}
+
+	// 开始配置
+	stdlog.Println("|==================== 配置程序 开始 ====================|")
+	defer stdlog.Println("|==================== 配置程序 结束 ====================|")
+
+	return newEngine(configHandler, setupOpts)
+}
+
+// newSetupOptions 启动选项
+func newSetupOptions(opts ...Option) *options {
 	if !flag.Parsed() {
 		flag.Parse()
 	}
-	// 启动选项
 	setupOpts := &options{
 		configPath: configFlag,
 	}
 	for i := range opts {
 		opts[i](setupOpts)
 	}
+	return setupOpts
+}
 
-	// 配置方式
-	var (
-		configHandler Config
-	)
+// newConfigHandler 配置手柄：consul、文件
+func newConfigHandler(setupOpts *options) (configHandler Config, err error) {
 	switch {
 	case setupOpts.consulConfigPath != "":
-		// 初始化配置手柄
 		configHandler, _, err = newConfigWithConsul(setupOpts)
-		if err != nil {
-			return engineHandler, err
-		}
 	default:
-		// 初始化配置手柄
 		configHandler, err = newConfigWithFiles(setupOpts)
-		if err != nil {
-			return engineHandler, err
-		}
 	}
-
-	// 开始配置
-	stdlog.Println("|==================== 配置程序 开始 ====================|")
-	defer stdlog.Println("|==================== 配置程序 结束 ====================|")
-
-	return newEngine(configHandler)
+	return configHandler, err
 }
 
 // initEngine ...
@@ -85,12 +112,19 @@ func initEngine(conf Config) *engines {
 }
 
 // newEngine 启动与配置
-func newEngine(configHandler Config) (Engine, error) {
+func newEngine(configHandler Config, setupOpts *options) (engineHandler Engine, err error) {
 	// 初始化手柄
-	var (
-		err          error
-		setupHandler = initEngine(configHandler)
-	)
+	setupHandler := initEngine(configHandler)
+	setupHandler.migrations = setupOpts.migrations
+	setupHandler.migrationOptions = setupOpts.migrationOptions
+	setupHandler.snowflakeNodeAllocatorFunc = setupOpts.snowflakeNodeAllocatorFunc
+
+	// 启动失败：关闭已加载的组件
+	defer func() {
+		if err != nil {
+			_ = setupHandler.Close()
+		}
+	}()
 
 	// 设置调试工具
 	if err = setupHandler.loadingDebugUtil(); err != nil {
@@ -102,6 +136,13 @@ func newEngine(configHandler Config) (Engine, error) {
 		return nil, err
 	}
 
+	// prometheus 指标；先于数据库与redis加载
+	if cfg := setupHandler.Config.MetricsConfig(); cfg != nil && cfg.Enable {
+		if _, err = setupHandler.GetMetrics(); err != nil {
+			return nil, err
+		}
+	}
+
 	// mysql gorm 数据库
 	if cfg := setupHandler.Config.MySQLConfig(); cfg != nil && cfg.Enable {
 		if _, err = setupHandler.GetMySQLGormDB(); err != nil {
@@ -118,14 +159,47 @@ func newEngine(configHandler Config) (Engine, error) {
 
 	// redis 客户端
 	if cfg := setupHandler.Config.RedisConfig(); cfg != nil && cfg.Enable {
-		redisCC, err := setupHandler.GetRedisClient()
-		if err != nil {
-			return nil, err
+		redisCC, redisErr := setupHandler.GetRedisClient()
+		if redisErr != nil {
+			return nil, redisErr
 		}
 		// 验证Token工具
 		_, _ = setupHandler.GetAuthTokenRepo(redisCC)
 	}
 
+	// 命名实例：mysql、postgres、redis
+	if err = setupHandler.loadingInstances(); err != nil {
+		return nil, err
+	}
+
+	// 数据库迁移
+	if cfg := setupHandler.Config.SettingConfig(); cfg != nil && cfg.EnableMigrateDb {
+		if err = setupHandler.runMigrations(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 定时任务
+	if cfg := setupHandler.Config.SettingConfig(); cfg != nil && cfg.EnableScheduleTask {
+		if _, err = setupHandler.GetScheduler(); err != nil {
+			return nil, err
+		}
+	}
+
+	// rabbitmq
+	if cfg := setupHandler.Config.RabbitmqConfig(); cfg != nil && cfg.Enable {
+		if _, err = setupHandler.GetRabbitmqConn(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 事务发件箱
+	if cfg := setupHandler.Config.SettingConfig(); cfg != nil && cfg.EnableOutboxRelay {
+		if _, err = setupHandler.GetOutbox(); err != nil {
+			return nil, err
+		}
+	}
+
 	// 服务注册
 	setupHandler.SetRegistryType(registrypkg.RegistryTypeLocal)
 
@@ -137,6 +211,14 @@ func newEngine(configHandler Config) (Engine, error) {
 		}
 	}
 
+	// etcd 客户端
+	if cfg := setupHandler.Config.EtcdConfig(); cfg != nil && cfg.Enable {
+		_, err = setupHandler.GetEtcdClient()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// jaeger
 	if cfg := setupHandler.Config.JaegerConfig(); cfg != nil && cfg.Enable {
 		_, err = setupHandler.GetJaegerExporter()
@@ -145,14 +227,45 @@ func newEngine(configHandler Config) (Engine, error) {
 		}
 	}
 
+	// otlp
+	if cfg := setupHandler.Config.OtlpConfig(); cfg != nil && cfg.Enable {
+		_, err = setupHandler.GetOtlpExporter()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// 雪花算法
 	if cfg := setupHandler.Config.SettingConfig(); cfg != nil && cfg.EnableSnowflakeWorker {
+		start := time.Now()
 		err = setupHandler.loadingSnowflakeWorker()
+		setupHandler.recordComponent(ComponentSnowflake, start, err)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// 传输加密；校验密钥对
+	if setupHandler.transferEncryptEnabled() {
+		if _, err = setupHandler.GetTransferCipher(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 服务间请求签名；校验密钥
+	if cfg := setupHandler.Config.ServiceEncryptConfig(); cfg != nil && cfg.Enable {
+		if _, err = setupHandler.GetServiceSigner(); err != nil {
+			return nil, err
+		}
+	}
+
+	// 服务注册；服务开始监听后注册，见 ServiceRegistryOptions
+	if cfg := setupHandler.Config.SettingConfig(); cfg != nil && cfg.EnableServiceRegistry {
+		if err = setupHandler.loadingServiceRegistrar(); err != nil {
+			return nil, err
+		}
+	}
+
 	// 监听配置 app
 	//if err = setupHandler.watchConfigApp(); err != nil {
 	//	return nil, err
@@ -0,0 +1,185 @@
+package setuputil
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// DefaultAdminPath 管理端点的 http 路径
+	DefaultAdminPath = "/debug/engine"
+)
+
+// EngineStatus 引擎状态
+type EngineStatus struct {
+	AppID         string `json:"app_id"`
+	ServerName    string `json:"server_name"`
+	ServerVersion string `json:"server_version"`
+	ServerEnv     string `json:"server_env"`
+	// RegistryType 服务注册类型；未注册时为空
+	RegistryType      string             `json:"registry_type,omitempty"`
+	ServiceInstanceID string             `json:"service_instance_id,omitempty"`
+	ConfigSource      ConfigSource       `json:"config_source"`
+	Components        []*ComponentStatus `json:"components"`
+	Time              time.Time          `json:"time"`
+}
+
+// EngineStatus 引擎状态
+func (s *engines) EngineStatus() *EngineStatus {
+	appConfig := s.Config.AppConfig()
+	status := &EngineStatus{
+		AppID:         apputil.ID(appConfig),
+		ServerName:    appConfig.GetServerName(),
+		ServerVersion: appConfig.GetServerVersion(),
+		ServerEnv:     appConfig.GetServerEnv(),
+		RegistryType:  string(s.GetRegistryType()),
+		ConfigSource:  s.Config.ConfigSource(),
+		Components:    s.ComponentStatuses(),
+		Time:          time.Now(),
+	}
+	if instance := s.GetServiceInstance(); instance != nil {
+		status.ServiceInstanceID = instance.ID
+	}
+	return status
+}
+
+// AdminHandler 管理端点：引擎状态(json)；配置 server.admin 的基础认证与 ip 白名单
+func (s *engines) AdminHandler() (http.Handler, error) {
+	cfg := s.Config.AdminConfig()
+	allowList, err := newAdminIPAllowList(cfg)
+	if err != nil {
+		return nil, err
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(s.EngineStatus())
+	})
+	return withAdminAccess(cfg, allowList, handler), nil
+}
+
+// RegisterAdminHTTPServer 在 http 服务上暴露管理端点；需配置 server.admin.enable = true
+func (s *engines) RegisterAdminHTTPServer(srv *khttp.Server) error {
+	cfg := s.Config.AdminConfig()
+	if cfg == nil || !cfg.Enable {
+		return nil
+	}
+	handler, err := s.AdminHandler()
+	if err != nil {
+		return err
+	}
+	path := cfg.Path
+	if path == "" {
+		path = DefaultAdminPath
+	}
+	srv.Handle(path, handler)
+
+	// 日志级别
+	levelHandler, err := s.LoggerLevelHandler()
+	if err != nil {
+		return err
+	}
+	srv.Handle(path+AdminLoggerLevelPath, levelHandler)
+	return nil
+}
+
+// withAdminAccess 访问控制：ip 白名单、基础认证
+func withAdminAccess(cfg *configs.Server_Admin, allowList ipAllowList, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 使用直连的地址；不信任 X-Forwarded-For
+		if !allowList.allowRemoteAddr(r.RemoteAddr) {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
+		if cfg.GetUsername() != "" {
+			username, password, ok := r.BasicAuth()
+			if !ok || !secureCompare(username, cfg.GetUsername()) || !secureCompare(password, cfg.GetPassword()) {
+				w.Header().Set("WWW-Authenticate", `Basic realm="engine admin", charset="UTF-8"`)
+				http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+				return
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// secureCompare 常量时间比较
+func secureCompare(given, expected string) bool {
+	return subtle.ConstantTimeCompare([]byte(given), []byte(expected)) == 1
+}
+
+// ipAllowList ip 白名单；为空时不限制
+type ipAllowList []*net.IPNet
+
+// adminLoopbackIPs 未配置基础认证与 ip 白名单时，管理端点仅允许本机访问
+var adminLoopbackIPs = []string{"127.0.0.0/8", "::1/128"}
+
+// newAdminIPAllowList 管理端点的 ip 白名单；未配置 server.admin.username 与 allow_ips 时仅允许本机访问
+func newAdminIPAllowList(cfg *configs.Server_Admin) (ipAllowList, error) {
+	if cfg.GetUsername() == "" && len(cfg.GetAllowIps()) == 0 {
+		return newIPAllowList(adminLoopbackIPs)
+	}
+	return newIPAllowList(cfg.GetAllowIps())
+}
+
+// newIPAllowList ip 或 cidr
+func newIPAllowList(ips []string) (ipAllowList, error) {
+	allowList := make(ipAllowList, 0, len(ips))
+	for _, ip := range ips {
+		ip = strings.TrimSpace(ip)
+		if !strings.Contains(ip, "/") {
+			parsed := net.ParseIP(ip)
+			if parsed == nil {
+				return nil, pkgerrors.Errorf("[请配置服务再启动] 无效的 server.admin.allow_ips : %s", ip)
+			}
+			if parsed.To4() != nil {
+				ip += "/32"
+			} else {
+				ip += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(ip)
+		if err != nil {
+			return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 无效的 server.admin.allow_ips")
+		}
+		allowList = append(allowList, ipNet)
+	}
+	return allowList, nil
+}
+
+// allowRemoteAddr http.Request.RemoteAddr
+func (l ipAllowList) allowRemoteAddr(remoteAddr string) bool {
+	if len(l) == 0 {
+		return true
+	}
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range l {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,130 @@
+package setuputil
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestingAdminEngine(t *testing.T, adminConfig *configs.Server_Admin) *engines {
+	server := miniredis.RunT(t)
+	handler := initEngine(&configuration{
+		source: ConfigSource{Type: ConfigSourceTypeFile, Path: "../../configs"},
+		conf: &configs.Bootstrap{
+			App: &configs.App{
+				ProjectName:   "testing-project",
+				ServerName:    "testing-service",
+				ServerEnv:     "testing",
+				ServerVersion: "v1.0.0",
+			},
+			Server: &configs.Server{Admin: adminConfig},
+			Infrastructure: &configs.Infrastructure{
+				Mysql: &configs.Infrastructure_MySQL{Enable: true},
+				Redis: &configs.Infrastructure_Redis{Enable: true, Addresses: []string{server.Addr()}},
+				Etcd:  &configs.Infrastructure_Etcd{Enable: true},
+			},
+		},
+	})
+	redisCC, err := handler.GetRedisClient()
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = redisCC.Close() })
+	_, err = handler.GetEtcdClient()
+	require.Error(t, err)
+	return handler
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_EngineStatus
+func TestEngines_EngineStatus(t *testing.T) {
+	handler := newTestingAdminEngine(t, nil)
+	handler.SetRegistryType(registrypkg.RegistryTypeEtcd)
+
+	status := handler.EngineStatus()
+	require.Equal(t, apputil.ID(handler.AppConfig()), status.AppID)
+	require.Equal(t, "etcd", status.RegistryType)
+	require.Equal(t, ConfigSource{Type: ConfigSourceTypeFile, Path: "../../configs"}, status.ConfigSource)
+
+	components := make(map[string]*ComponentStatus)
+	for _, component := range status.Components {
+		components[component.Name] = component
+	}
+	require.Equal(t, ComponentStateReady, components[ComponentRedis].State)
+	require.NotEmpty(t, components[ComponentRedis].InitDuration)
+	require.IsType(t, &RedisPoolStats{}, components[ComponentRedis].Pool)
+	require.Equal(t, ComponentStateFailed, components[ComponentEtcd].State)
+	require.Contains(t, components[ComponentEtcd].LastError, "etcd.endpoints")
+	require.Equal(t, ComponentStatePending, components[ComponentMySQL].State)
+	require.Nil(t, components[ComponentMySQL].Pool)
+	require.Equal(t, ComponentStateDisabled, components[ComponentJaeger].State)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_AdminHandler
+func TestEngines_AdminHandler(t *testing.T) {
+	handler := newTestingAdminEngine(t, &configs.Server_Admin{
+		Enable:   true,
+		Username: "admin",
+		Password: "secret",
+		AllowIps: []string{"192.0.2.1", "10.0.0.0/8"},
+	})
+	adminHandler, err := handler.AdminHandler()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		username   string
+		password   string
+		wantCode   int
+	}{
+		{name: "#ok_ip", remoteAddr: "192.0.2.1:5000", username: "admin", password: "secret", wantCode: http.StatusOK},
+		{name: "#ok_cidr", remoteAddr: "10.1.2.3:5000", username: "admin", password: "secret", wantCode: http.StatusOK},
+		{name: "#forbidden", remoteAddr: "192.0.2.2:5000", username: "admin", password: "secret", wantCode: http.StatusForbidden},
+		{name: "#unauthorized", remoteAddr: "192.0.2.1:5000", username: "admin", password: "wrong", wantCode: http.StatusUnauthorized},
+		{name: "#no_auth", remoteAddr: "192.0.2.1:5000", wantCode: http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, DefaultAdminPath, nil)
+			req.RemoteAddr = tt.remoteAddr
+			if tt.username != "" {
+				req.SetBasicAuth(tt.username, tt.password)
+			}
+			rec := httptest.NewRecorder()
+			adminHandler.ServeHTTP(rec, req)
+			require.Equal(t, tt.wantCode, rec.Code)
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			status := &EngineStatus{}
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), status))
+			require.Equal(t, apputil.ID(handler.AppConfig()), status.AppID)
+			require.NotEmpty(t, status.Components)
+		})
+	}
+
+	// 未配置基础认证与 ip 白名单：仅允许本机访问
+	adminHandler, err = newTestingAdminEngine(t, &configs.Server_Admin{Enable: true}).AdminHandler()
+	require.NoError(t, err)
+	for remoteAddr, wantCode := range map[string]int{
+		"127.0.0.1:5000": http.StatusOK,
+		"[::1]:5000":     http.StatusOK,
+		"192.0.2.1:5000": http.StatusForbidden,
+	} {
+		req := httptest.NewRequest(http.MethodGet, DefaultAdminPath, nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		adminHandler.ServeHTTP(rec, req)
+		require.Equal(t, wantCode, rec.Code, remoteAddr)
+	}
+
+	_, err = initEngine(&configuration{conf: &configs.Bootstrap{
+		Server: &configs.Server{Admin: &configs.Server_Admin{AllowIps: []string{"localhost"}}},
+	}}).AdminHandler()
+	require.Error(t, err)
+}
@@ -3,13 +3,22 @@ package setuputil
 import (
 	stdlog "log"
 	"sync"
+	"time"
 
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
 	authpkg "github.com/ikaiguang/go-srv-kit/kratos/auth"
 	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	tokenkeyutil "github.com/my-saas-platform/api-proto/util/tokenkey"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// tokenEncryptConfigKey 配置：token 密钥
+const tokenEncryptConfigKey = "setting.encrypt_secret.token_encrypt"
+
 // GetAuthTokenRepo 验证Token工具
 func (s *engines) GetAuthTokenRepo(redisCC redis.UniversalClient) (authpkg.AuthRepo, error) {
 	if s.authTokenRepo != nil {
@@ -27,33 +36,103 @@ func (s *engines) GetAuthTokenRepo(redisCC redis.UniversalClient) (authpkg.AuthR
 
 // loadingAuthTokenRepo 验证Token工具
 func (s *engines) loadingAuthTokenRepo(redisCC redis.UniversalClient) (authpkg.AuthRepo, error) {
-	tokenConfig := s.TokenEncryptConfig()
-	if tokenConfig == nil {
-		err := pkgerrors.New("[请配置服务再启动] config key : setting.encrypt_secret.token_encrypt")
-		return nil, err
-	}
-	if tokenConfig.GetSignKey() == "" {
-		err := pkgerrors.New("[请配置服务再启动] config key : setting.encrypt_secret.token_encrypt.sign_key")
-		return nil, err
-	}
-	if tokenConfig.RefreshKey == "" {
-		err := pkgerrors.New("[请配置服务再启动] config key : setting.encrypt_secret.token_encrypt.refresh_key")
+	keySet, err := newTokenKeySet(s.TokenEncryptConfig())
+	if err != nil {
 		return nil, err
 	}
 	logger, _, err := s.LoggerMiddleware()
 	if err != nil {
 		return nil, err
 	}
+	rotator := tokenkeyutil.NewRotator(keySet)
 	tokenManger := authpkg.NewTokenManger(logger, redisCC, authpkg.CheckAuthCacheKeyPrefix(nil))
-	config := &authpkg.Config{
-		SignCrypto:    authpkg.NewSignEncryptor(tokenConfig.SignKey),
-		RefreshCrypto: authpkg.NewCBCCipher(tokenConfig.RefreshKey),
+	authConfig := &authpkg.Config{
+		SignCrypto:    rotator.SignEncryptor(),
+		RefreshCrypto: rotator.RefreshEncryptor(),
 	}
 	stdlog.Println("|*** 加载：验证Token工具：...")
-	authRepo, err := authpkg.NewAuthRepo(*config, logger, tokenManger)
+	authRepo, err := authpkg.NewAuthRepo(*authConfig, logger, tokenManger)
 	if err != nil {
 		e := errorpkg.FromError(err)
 		return nil, errorpkg.WithStack(e)
 	}
+
+	// 配置变更：轮换密钥；密钥无效时保留原有的密钥
+	var observer = func(k string, v config.Value) {
+		tokenConfig := &configs.Setting_EncryptSecret_TokenEncrypt{}
+		if err := v.Scan(tokenConfig); err != nil {
+			_ = logger.Log(log.LevelError,
+				"watch config.TokenEncrypt",
+				"config.Value.Scan(tokenConfig) err : "+err.Error(),
+			)
+			return
+		}
+		newKeySet, err := newTokenKeySet(tokenConfig)
+		if err != nil {
+			_ = logger.Log(log.LevelError,
+				"watch config.TokenEncrypt",
+				"invalid token keys : "+err.Error(),
+			)
+			return
+		}
+		rotator.Update(newKeySet)
+		_ = logger.Log(log.LevelInfo,
+			"watch config.TokenEncrypt",
+			"监听配置：密钥已更新：key = "+k,
+		)
+	}
+	err = s.Watch(tokenEncryptConfigKey, observer)
+	if err != nil && !pkgerrors.Is(err, config.ErrNotFound) && !IsUninitializedError(err) {
+		return nil, pkgerrors.WithStack(err)
+	}
 	return authRepo, nil
 }
+
+// newTokenKeySet 密钥集合：keys 与旧版本的 sign_key、refresh_key
+func newTokenKeySet(tokenConfig *configs.Setting_EncryptSecret_TokenEncrypt) (*tokenkeyutil.KeySet, error) {
+	if tokenConfig == nil {
+		err := pkgerrors.New("[请配置服务再启动] config key : " + tokenEncryptConfigKey)
+		return nil, err
+	}
+	var keys []*tokenkeyutil.Key
+	for _, key := range tokenConfig.GetKeys() {
+		if key.GetKid() == "" {
+			err := pkgerrors.New("[请配置服务再启动] config key : " + tokenEncryptConfigKey + ".keys.kid")
+			return nil, err
+		}
+		keys = append(keys, &tokenkeyutil.Key{
+			ID:         key.GetKid(),
+			SignKey:    key.GetSignKey(),
+			RefreshKey: key.GetRefreshKey(),
+			NotBefore:  tokenKeyTime(key.GetNotBefore()),
+			RetireAt:   tokenKeyTime(key.GetRetireAt()),
+		})
+	}
+	if tokenConfig.GetSignKey() != "" || tokenConfig.GetRefreshKey() != "" || len(keys) == 0 {
+		if tokenConfig.GetSignKey() == "" {
+			err := pkgerrors.New("[请配置服务再启动] config key : " + tokenEncryptConfigKey + ".sign_key")
+			return nil, err
+		}
+		if tokenConfig.GetRefreshKey() == "" {
+			err := pkgerrors.New("[请配置服务再启动] config key : " + tokenEncryptConfigKey + ".refresh_key")
+			return nil, err
+		}
+		keys = append(keys, &tokenkeyutil.Key{
+			SignKey:    tokenConfig.GetSignKey(),
+			RefreshKey: tokenConfig.GetRefreshKey(),
+		})
+	}
+	keySet, err := tokenkeyutil.NewKeySet(keys)
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] config key : "+tokenEncryptConfigKey)
+	}
+	return keySet, nil
+}
+
+// tokenKeyTime 未配置时为零值
+func tokenKeyTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
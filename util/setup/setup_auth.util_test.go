@@ -0,0 +1,68 @@
+package setuputil
+
+import (
+	"testing"
+	"time"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestNewTokenKeySet
+func TestNewTokenKeySet(t *testing.T) {
+	now := time.Now()
+
+	// 旧版本的配置
+	keySet, err := newTokenKeySet(&configs.Setting_EncryptSecret_TokenEncrypt{
+		SignKey:    "legacy-sign",
+		RefreshKey: "legacy-refresh16",
+	})
+	require.NoError(t, err)
+	active, err := keySet.Active(now)
+	require.NoError(t, err)
+	require.Equal(t, "", active.ID)
+
+	// 密钥集合
+	keySet, err = newTokenKeySet(&configs.Setting_EncryptSecret_TokenEncrypt{
+		SignKey:    "legacy-sign",
+		RefreshKey: "legacy-refresh16",
+		Keys: []*configs.Setting_EncryptSecret_TokenEncrypt_Key{
+			{Kid: "k1", SignKey: "sign-1", RefreshKey: "refresh-key-1-16", NotBefore: timestamppb.New(now.Add(-time.Hour))},
+			{Kid: "k2", SignKey: "sign-2", RefreshKey: "refresh-key-2-16", NotBefore: timestamppb.New(now.Add(time.Hour))},
+			{Kid: "k0", SignKey: "sign-0", RefreshKey: "refresh-key-0-16", RetireAt: timestamppb.New(now.Add(-time.Minute))},
+		},
+	})
+	require.NoError(t, err)
+	active, err = keySet.Active(now)
+	require.NoError(t, err)
+	require.Equal(t, "k1", active.ID)
+	_, err = keySet.Lookup("", now)
+	require.NoError(t, err)
+	_, err = keySet.Lookup("k2", now)
+	require.NoError(t, err)
+	_, err = keySet.Lookup("k0", now)
+	require.Error(t, err)
+
+	// 仅配置密钥集合
+	keySet, err = newTokenKeySet(&configs.Setting_EncryptSecret_TokenEncrypt{
+		Keys: []*configs.Setting_EncryptSecret_TokenEncrypt_Key{
+			{Kid: "k1", SignKey: "sign-1", RefreshKey: "refresh-key-1-16"},
+		},
+	})
+	require.NoError(t, err)
+	_, err = keySet.Lookup("", now)
+	require.Error(t, err)
+
+	// 无效的配置
+	_, err = newTokenKeySet(nil)
+	require.Error(t, err)
+	_, err = newTokenKeySet(&configs.Setting_EncryptSecret_TokenEncrypt{})
+	require.Error(t, err)
+	_, err = newTokenKeySet(&configs.Setting_EncryptSecret_TokenEncrypt{SignKey: "legacy-sign"})
+	require.Error(t, err)
+	_, err = newTokenKeySet(&configs.Setting_EncryptSecret_TokenEncrypt{
+		Keys: []*configs.Setting_EncryptSecret_TokenEncrypt_Key{{SignKey: "sign-1", RefreshKey: "refresh-key-1-16"}},
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,53 @@
+package setuputil
+
+import (
+	stdlog "log"
+
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	cacheutil "github.com/my-saas-platform/api-proto/util/cache"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// NewCache 两级缓存；redis键前缀：apputil.KeyPrefix + cache:；启用指标时记录每个层级的命中
+func (s *engines) NewCache(name string, opts ...cacheutil.Option) (*cacheutil.Cache, error) {
+	redisCC, err := s.GetRedisClient()
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 两级缓存需启用 redis")
+	}
+	cacheOpts := []cacheutil.Option{
+		cacheutil.WithKeyPrefix(apputil.KeyPrefix(s.Config.AppConfig()) + cacheutil.DefaultKeyPrefix),
+	}
+	m, err := s.enabledMetrics()
+	if err != nil {
+		return nil, err
+	}
+	if m != nil {
+		cacheOpts = append(cacheOpts, cacheutil.WithMetrics(m))
+	}
+	cache, err := cacheutil.NewCache(name, redisCC, append(cacheOpts, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	stdlog.Println("|*** 加载：两级缓存：" + name)
+
+	s.cachesMutex.Lock()
+	defer s.cachesMutex.Unlock()
+	s.caches = append(s.caches, cache)
+	return cache, nil
+}
+
+// closeCaches 关闭两级缓存
+func (s *engines) closeCaches() (errInfos []string) {
+	s.cachesMutex.Lock()
+	defer s.cachesMutex.Unlock()
+
+	for _, cache := range s.caches {
+		stdlog.Println("|*** 退出程序：关闭：两级缓存：" + cache.Name())
+		if err := cache.Close(); err != nil {
+			errorPrefix := "caches[" + cache.Name() + "].Close error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+	s.caches = nil
+	return errInfos
+}
@@ -0,0 +1,44 @@
+package setuputil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	cacheutil "github.com/my-saas-platform/api-proto/util/cache"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_NewCache
+func TestEngines_NewCache(t *testing.T) {
+	server := miniredis.RunT(t)
+	app := &configs.App{ProjectName: "testing-project", ServerName: "testing-service", ServerEnv: "testing"}
+	handler := initEngine(&configuration{conf: &configs.Bootstrap{
+		App: app,
+		Infrastructure: &configs.Infrastructure{
+			Redis: &configs.Infrastructure_Redis{Enable: true, Addresses: []string{server.Addr()}},
+		},
+	}})
+	ctx := context.Background()
+
+	cache, err := handler.NewCache("user")
+	require.NoError(t, err)
+	v, err := cacheutil.GetOrLoad(ctx, cache, "1", func(ctx context.Context) (string, error) {
+		return "testing", nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, "testing", v)
+	require.True(t, server.Exists(apputil.ID(app)+":cache:user:1"))
+
+	require.Empty(t, handler.closeCaches())
+	require.Empty(t, handler.caches)
+	require.NoError(t, handler.redisClient.Close())
+
+	t.Run("#redis_disabled", func(t *testing.T) {
+		handler := initEngine(&configuration{conf: &configs.Bootstrap{App: app}})
+		_, err := handler.NewCache("user")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,42 @@
+package setuputil
+
+import (
+	stdlog "log"
+
+	enumv1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/enums"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	captchautil "github.com/my-saas-platform/api-proto/util/captcha"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// NewCaptchaService 验证码；redis键前缀：apputil.KeyPrefix + captcha:；规则为 setting.captcha；
+// 配置 setting.captcha.token_secret 时，VerifyCaptcha 返回验证凭证
+func (s *engines) NewCaptchaService(opts ...captchautil.Option) (*captchautil.Service, error) {
+	redisCC, err := s.GetRedisClient()
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 验证码需启用 redis")
+	}
+	cfg := s.Config.SettingConfig().GetCaptcha()
+	serviceOpts := []captchautil.Option{
+		captchautil.WithKeyPrefix(apputil.KeyPrefix(s.Config.AppConfig()) + captchautil.DefaultKeyPrefix),
+	}
+	if cfg.GetImageWidth() > 0 || cfg.GetImageHeight() > 0 {
+		serviceOpts = append(serviceOpts, captchautil.WithGenerator(enumv1.CaptchaTypeEnum_IMAGE, captchautil.ImageGenerator{
+			Width:  int(cfg.GetImageWidth()),
+			Height: int(cfg.GetImageHeight()),
+		}))
+	}
+	if cfg.GetTokenSecret() != "" {
+		serviceOpts = append(serviceOpts, captchautil.WithTokenSecret(cfg.GetTokenSecret()))
+	}
+
+	stdlog.Println("|*** 加载：验证码")
+	return captchautil.NewService(redisCC, captchautil.Config{
+		Length:            int(cfg.GetCaptchaLen()),
+		TTL:               cfg.GetCaptchaTtl().AsDuration(),
+		MaxVerifyAttempts: int64(cfg.GetMaxVerifyAttempts()),
+		ResendInterval:    cfg.GetResendInterval().AsDuration(),
+		ImageSendLimit:    int64(cfg.GetImageSendLimit()),
+		TokenTTL:          cfg.GetTokenTtl().AsDuration(),
+	}, append(serviceOpts, opts...)...), nil
+}
@@ -0,0 +1,50 @@
+package setuputil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	enumv1 "github.com/my-saas-platform/api-proto/api/captcha-service/v1/enums"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	captchautil "github.com/my-saas-platform/api-proto/util/captcha"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_NewCaptchaService
+func TestEngines_NewCaptchaService(t *testing.T) {
+	server := miniredis.RunT(t)
+	app := &configs.App{ProjectName: "testing-project", ServerName: "testing-service", ServerEnv: "testing"}
+	handler := initEngine(&configuration{conf: &configs.Bootstrap{
+		App: app,
+		Infrastructure: &configs.Infrastructure{
+			Redis: &configs.Infrastructure_Redis{Enable: true, Addresses: []string{server.Addr()}},
+		},
+		Setting: &configs.Setting{Captcha: &configs.Setting_Captcha{
+			CaptchaLen:     4,
+			CaptchaTtl:     durationpb.New(time.Minute),
+			ResendInterval: durationpb.New(30 * time.Second),
+		}},
+	}})
+	defer func() { require.NoError(t, handler.redisClient.Close()) }()
+
+	var sent string
+	service, err := handler.NewCaptchaService(captchautil.WithSender(enumv1.CaptchaTypeEnum_SMS,
+		captchautil.SenderFunc(func(ctx context.Context, target, code string, ttl time.Duration) error {
+			sent = code
+			return nil
+		}),
+	))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	result, err := service.Send(ctx, &captchautil.SendRequest{Type: enumv1.CaptchaTypeEnum_SMS, Target: "13800000000"})
+	require.NoError(t, err)
+	require.Len(t, sent, 4)
+	require.Equal(t, time.Minute, result.ExpiresIn)
+	require.Equal(t, 30*time.Second, result.ResendAfter)
+	require.Equal(t, time.Minute, server.TTL(apputil.ID(app)+":captcha:code:"+result.CaptchaID))
+}
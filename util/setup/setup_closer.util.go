@@ -6,6 +6,7 @@ import (
 	stdlog "log"
 	"strings"
 
+	outboxutil "github.com/my-saas-platform/api-proto/util/outbox"
 	pkgerrors "github.com/pkg/errors"
 )
 
@@ -31,6 +32,15 @@ func (s *engines) Close() (err error) {
 		stdlog.Printf("|*** 退出程序：发生Panic：%v\n", panicRecover)
 	}()
 
+	// 服务注销；先于其他资源关闭，停止接收流量
+	if s.serviceRegistrar != nil {
+		stdlog.Println("|*** 退出程序：关闭：服务注册")
+		if err := s.DeregisterService(context.Background()); err != nil {
+			errorPrefix := "DeregisterService error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+
 	// 关闭配置
 	stdlog.Println("|*** 退出程序：关闭：配置处理手柄")
 	if err = s.Config.Close(); err != nil {
@@ -38,6 +48,68 @@ func (s *engines) Close() (err error) {
 		errInfos = append(errInfos, errorPrefix+err.Error())
 	}
 
+	// 定时任务；先于redis关闭
+	if s.scheduler != nil {
+		stdlog.Println("|*** 退出程序：关闭：定时任务")
+		s.scheduler.Stop()
+	}
+
+	// 事务发件箱；先于rabbitmq与数据库关闭，释放转发租约
+	if s.outbox != nil {
+		stdlog.Println("|*** 退出程序：关闭：事务发件箱")
+		ctx, cancel := context.WithTimeout(context.Background(), outboxutil.DefaultPublishTimeout)
+		err := s.outbox.Stop(ctx)
+		cancel()
+		if err != nil {
+			errorPrefix := "outbox.Stop error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+
+	// rabbitmq 消费者；先于链接关闭，等待处理中的消息
+	if s.rabbitmqConsumer != nil {
+		stdlog.Println("|*** 退出程序：关闭：RabbitMQ消费者")
+		ctx, cancel := context.WithTimeout(context.Background(), s.rabbitmqConsumerDrainTimeout())
+		err := s.rabbitmqConsumer.Stop(ctx)
+		cancel()
+		if err != nil {
+			errorPrefix := "rabbitmqConsumer.Stop error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+
+	// rabbitmq；停止重连，关闭channel池与链接
+	if s.rabbitmqConn != nil {
+		stdlog.Println("|*** 退出程序：关闭：RabbitMQ")
+		if err := s.rabbitmqConn.Close(); err != nil {
+			errorPrefix := "rabbitmqConn.Close error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+
+	// 雪花算法；先于redis关闭，释放节点ID
+	if s.snowflakeWorker != nil {
+		stdlog.Println("|*** 退出程序：关闭：雪花算法")
+		if err := s.snowflakeWorker.Stop(); err != nil {
+			errorPrefix := "snowflakeWorker.Stop error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+
+	// 分布式锁；先于redis关闭，释放持有的锁
+	if s.locker != nil {
+		stdlog.Println("|*** 退出程序：关闭：分布式锁")
+		ctx, cancel := context.WithTimeout(context.Background(), defaultLockerCloseTimeout)
+		if err := s.locker.Close(ctx); err != nil {
+			errorPrefix := "locker.Close error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+		cancel()
+	}
+
+	// 两级缓存；先于redis关闭，取消订阅
+	errInfos = append(errInfos, s.closeCaches()...)
+
 	// redis
 	if s.redisClient != nil {
 		stdlog.Println("|*** 退出程序：关闭：Redis客户端")
@@ -46,9 +118,11 @@ func (s *engines) Close() (err error) {
 			errorPrefix := "redisClient.Close error : "
 			errInfos = append(errInfos, errorPrefix+err.Error())
 		}
+		s.unregisterRedisClient(DefaultInstanceName)
 	}
 
 	// 数据库
+	errInfos = append(errInfos, s.closeDBReplicaPolicies()...)
 	if s.mysqlGormDB != nil {
 		stdlog.Println("|*** 退出程序：关闭：MySQL-GORM")
 		errorPrefix := "mysqlGormDB.Close error : "
@@ -58,6 +132,7 @@ func (s *engines) Close() (err error) {
 		} else if err = connPool.Close(); err != nil {
 			errInfos = append(errInfos, errorPrefix+err.Error())
 		}
+		s.unregisterGormDB(metricsSystemMySQL, DefaultInstanceName)
 	}
 	if s.postgresGormDB != nil {
 		stdlog.Println("|*** 退出程序：关闭：Postgres-GORM")
@@ -68,14 +143,45 @@ func (s *engines) Close() (err error) {
 		} else if err = connPool.Close(); err != nil {
 			errInfos = append(errInfos, errorPrefix+err.Error())
 		}
+		s.unregisterGormDB(metricsSystemPostgres, DefaultInstanceName)
 	}
 
+	// 命名实例
+	errInfos = append(errInfos, s.closeInstances()...)
+
 	// s.consulClient
 	if s.consulClient != nil {
 		//stdlog.Println("|*** 退出程序：关闭：consulClient")
 		//errorPrefix := "consulClient.Close error : "
 	}
 
+	// s.etcdClient
+	if s.etcdClient != nil {
+		stdlog.Println("|*** 退出程序：关闭：etcdClient")
+		if err := s.etcdClient.Close(); err != nil {
+			errorPrefix := "etcdClient.Close error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+
+	// 链路追踪；导出剩余的追踪数据，并关闭导出
+	if s.tracerProvider != nil {
+		stdlog.Println("|*** 退出程序：关闭：tracerProvider")
+		errorPrefix := "tracerProvider.Shutdown error : "
+		err := s.tracerProvider.Shutdown(context.Background())
+		if err != nil {
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+	if s.otlpTraceExporter != nil {
+		stdlog.Println("|*** 退出程序：关闭：otlpTraceExporter")
+		errorPrefix := "otlpTraceExporter.Shutdown error : "
+		err := s.otlpTraceExporter.Shutdown(context.Background())
+		if err != nil {
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+	}
+
 	// s.jaegerTraceExporter
 	if s.jaegerTraceExporter != nil {
 		stdlog.Println("|*** 退出程序：关闭：jaegerTraceExporter")
@@ -86,13 +192,6 @@ func (s *engines) Close() (err error) {
 		}
 	}
 
-	// 雪花算法
-	if s.snowflakeStopChannel != nil {
-		stdlog.Println("|*** 退出程序：关闭：雪花算法")
-		s.snowflakeStopChannel <- 1
-		close(s.snowflakeStopChannel)
-	}
-
 	// debug
 	if len(s.debugHelperCloseFnSlice) > 0 {
 		stdlog.Println("|*** 退出程序：关闭：调试工具debugutil")
@@ -0,0 +1,255 @@
+package setuputil
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// ComponentState 组件状态
+type ComponentState string
+
+const (
+	// ComponentStateDisabled 未启用
+	ComponentStateDisabled ComponentState = "disabled"
+	// ComponentStatePending 已启用，未加载(懒加载)
+	ComponentStatePending ComponentState = "pending"
+	// ComponentStateReady 已加载
+	ComponentStateReady ComponentState = "ready"
+	// ComponentStateFailed 加载失败；已重置 sync.Once，下次获取时重新加载
+	ComponentStateFailed ComponentState = "failed"
+)
+
+// 组件名称
+const (
+	ComponentMetrics          = "Metrics"
+	ComponentMySQL            = "MySQL-GORM"
+	ComponentPostgres         = "Postgres-GORM"
+	ComponentRedis            = "Redis"
+	ComponentMigrator         = "Migrator"
+	ComponentScheduler        = "Scheduler"
+	ComponentRabbitmq         = "RabbitMQ"
+	ComponentRabbitmqConsumer = "RabbitMQ-Consumer"
+	ComponentOutbox           = "Outbox"
+	ComponentConsul           = "Consul"
+	ComponentEtcd             = "Etcd"
+	ComponentJaeger           = "Jaeger"
+	ComponentOtlp             = "OTLP"
+	ComponentSnowflake        = "Snowflake"
+	ComponentLocker           = "Locker"
+	ComponentLoginGuard       = "LoginGuard"
+	ComponentTransferEncrypt  = "TransferEncrypt"
+	ComponentServiceSign      = "ServiceSign"
+)
+
+// componentRecord 组件最近一次加载的结果
+type componentRecord struct {
+	err      error
+	duration time.Duration
+	loadedAt time.Time
+}
+
+// recordComponent 记录组件的加载结果
+func (s *engines) recordComponent(name string, start time.Time, err error) {
+	s.componentsMutex.Lock()
+	defer s.componentsMutex.Unlock()
+
+	if s.components == nil {
+		s.components = make(map[string]*componentRecord)
+	}
+	s.components[name] = &componentRecord{
+		err:      err,
+		duration: time.Since(start),
+		loadedAt: start,
+	}
+}
+
+// componentRecordByName 组件最近一次加载的结果
+func (s *engines) componentRecordByName(name string) (componentRecord, bool) {
+	s.componentsMutex.Lock()
+	defer s.componentsMutex.Unlock()
+
+	record, ok := s.components[name]
+	if !ok {
+		return componentRecord{}, false
+	}
+	return *record, true
+}
+
+// ComponentStatus 组件状态
+type ComponentStatus struct {
+	Name  string         `json:"name"`
+	State ComponentState `json:"state"`
+	// LastError 最近一次加载的错误
+	LastError string `json:"last_error,omitempty"`
+	// InitDuration 最近一次加载的耗时
+	InitDuration string     `json:"init_duration,omitempty"`
+	InitAt       *time.Time `json:"init_at,omitempty"`
+	// Pool 连接池统计：*DBPoolStats、*RedisPoolStats
+	Pool interface{} `json:"pool,omitempty"`
+}
+
+// DBPoolStats 数据库连接池统计
+type DBPoolStats struct {
+	MaxOpenConnections int    `json:"max_open_connections"`
+	OpenConnections    int    `json:"open_connections"`
+	InUse              int    `json:"in_use"`
+	Idle               int    `json:"idle"`
+	WaitCount          int64  `json:"wait_count"`
+	WaitDuration       string `json:"wait_duration"`
+}
+
+// RedisPoolStats redis 连接池统计
+type RedisPoolStats struct {
+	Hits       uint32 `json:"hits"`
+	Misses     uint32 `json:"misses"`
+	Timeouts   uint32 `json:"timeouts"`
+	TotalConns uint32 `json:"total_conns"`
+	IdleConns  uint32 `json:"idle_conns"`
+	StaleConns uint32 `json:"stale_conns"`
+}
+
+// componentDescriptor 组件：是否启用、连接池统计
+type componentDescriptor struct {
+	name    string
+	enabled bool
+	pool    func() interface{}
+}
+
+// ComponentStatuses 组件状态；按加载顺序
+func (s *engines) ComponentStatuses() []*ComponentStatus {
+	descriptors := s.componentDescriptors()
+	statuses := make([]*ComponentStatus, 0, len(descriptors))
+	for _, descriptor := range descriptors {
+		status := &ComponentStatus{Name: descriptor.name, State: ComponentStatePending}
+		if !descriptor.enabled {
+			status.State = ComponentStateDisabled
+		}
+		// 未启用的组件也可能被显式获取
+		if record, ok := s.componentRecordByName(descriptor.name); ok {
+			initAt := record.loadedAt
+			status.InitAt = &initAt
+			status.InitDuration = record.duration.String()
+			if record.err != nil {
+				status.State = ComponentStateFailed
+				status.LastError = record.err.Error()
+			} else {
+				status.State = ComponentStateReady
+				if descriptor.pool != nil {
+					status.Pool = descriptor.pool()
+				}
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// componentDescriptors 组件；与 newEngine 的加载顺序一致
+func (s *engines) componentDescriptors() []*componentDescriptor {
+	var (
+		infraConfig   = s.Config.InfrastructureConfig()
+		settingConfig = s.Config.SettingConfig()
+		mysqlEnabled  = s.Config.MySQLConfig().GetEnable()
+		psqlEnabled   = s.Config.PostgresConfig().GetEnable()
+		mqEnabled     = s.Config.RabbitmqConfig().GetEnable()
+	)
+	descriptors := []*componentDescriptor{
+		{name: ComponentMetrics, enabled: s.Config.MetricsConfig().GetEnable()},
+		{name: ComponentMySQL, enabled: mysqlEnabled, pool: func() interface{} { return toDBPoolStats(s.mysqlGormDB) }},
+		{name: ComponentPostgres, enabled: psqlEnabled, pool: func() interface{} { return toDBPoolStats(s.postgresGormDB) }},
+		{name: ComponentRedis, enabled: s.Config.RedisConfig().GetEnable(), pool: func() interface{} { return toRedisPoolStats(s.redisClient) }},
+	}
+
+	// 命名实例
+	for _, name := range sortedInstanceNames(infraConfig.GetMysqlInstances()) {
+		instanceName := name
+		descriptors = append(descriptors, &componentDescriptor{
+			name:    instanceComponentName(ComponentMySQL, name),
+			enabled: infraConfig.MysqlInstances[name].GetEnable(),
+			pool: func() interface{} {
+				return toDBPoolStats(s.getGormDBInstance(&s.mysqlGormInstances, instanceName).db)
+			},
+		})
+	}
+	for _, name := range sortedInstanceNames(infraConfig.GetPsqlInstances()) {
+		instanceName := name
+		descriptors = append(descriptors, &componentDescriptor{
+			name:    instanceComponentName(ComponentPostgres, name),
+			enabled: infraConfig.PsqlInstances[name].GetEnable(),
+			pool: func() interface{} {
+				return toDBPoolStats(s.getGormDBInstance(&s.postgresGormInstances, instanceName).db)
+			},
+		})
+	}
+	for _, name := range sortedInstanceNames(infraConfig.GetRedisInstances()) {
+		instanceName := name
+		descriptors = append(descriptors, &componentDescriptor{
+			name:    instanceComponentName(ComponentRedis, name),
+			enabled: infraConfig.RedisInstances[name].GetEnable(),
+			pool: func() interface{} {
+				return toRedisPoolStats(s.getRedisClientInstance(instanceName).client)
+			},
+		})
+	}
+
+	descriptors = append(descriptors,
+		&componentDescriptor{name: ComponentMigrator, enabled: settingConfig.GetEnableMigrateDb() && (mysqlEnabled || psqlEnabled)},
+		&componentDescriptor{name: ComponentScheduler, enabled: settingConfig.GetEnableScheduleTask()},
+		&componentDescriptor{name: ComponentRabbitmq, enabled: mqEnabled},
+		&componentDescriptor{name: ComponentRabbitmqConsumer, enabled: mqEnabled},
+		&componentDescriptor{name: ComponentOutbox, enabled: settingConfig.GetEnableOutboxRelay()},
+		&componentDescriptor{name: ComponentConsul, enabled: s.Config.ConsulConfig().GetEnable()},
+		&componentDescriptor{name: ComponentEtcd, enabled: s.Config.EtcdConfig().GetEnable()},
+		&componentDescriptor{name: ComponentJaeger, enabled: s.Config.JaegerConfig().GetEnable()},
+		&componentDescriptor{name: ComponentOtlp, enabled: s.Config.OtlpConfig().GetEnable()},
+		&componentDescriptor{name: ComponentSnowflake, enabled: settingConfig.GetEnableSnowflakeWorker()},
+		&componentDescriptor{name: ComponentLocker, enabled: s.Config.RedisConfig().GetEnable()},
+		&componentDescriptor{name: ComponentLoginGuard, enabled: s.Config.RedisConfig().GetEnable()},
+		&componentDescriptor{name: ComponentTransferEncrypt, enabled: s.transferEncryptEnabled()},
+		&componentDescriptor{name: ComponentServiceSign, enabled: s.Config.ServiceEncryptConfig().GetEnable()},
+	)
+	return descriptors
+}
+
+// toDBPoolStats 数据库连接池统计
+func toDBPoolStats(db *gorm.DB) interface{} {
+	if db == nil {
+		return nil
+	}
+	connPool, err := db.DB()
+	if err != nil {
+		return nil
+	}
+	return newDBPoolStats(connPool.Stats())
+}
+
+// newDBPoolStats ...
+func newDBPoolStats(stats sql.DBStats) *DBPoolStats {
+	return &DBPoolStats{
+		MaxOpenConnections: stats.MaxOpenConnections,
+		OpenConnections:    stats.OpenConnections,
+		InUse:              stats.InUse,
+		Idle:               stats.Idle,
+		WaitCount:          stats.WaitCount,
+		WaitDuration:       stats.WaitDuration.String(),
+	}
+}
+
+// toRedisPoolStats redis 连接池统计
+func toRedisPoolStats(client redis.UniversalClient) interface{} {
+	if client == nil {
+		return nil
+	}
+	stats := client.PoolStats()
+	return &RedisPoolStats{
+		Hits:       stats.Hits,
+		Misses:     stats.Misses,
+		Timeouts:   stats.Timeouts,
+		TotalConns: stats.TotalConns,
+		IdleConns:  stats.IdleConns,
+		StaleConns: stats.StaleConns,
+	}
+}
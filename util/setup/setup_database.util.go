@@ -0,0 +1,278 @@
+package setuputil
+
+import (
+	"context"
+	"crypto/tls"
+	"database/sql"
+	"database/sql/driver"
+	"net"
+	"regexp"
+	"strconv"
+	"sync"
+
+	mysqldriver "github.com/go-sql-driver/mysql"
+	gormpkg "github.com/ikaiguang/go-srv-kit/data/gorm"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/stdlib"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+const (
+	// DriverMySQL mysql
+	DriverMySQL = "mysql"
+	// DriverPostgres postgres
+	DriverPostgres = "postgres"
+)
+
+var (
+	_passwordProviders = sync.Map{}
+
+	// _tlsConfigNameRegexp mysql tls配置名称
+	_tlsConfigNameRegexp = regexp.MustCompile(`[^a-zA-Z0-9_\-]`)
+)
+
+// PasswordRequest 获取密码的请求
+type PasswordRequest struct {
+	// Driver 数据库驱动；值：DriverMySQL、DriverPostgres
+	Driver string
+	// Instance 实例名称；默认实例为 DefaultInstanceName
+	Instance string
+	// Addr 连接地址；例：127.0.0.1:3306
+	Addr string
+	// User 用户名
+	User string
+	// DBName 数据库名称
+	DBName string
+}
+
+// PasswordProvider 密码提供者；连接池每次新建连接时调用
+type PasswordProvider func(ctx context.Context, req *PasswordRequest) (string, error)
+
+// RegisterPasswordProvider 注册密码提供者；
+// 在 setuputil.New 之前注册，配置 password_provider = name 后生效
+func RegisterPasswordProvider(name string, provider PasswordProvider) {
+	_passwordProviders.Store(name, provider)
+}
+
+// getPasswordProvider 密码提供者
+func getPasswordProvider(name string) (PasswordProvider, error) {
+	if name == "" {
+		return nil, nil
+	}
+	provider, ok := _passwordProviders.Load(name)
+	if !ok {
+		return nil, pkgerrors.New("[请配置服务再启动] 未注册的 password_provider : " + name)
+	}
+	return provider.(PasswordProvider), nil
+}
+
+// gormConnConfig 数据库连接配置；configs.Infrastructure_MySQL、configs.Infrastructure_PSQL
+type gormConnConfig interface {
+	GetSlowThreshold() *durationpb.Duration
+	GetLoggerEnable() bool
+	GetLoggerColorful() bool
+	GetLoggerLevel() string
+	GetConnMaxActive() uint32
+	GetConnMaxLifetime() *durationpb.Duration
+	GetConnMaxIdle() uint32
+	GetConnMaxIdleTime() *durationpb.Duration
+}
+
+// newGormConnOption gorm 连接选项
+func newGormConnOption(cfg gormConnConfig, opts ...gormpkg.Option) *gormpkg.ConnOption {
+	connOption := &gormpkg.ConnOption{
+		LoggerEnable:              cfg.GetLoggerEnable(),
+		LoggerLevel:               gormpkg.ParseLoggerLevel(cfg.GetLoggerLevel()),
+		LoggerWriters:             nil,
+		LoggerColorful:            cfg.GetLoggerColorful(),
+		SlowThreshold:             cfg.GetSlowThreshold().AsDuration(),
+		IgnoreRecordNotFoundError: false,
+
+		ConnMaxActive:   int(cfg.GetConnMaxActive()),
+		ConnMaxLifetime: cfg.GetConnMaxLifetime().AsDuration(),
+		ConnMaxIdle:     int(cfg.GetConnMaxIdle()),
+		ConnMaxIdleTime: cfg.GetConnMaxIdleTime().AsDuration(),
+	}
+	for _, o := range opts {
+		o(connOption)
+	}
+	return connOption
+}
+
+// newMysqlDialector mysql 拨号；注册tls配置与密码提供者
+func newMysqlDialector(name string, cfg *configs.Infrastructure_MySQL, dsn string) (gorm.Dialector, error) {
+	if !cfg.EnableTls && cfg.PasswordProvider == "" {
+		return mysql.Open(dsn), nil
+	}
+	dsnConfig, err := mysqldriver.ParseDSN(dsn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	// tls
+	if cfg.EnableTls {
+		tlsConfig, err := newTLSConfig(&tlsConfigOption{
+			serverName:         cfg.TlsServerName,
+			insecureSkipVerify: cfg.InsecureSkipVerify,
+			caPem:              cfg.TlsCaPem,
+			certPem:            cfg.TlsCertPem,
+			keyPem:             cfg.TlsKeyPem,
+		})
+		if err != nil {
+			return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] mysql")
+		}
+		tlsConfigName := "setuputil_" + _tlsConfigNameRegexp.ReplaceAllString(name, "_")
+		if err = mysqldriver.RegisterTLSConfig(tlsConfigName, tlsConfig); err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		dsnConfig.TLS = nil
+		dsnConfig.TLSConfig = tlsConfigName
+	}
+
+	// 密码
+	provider, err := getPasswordProvider(cfg.PasswordProvider)
+	if err != nil {
+		return nil, err
+	}
+	if provider == nil {
+		return mysql.Open(dsnConfig.FormatDSN()), nil
+	}
+	connector := &mysqlConnector{
+		cfg:      dsnConfig,
+		provider: provider,
+		req: &PasswordRequest{
+			Driver:   DriverMySQL,
+			Instance: name,
+			Addr:     dsnConfig.Addr,
+			User:     dsnConfig.User,
+			DBName:   dsnConfig.DBName,
+		},
+	}
+	return mysql.New(mysql.Config{
+		DSNConfig: dsnConfig,
+		Conn:      sql.OpenDB(connector),
+	}), nil
+}
+
+// mysqlConnector 每次新建连接时获取密码
+type mysqlConnector struct {
+	cfg      *mysqldriver.Config
+	provider PasswordProvider
+	req      *PasswordRequest
+}
+
+// Connect 实现 driver.Connector
+func (c *mysqlConnector) Connect(ctx context.Context) (driver.Conn, error) {
+	password, err := c.provider(ctx, c.req)
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "mysql password provider")
+	}
+	cfg := c.cfg.Clone()
+	cfg.Passwd = password
+	connector, err := mysqldriver.NewConnector(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return connector.Connect(ctx)
+}
+
+// Driver 实现 driver.Connector
+func (c *mysqlConnector) Driver() driver.Driver {
+	return &mysqldriver.MySQLDriver{}
+}
+
+// newPostgresConnConfig postgres 连接配置；启用tls时，主机与备用主机(host=a,b)均使用tls配置
+func newPostgresConnConfig(cfg *configs.Infrastructure_PSQL, dsn string) (*pgx.ConnConfig, error) {
+	connConfig, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	if !cfg.EnableTls {
+		return connConfig, nil
+	}
+
+	newHostTLSConfig := func(host string) (*tls.Config, error) {
+		serverName := cfg.TlsServerName
+		if serverName == "" {
+			serverName = host
+		}
+		tlsConfig, err := newTLSConfig(&tlsConfigOption{
+			serverName:         serverName,
+			insecureSkipVerify: cfg.InsecureSkipVerify,
+			caPem:              cfg.TlsCaPem,
+			certPem:            cfg.TlsCertPem,
+			keyPem:             cfg.TlsKeyPem,
+		})
+		if err != nil {
+			return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] postgres")
+		}
+		return tlsConfig, nil
+	}
+	if connConfig.TLSConfig, err = newHostTLSConfig(connConfig.Host); err != nil {
+		return nil, err
+	}
+
+	// 保留备用主机，不回退到非加密连接：同一主机的加密与非加密(sslmode=prefer)合并为一个加密连接
+	var (
+		fallbacks = make([]*pgconn.FallbackConfig, 0, len(connConfig.Fallbacks))
+		seen      = map[string]bool{net.JoinHostPort(connConfig.Host, strconv.Itoa(int(connConfig.Port))): true}
+	)
+	for _, fallback := range connConfig.Fallbacks {
+		addr := net.JoinHostPort(fallback.Host, strconv.Itoa(int(fallback.Port)))
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
+		tlsConfig, err := newHostTLSConfig(fallback.Host)
+		if err != nil {
+			return nil, err
+		}
+		fallbacks = append(fallbacks, &pgconn.FallbackConfig{Host: fallback.Host, Port: fallback.Port, TLSConfig: tlsConfig})
+	}
+	connConfig.Fallbacks = fallbacks
+	return connConfig, nil
+}
+
+// newPostgresDialector postgres 拨号；设置tls配置与密码提供者
+func newPostgresDialector(name string, cfg *configs.Infrastructure_PSQL, dsn string) (gorm.Dialector, error) {
+	if !cfg.EnableTls && cfg.PasswordProvider == "" {
+		return postgres.Open(dsn), nil
+	}
+	connConfig, err := newPostgresConnConfig(cfg, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	// 密码
+	provider, err := getPasswordProvider(cfg.PasswordProvider)
+	if err != nil {
+		return nil, err
+	}
+	var opts []stdlib.OptionOpenDB
+	if provider != nil {
+		req := &PasswordRequest{
+			Driver:   DriverPostgres,
+			Instance: name,
+			Addr:     net.JoinHostPort(connConfig.Host, strconv.Itoa(int(connConfig.Port))),
+			User:     connConfig.User,
+			DBName:   connConfig.Database,
+		}
+		opts = append(opts, stdlib.OptionBeforeConnect(func(ctx context.Context, cc *pgx.ConnConfig) error {
+			password, err := provider(ctx, req)
+			if err != nil {
+				return pkgerrors.WithMessage(err, "postgres password provider")
+			}
+			cc.Password = password
+			return nil
+		}))
+	}
+	return postgres.New(postgres.Config{
+		Conn: stdlib.OpenDB(*connConfig, opts...),
+	}), nil
+}
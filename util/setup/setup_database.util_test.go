@@ -0,0 +1,86 @@
+package setuputil
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestNewMysqlDialector
+func TestNewMysqlDialector(t *testing.T) {
+	dsn := "root:Mysql.123456@tcp(127.0.0.1:3306)/test?charset=utf8mb4&parseTime=True"
+
+	// 未启用
+	dialect, err := newMysqlDialector("reporting", &configs.Infrastructure_MySQL{}, dsn)
+	require.NoError(t, err)
+	require.Equal(t, dsn, dialect.(*mysql.Dialector).DSN)
+
+	// tls
+	dialect, err = newMysqlDialector("reporting", &configs.Infrastructure_MySQL{EnableTls: true}, dsn)
+	require.NoError(t, err)
+	require.Contains(t, dialect.(*mysql.Dialector).DSN, "tls=setuputil_reporting")
+
+	// tls 证书无效
+	_, err = newMysqlDialector("reporting", &configs.Infrastructure_MySQL{EnableTls: true, TlsCertPem: "pem"}, dsn)
+	require.Error(t, err)
+
+	// 未注册的密码提供者
+	_, err = newMysqlDialector("reporting", &configs.Infrastructure_MySQL{PasswordProvider: "unknown"}, dsn)
+	require.Error(t, err)
+
+	// 密码提供者
+	RegisterPasswordProvider("testing", func(ctx context.Context, req *PasswordRequest) (string, error) {
+		return req.Driver + "-" + req.Instance, nil
+	})
+	dialect, err = newMysqlDialector("reporting", &configs.Infrastructure_MySQL{PasswordProvider: "testing"}, dsn)
+	require.NoError(t, err)
+	require.NotNil(t, dialect.(*mysql.Dialector).Conn)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestNewPostgresDialector
+func TestNewPostgresDialector(t *testing.T) {
+	dsn := "host=127.0.0.1 user=postgres password=Postgres.123456 dbname=test port=5432 sslmode=disable"
+
+	dialect, err := newPostgresDialector(DefaultInstanceName, &configs.Infrastructure_PSQL{}, dsn)
+	require.NoError(t, err)
+	require.Equal(t, dsn, dialect.(*postgres.Dialector).DSN)
+
+	RegisterPasswordProvider("testing", func(ctx context.Context, req *PasswordRequest) (string, error) {
+		return req.Driver + "-" + req.Instance, nil
+	})
+	dialect, err = newPostgresDialector(DefaultInstanceName, &configs.Infrastructure_PSQL{EnableTls: true, PasswordProvider: "testing"}, dsn)
+	require.NoError(t, err)
+	require.NotNil(t, dialect.(*postgres.Dialector).Conn)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestNewPostgresConnConfig
+func TestNewPostgresConnConfig(t *testing.T) {
+	dsn := "host=127.0.0.1,127.0.0.2,127.0.0.3 user=postgres password=Postgres.123456 dbname=test port=5432,5433,5434 sslmode=prefer"
+
+	// 未启用tls：同 pgx
+	connConfig, err := newPostgresConnConfig(&configs.Infrastructure_PSQL{}, dsn)
+	require.NoError(t, err)
+	require.Len(t, connConfig.Fallbacks, 5)
+
+	// 启用tls：保留备用主机，均使用tls
+	connConfig, err = newPostgresConnConfig(&configs.Infrastructure_PSQL{EnableTls: true}, dsn)
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1", connConfig.TLSConfig.ServerName)
+	require.Len(t, connConfig.Fallbacks, 2)
+	for i, fallback := range connConfig.Fallbacks {
+		require.Equal(t, fmt.Sprintf("127.0.0.%d", i+2), fallback.Host)
+		require.Equal(t, uint16(5433+i), fallback.Port)
+		require.NotNil(t, fallback.TLSConfig)
+		require.Equal(t, fallback.Host, fallback.TLSConfig.ServerName)
+	}
+
+	connConfig, err = newPostgresConnConfig(&configs.Infrastructure_PSQL{EnableTls: true, TlsServerName: "db.testing"}, dsn)
+	require.NoError(t, err)
+	require.Len(t, connConfig.Fallbacks, 2)
+	require.Equal(t, "db.testing", connConfig.Fallbacks[1].TLSConfig.ServerName)
+}
@@ -0,0 +1,327 @@
+package setuputil
+
+import (
+	"context"
+	stdlog "log"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	gormpkg "github.com/ikaiguang/go-srv-kit/data/gorm"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+)
+
+const (
+	// ReplicaPolicyRandom 随机
+	ReplicaPolicyRandom = "random"
+	// ReplicaPolicyRoundRobin 轮询
+	ReplicaPolicyRoundRobin = "round_robin"
+
+	// defaultReplicaHealthCheckInterval 从库健康检查间隔
+	defaultReplicaHealthCheckInterval = 5 * time.Second
+	// replicaHealthCheckTimeout 从库健康检查超时
+	replicaHealthCheckTimeout = 3 * time.Second
+)
+
+// UsePrimary 强制使用主库；
+// 查询：UsePrimary(db).First(&user)；
+// 事务：UsePrimary(db).Transaction(fc) (事务默认在主库执行)
+func UsePrimary(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Write)
+}
+
+// UseReplica 强制使用从库
+func UseReplica(db *gorm.DB) *gorm.DB {
+	return db.Clauses(dbresolver.Read)
+}
+
+// dbReplicaConfig 从库配置
+type dbReplicaConfig struct {
+	// component 组件名称
+	component string
+	// replicas 从库
+	replicas []gorm.Dialector
+	// policy 负载均衡策略
+	policy string
+	// healthCheckInterval 健康检查间隔
+	healthCheckInterval *durationpb.Duration
+	// connOption 连接池配置；同主库
+	connOption *gormpkg.ConnOption
+}
+
+// registerDBReplicas 注册从库；读写分离；
+// 成功后由调用方 storeDBReplicaPolicy，失败时需 Close 返回的 replicaPolicy
+func registerDBReplicas(db *gorm.DB, cfg *dbReplicaConfig) (*replicaPolicy, error) {
+	switch cfg.policy {
+	case "", ReplicaPolicyRandom, ReplicaPolicyRoundRobin:
+	default:
+		return nil, pkgerrors.New("[请配置服务再启动] 不支持的从库负载均衡策略 replica_policy : " + cfg.policy)
+	}
+	stdlog.Printf("|*** 加载：%s：从库数量 = %d", cfg.component, len(cfg.replicas))
+
+	policy := newReplicaPolicy(cfg.component, cfg.policy, cfg.healthCheckInterval)
+	// 主库作为最后一个连接池；从库均不可用时使用；复用主库的连接池
+	primary := db.ConnPool
+	if preparedStmtDB, ok := primary.(*gorm.PreparedStmtDB); ok {
+		primary = preparedStmtDB.ConnPool
+	}
+	replicas := make([]gorm.Dialector, 0, len(cfg.replicas)+1)
+	replicas = append(replicas, cfg.replicas...)
+	replicas = append(replicas, &connPoolDialector{Dialector: db.Dialector, connPool: primary})
+	resolver := dbresolver.Register(dbresolver.Config{
+		Replicas: replicas,
+		Policy:   policy,
+	})
+	if cfg.connOption.ConnMaxActive > 0 {
+		resolver.SetMaxOpenConns(cfg.connOption.ConnMaxActive)
+	}
+	if cfg.connOption.ConnMaxLifetime > 0 {
+		resolver.SetConnMaxLifetime(cfg.connOption.ConnMaxLifetime)
+	}
+	if cfg.connOption.ConnMaxIdle > 0 {
+		resolver.SetMaxIdleConns(cfg.connOption.ConnMaxIdle)
+	}
+	if cfg.connOption.ConnMaxIdleTime > 0 {
+		resolver.SetConnMaxIdleTime(cfg.connOption.ConnMaxIdleTime)
+	}
+	if err := db.Use(resolver); err != nil {
+		policy.Close()
+		return nil, pkgerrors.WithStack(err)
+	}
+
+	// 从库的连接池；关闭时释放
+	_ = resolver.Call(func(connPool gorm.ConnPool) error {
+		if connPool != primary {
+			policy.replicaPools = append(policy.replicaPools, connPool)
+		}
+		return nil
+	})
+	return policy, nil
+}
+
+// storeDBReplicaPolicy 记录从库；重新加载时由调用方关闭替换的 replicaPolicy
+func (s *engines) storeDBReplicaPolicy(policy *replicaPolicy) {
+	s.dbReplicaPoliciesMutex.Lock()
+	defer s.dbReplicaPoliciesMutex.Unlock()
+	if s.dbReplicaPolicies == nil {
+		s.dbReplicaPolicies = make(map[string]*replicaPolicy)
+	}
+	s.dbReplicaPolicies[policy.component] = policy
+}
+
+// loadDBReplicaPolicy 已记录的从库
+func (s *engines) loadDBReplicaPolicy(component string) *replicaPolicy {
+	s.dbReplicaPoliciesMutex.Lock()
+	defer s.dbReplicaPoliciesMutex.Unlock()
+	return s.dbReplicaPolicies[component]
+}
+
+// closeReplacedDBReplicaPolicy 重新加载后关闭替换的从库：停止健康检查，关闭从库的连接池
+func (s *engines) closeReplacedDBReplicaPolicy(previous *replicaPolicy) {
+	if previous == nil {
+		return
+	}
+	s.dbReplicaPoliciesMutex.Lock()
+	if s.dbReplicaPolicies[previous.component] == previous {
+		delete(s.dbReplicaPolicies, previous.component)
+	}
+	s.dbReplicaPoliciesMutex.Unlock()
+
+	if err := previous.Close(); err != nil {
+		logpkg.Warnw(
+			"db.component", previous.component,
+			"db.error", "close replaced replicas failed : "+err.Error(),
+		)
+	}
+}
+
+// closeDBReplicaPolicies 停止从库健康检查，关闭从库的连接池
+func (s *engines) closeDBReplicaPolicies() []string {
+	s.dbReplicaPoliciesMutex.Lock()
+	defer s.dbReplicaPoliciesMutex.Unlock()
+
+	if len(s.dbReplicaPolicies) > 0 {
+		stdlog.Println("|*** 退出程序：关闭：数据库从库")
+	}
+	var errInfos []string
+	for component, policy := range s.dbReplicaPolicies {
+		if err := policy.Close(); err != nil {
+			errInfos = append(errInfos, component+" replicas close error : "+err.Error())
+		}
+	}
+	s.dbReplicaPolicies = nil
+	return errInfos
+}
+
+// connPoolDialector 使用已有的连接池；从库均不可用时回退到主库，不另建主库的连接池
+type connPoolDialector struct {
+	gorm.Dialector
+	connPool gorm.ConnPool
+}
+
+// Initialize 实现 gorm.Dialector
+func (d *connPoolDialector) Initialize(db *gorm.DB) error {
+	db.ConnPool = d.connPool
+	return nil
+}
+
+// replicaPolicy 从库负载均衡策略；
+// 连接池列表的最后一个为主库，健康检查失败的从库暂停使用，从库均不可用时回退到主库
+type replicaPolicy struct {
+	component string
+	policy    string
+	interval  time.Duration
+	counter   uint64
+
+	poolsOnce sync.Once
+	mutex     sync.RWMutex
+	pools     []gorm.ConnPool
+	unhealthy map[gorm.ConnPool]bool
+
+	// replicaPools 从库的连接池；不含主库
+	replicaPools []gorm.ConnPool
+
+	stopOnce    sync.Once
+	stopChannel chan struct{}
+	closeErr    error
+}
+
+// newReplicaPolicy ...
+func newReplicaPolicy(component, policy string, interval *durationpb.Duration) *replicaPolicy {
+	p := &replicaPolicy{
+		component:   component,
+		policy:      policy,
+		interval:    interval.AsDuration(),
+		unhealthy:   make(map[gorm.ConnPool]bool),
+		stopChannel: make(chan struct{}),
+	}
+	if p.interval <= 0 {
+		p.interval = defaultReplicaHealthCheckInterval
+	}
+	return p
+}
+
+// Resolve 实现 dbresolver.Policy
+func (p *replicaPolicy) Resolve(connPools []gorm.ConnPool) gorm.ConnPool {
+	p.poolsOnce.Do(func() {
+		p.pools = connPools
+		go p.healthCheck()
+	})
+
+	primary := connPools[len(connPools)-1]
+	replicas := connPools[:len(connPools)-1]
+
+	p.mutex.RLock()
+	healthy := make([]gorm.ConnPool, 0, len(replicas))
+	for i := range replicas {
+		if !p.unhealthy[replicas[i]] {
+			healthy = append(healthy, replicas[i])
+		}
+	}
+	p.mutex.RUnlock()
+
+	switch len(healthy) {
+	case 0:
+		return primary
+	case 1:
+		return healthy[0]
+	}
+	if p.policy == ReplicaPolicyRoundRobin {
+		n := atomic.AddUint64(&p.counter, 1)
+		return healthy[n%uint64(len(healthy))]
+	}
+	return dbresolver.RandomPolicy{}.Resolve(healthy)
+}
+
+// Close 停止健康检查，关闭从库的连接池
+func (p *replicaPolicy) Close() error {
+	p.stopOnce.Do(func() {
+		close(p.stopChannel)
+		var errInfos []string
+		for i := range p.replicaPools {
+			closer, ok := p.replicaPools[i].(interface{ Close() error })
+			if !ok {
+				continue
+			}
+			if err := closer.Close(); err != nil {
+				errInfos = append(errInfos, err.Error())
+			}
+		}
+		if len(errInfos) > 0 {
+			p.closeErr = pkgerrors.New(strings.Join(errInfos, "；"))
+		}
+	})
+	return p.closeErr
+}
+
+// healthCheck 定时检查从库
+func (p *replicaPolicy) healthCheck() {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			p.checkReplicas()
+		case <-p.stopChannel:
+			return
+		}
+	}
+}
+
+// checkReplicas 检查从库；状态变化时记录日志
+func (p *replicaPolicy) checkReplicas() {
+	replicas := p.pools[:len(p.pools)-1]
+	for i := range replicas {
+		err := pingConnPool(replicas[i])
+
+		p.mutex.Lock()
+		wasUnhealthy := p.unhealthy[replicas[i]]
+		p.unhealthy[replicas[i]] = err != nil
+		p.mutex.Unlock()
+
+		switch {
+		case err != nil && !wasUnhealthy:
+			logpkg.Warnw(
+				"db.component", p.component,
+				"db.replica", i,
+				"db.replicaStatus", "unhealthy",
+				"db.error", err.Error(),
+			)
+		case err == nil && wasUnhealthy:
+			logpkg.Infow(
+				"db.component", p.component,
+				"db.replica", i,
+				"db.replicaStatus", "healthy",
+			)
+		}
+	}
+}
+
+// pingConnPool ...
+func pingConnPool(connPool gorm.ConnPool) error {
+	pinger, ok := connPool.(interface {
+		PingContext(ctx context.Context) error
+	})
+	if !ok {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), replicaHealthCheckTimeout)
+	defer cancel()
+	return pinger.PingContext(ctx)
+}
+
+// replicaDSNs 从库dsn
+func replicaDSNs(dsnSlice []string) []string {
+	res := make([]string, 0, len(dsnSlice))
+	for i := range dsnSlice {
+		if dsn := strings.TrimSpace(dsnSlice[i]); dsn != "" {
+			res = append(res, dsn)
+		}
+	}
+	return res
+}
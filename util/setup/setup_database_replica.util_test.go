@@ -0,0 +1,104 @@
+package setuputil
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	gormpkg "github.com/ikaiguang/go-srv-kit/data/gorm"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// fakeConnPool 测试连接池
+type fakeConnPool struct {
+	gorm.ConnPool
+	name    string
+	pingErr error
+}
+
+func (s *fakeConnPool) PingContext(context.Context) error {
+	return s.pingErr
+}
+
+func (s *fakeConnPool) QueryRowContext(context.Context, string, ...interface{}) *sql.Row {
+	return nil
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestReplicaPolicy_Resolve
+func TestReplicaPolicy_Resolve(t *testing.T) {
+	var (
+		replica1 = &fakeConnPool{name: "replica1"}
+		replica2 = &fakeConnPool{name: "replica2"}
+		primary  = &fakeConnPool{name: "primary"}
+		pools    = []gorm.ConnPool{replica1, replica2, primary}
+	)
+	policy := newReplicaPolicy("MySQL-GORM", ReplicaPolicyRoundRobin, nil)
+	defer policy.Close()
+
+	// 轮询
+	got := map[gorm.ConnPool]int{}
+	for i := 0; i < 4; i++ {
+		got[policy.Resolve(pools)]++
+	}
+	require.Equal(t, 2, got[replica1])
+	require.Equal(t, 2, got[replica2])
+	require.Equal(t, 0, got[primary])
+
+	// 剔除不健康的从库
+	replica1.pingErr = sql.ErrConnDone
+	policy.checkReplicas()
+	for i := 0; i < 4; i++ {
+		require.Equal(t, gorm.ConnPool(replica2), policy.Resolve(pools))
+	}
+
+	// 从库均不可用：回退到主库
+	replica2.pingErr = sql.ErrConnDone
+	policy.checkReplicas()
+	require.Equal(t, gorm.ConnPool(primary), policy.Resolve(pools))
+
+	// 恢复
+	replica1.pingErr = nil
+	policy.checkReplicas()
+	require.Equal(t, gorm.ConnPool(replica1), policy.Resolve(pools))
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestRegisterDBReplicas
+func TestRegisterDBReplicas(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	primary, err := db.DB()
+	require.NoError(t, err)
+	defer func() { _ = primary.Close() }()
+
+	policy, err := registerDBReplicas(db, &dbReplicaConfig{
+		component:  "MySQL-GORM",
+		replicas:   []gorm.Dialector{sqlite.Open("file::memory:")},
+		connOption: &gormpkg.ConnOption{},
+	})
+	require.NoError(t, err)
+
+	// 从库的连接池；主库复用已有的连接池
+	require.Len(t, policy.replicaPools, 1)
+	replica, ok := policy.replicaPools[0].(*sql.DB)
+	require.True(t, ok)
+	require.NotSame(t, primary, replica)
+	require.NoError(t, db.Exec("SELECT 1").Error)
+	var one int
+	require.NoError(t, UseReplica(db).Raw("SELECT 1").Scan(&one).Error)
+	require.Equal(t, 1, one)
+	require.Len(t, policy.pools, 2)
+	require.Same(t, primary, policy.pools[1])
+
+	// 替换后关闭从库的连接池，主库不受影响
+	handler := initEngine(&configuration{})
+	handler.storeDBReplicaPolicy(policy)
+	require.Same(t, policy, handler.loadDBReplicaPolicy("MySQL-GORM"))
+	handler.closeReplacedDBReplicaPolicy(policy)
+	require.Nil(t, handler.loadDBReplicaPolicy("MySQL-GORM"))
+	require.ErrorContains(t, replica.Ping(), "database is closed")
+	require.NoError(t, primary.Ping())
+	require.Empty(t, handler.closeDBReplicaPolicies())
+}
@@ -0,0 +1,105 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	etcdregistry "github.com/go-kratos/kratos/contrib/registry/etcd/v2"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	pkgerrors "github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+const (
+	// defaultEtcdDialTimeout 连接超时时间
+	defaultEtcdDialTimeout = 5 * time.Second
+)
+
+// GetEtcdClient etcd 客户端
+func (s *engines) GetEtcdClient() (*clientv3.Client, error) {
+	if s.etcdClient != nil {
+		return s.etcdClient, nil
+	}
+	var err error
+	s.etcdClientMutex.Do(func() {
+		start := time.Now()
+		s.etcdClient, err = s.loadingEtcdClient()
+		s.recordComponent(ComponentEtcd, start, err)
+	})
+	if err != nil {
+		s.etcdClientMutex = sync.Once{}
+	}
+	return s.etcdClient, err
+}
+
+// loadingEtcdClient etcd 客户端
+func (s *engines) loadingEtcdClient() (*clientv3.Client, error) {
+	cfg := s.Config.EtcdConfig()
+	if cfg == nil {
+		stdlog.Println("|*** 加载：Etcd客户端：未初始化")
+		return nil, pkgerrors.WithStack(ErrUninitialized)
+	}
+	stdlog.Println("|*** 加载：Etcd客户端：...")
+
+	etcdConfig, err := toEtcdClientConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	client, err := clientv3.New(etcdConfig)
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "etcd client failed")
+	}
+	return client, nil
+}
+
+// toEtcdClientConfig 客户端配置；配置 tls_*_pem 或 insecure_skip_verify 时使用 tls
+func toEtcdClientConfig(cfg *configs.Infrastructure_Etcd) (clientv3.Config, error) {
+	etcdConfig := clientv3.Config{
+		Endpoints:   cfg.Endpoints,
+		Username:    cfg.Username,
+		Password:    cfg.Password,
+		DialTimeout: defaultEtcdDialTimeout,
+	}
+	if len(etcdConfig.Endpoints) == 0 {
+		return etcdConfig, pkgerrors.New("[请配置服务再启动] config key : etcd.endpoints")
+	}
+	if cfg.DialTimeout.AsDuration() > 0 {
+		etcdConfig.DialTimeout = cfg.DialTimeout.AsDuration()
+	}
+
+	// tls
+	hasTLSPem := cfg.TlsCaPem != "" || cfg.TlsCertPem != "" || cfg.TlsKeyPem != ""
+	if !hasTLSPem && !cfg.InsecureSkipVerify {
+		return etcdConfig, nil
+	}
+	tlsConfig, err := newTLSConfig(&tlsConfigOption{
+		insecureSkipVerify: cfg.InsecureSkipVerify,
+		caPem:              cfg.TlsCaPem,
+		certPem:            cfg.TlsCertPem,
+		keyPem:             cfg.TlsKeyPem,
+	})
+	if err != nil {
+		return etcdConfig, pkgerrors.WithMessage(err, "[请配置服务再启动] etcd")
+	}
+	etcdConfig.TLS = tlsConfig
+	return etcdConfig, nil
+}
+
+// ToEtcdRegistryOptions 服务注册与发现的选项；注册与发现需使用相同的 registry_namespace
+func ToEtcdRegistryOptions(cfg *configs.Infrastructure_Etcd) []etcdregistry.Option {
+	var opts []etcdregistry.Option
+	if cfg == nil {
+		return opts
+	}
+	if cfg.RegistryNamespace != "" {
+		opts = append(opts, etcdregistry.Namespace(cfg.RegistryNamespace))
+	}
+	if cfg.RegistryTtl.AsDuration() > 0 {
+		opts = append(opts, etcdregistry.RegisterTTL(cfg.RegistryTtl.AsDuration()))
+	}
+	if cfg.RegistryMaxRetry > 0 {
+		opts = append(opts, etcdregistry.MaxRetry(int(cfg.RegistryMaxRetry)))
+	}
+	return opts
+}
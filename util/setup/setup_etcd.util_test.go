@@ -0,0 +1,333 @@
+package setuputil
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	etcdregistry "github.com/go-kratos/kratos/contrib/registry/etcd/v2"
+	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	"github.com/stretchr/testify/require"
+	"go.etcd.io/etcd/api/v3/etcdserverpb"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// testingEtcdServer 内存中的 etcd：服务注册与发现使用的 KV、Lease、Watch；租约不过期
+type testingEtcdServer struct {
+	etcdserverpb.UnimplementedKVServer
+	etcdserverpb.UnimplementedLeaseServer
+	etcdserverpb.UnimplementedWatchServer
+
+	mutex    sync.Mutex
+	revision int64
+	leaseID  int64
+	watchID  int64
+	kvs      map[string]*mvccpb.KeyValue
+	watchers map[*testingEtcdWatcher]struct{}
+}
+
+// testingEtcdWatcher 监听 [key, rangeEnd)
+type testingEtcdWatcher struct {
+	id       int64
+	key      []byte
+	rangeEnd []byte
+	events   chan<- *etcdserverpb.WatchResponse
+}
+
+// newTestingEtcdServer 内存中的 etcd 服务；返回客户端地址
+func newTestingEtcdServer(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	etcdServer := &testingEtcdServer{
+		kvs:      make(map[string]*mvccpb.KeyValue),
+		watchers: make(map[*testingEtcdWatcher]struct{}),
+	}
+	server := grpc.NewServer()
+	etcdserverpb.RegisterKVServer(server, etcdServer)
+	etcdserverpb.RegisterLeaseServer(server, etcdServer)
+	etcdserverpb.RegisterWatchServer(server, etcdServer)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// header 需持有锁
+func (s *testingEtcdServer) header() *etcdserverpb.ResponseHeader {
+	return &etcdserverpb.ResponseHeader{Revision: s.revision}
+}
+
+// inRange [key, rangeEnd)；rangeEnd 为空时仅 key，为 \x00 时为大于等于 key 的所有键
+func inRange(k, key, rangeEnd []byte) bool {
+	switch {
+	case len(rangeEnd) == 0:
+		return bytes.Equal(k, key)
+	case bytes.Equal(rangeEnd, []byte{0}):
+		return bytes.Compare(k, key) >= 0
+	default:
+		return bytes.Compare(k, key) >= 0 && bytes.Compare(k, rangeEnd) < 0
+	}
+}
+
+// notify 需持有锁
+func (s *testingEtcdServer) notify(event *mvccpb.Event) {
+	for w := range s.watchers {
+		if inRange(event.Kv.Key, w.key, w.rangeEnd) {
+			w.events <- &etcdserverpb.WatchResponse{Header: s.header(), WatchId: w.id, Events: []*mvccpb.Event{event}}
+		}
+	}
+}
+
+func (s *testingEtcdServer) Range(_ context.Context, req *etcdserverpb.RangeRequest) (*etcdserverpb.RangeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	resp := &etcdserverpb.RangeResponse{Header: s.header()}
+	for k, kv := range s.kvs {
+		if inRange([]byte(k), req.Key, req.RangeEnd) {
+			resp.Kvs = append(resp.Kvs, kv)
+		}
+	}
+	sort.Slice(resp.Kvs, func(i, j int) bool { return bytes.Compare(resp.Kvs[i].Key, resp.Kvs[j].Key) < 0 })
+	resp.Count = int64(len(resp.Kvs))
+	return resp, nil
+}
+
+func (s *testingEtcdServer) Put(_ context.Context, req *etcdserverpb.PutRequest) (*etcdserverpb.PutResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.revision++
+	kv := &mvccpb.KeyValue{Key: req.Key, Value: req.Value, Lease: req.Lease, CreateRevision: s.revision, ModRevision: s.revision, Version: 1}
+	if prev, ok := s.kvs[string(req.Key)]; ok {
+		kv.CreateRevision, kv.Version = prev.CreateRevision, prev.Version+1
+	}
+	s.kvs[string(req.Key)] = kv
+	s.notify(&mvccpb.Event{Type: mvccpb.PUT, Kv: kv})
+	return &etcdserverpb.PutResponse{Header: s.header()}, nil
+}
+
+func (s *testingEtcdServer) DeleteRange(_ context.Context, req *etcdserverpb.DeleteRangeRequest) (*etcdserverpb.DeleteRangeResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.revision++
+	resp := &etcdserverpb.DeleteRangeResponse{}
+	for k := range s.kvs {
+		if inRange([]byte(k), req.Key, req.RangeEnd) {
+			delete(s.kvs, k)
+			resp.Deleted++
+			s.notify(&mvccpb.Event{Type: mvccpb.DELETE, Kv: &mvccpb.KeyValue{Key: []byte(k), ModRevision: s.revision}})
+		}
+	}
+	resp.Header = s.header()
+	return resp, nil
+}
+
+func (s *testingEtcdServer) LeaseGrant(_ context.Context, req *etcdserverpb.LeaseGrantRequest) (*etcdserverpb.LeaseGrantResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.leaseID++
+	return &etcdserverpb.LeaseGrantResponse{Header: s.header(), ID: s.leaseID, TTL: req.TTL}, nil
+}
+
+func (s *testingEtcdServer) LeaseKeepAlive(stream etcdserverpb.Lease_LeaseKeepAliveServer) error {
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		s.mutex.Lock()
+		resp := &etcdserverpb.LeaseKeepAliveResponse{Header: s.header(), ID: req.ID, TTL: 60}
+		s.mutex.Unlock()
+		if err = stream.Send(resp); err != nil {
+			return nil
+		}
+	}
+}
+
+func (s *testingEtcdServer) Watch(stream etcdserverpb.Watch_WatchServer) error {
+	events := make(chan *etcdserverpb.WatchResponse, 64)
+	watchers := make(map[int64]*testingEtcdWatcher)
+	defer func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		for _, w := range watchers {
+			delete(s.watchers, w)
+		}
+	}()
+	go func() {
+		for resp := range events {
+			if stream.Send(resp) != nil {
+				return
+			}
+		}
+	}()
+	for {
+		req, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		s.mutex.Lock()
+		switch {
+		case req.GetCreateRequest() != nil:
+			s.watchID++
+			w := &testingEtcdWatcher{id: s.watchID, key: req.GetCreateRequest().Key, rangeEnd: req.GetCreateRequest().RangeEnd, events: events}
+			watchers[w.id], s.watchers[w] = w, struct{}{}
+			events <- &etcdserverpb.WatchResponse{Header: s.header(), WatchId: w.id, Created: true}
+		case req.GetCancelRequest() != nil:
+			if w, ok := watchers[req.GetCancelRequest().WatchId]; ok {
+				delete(watchers, w.id)
+				delete(s.watchers, w)
+				events <- &etcdserverpb.WatchResponse{Header: s.header(), WatchId: w.id, Canceled: true}
+			}
+		}
+		s.mutex.Unlock()
+	}
+}
+
+func newTestingEtcdRegistryEngine(endpoint string) *engines {
+	return initEngine(&configuration{
+		conf: &configs.Bootstrap{
+			App: &configs.App{
+				ProjectName:   "testing-project",
+				ServerName:    "testing-service",
+				ServerEnv:     "testing",
+				ServerVersion: "v1.0.0",
+				HttpEndpoints: []string{"127.0.0.1:8081"},
+				GrpcEndpoints: []string{"127.0.0.1:9091"},
+			},
+			Infrastructure: &configs.Infrastructure{Etcd: &configs.Infrastructure_Etcd{
+				Enable:            true,
+				Endpoints:         []string{endpoint},
+				RegistryNamespace: "/testing-microservices",
+				RegistryTtl:       durationpb.New(2 * time.Second),
+			}},
+			Setting: &configs.Setting{EnableServiceRegistry: true},
+		},
+	})
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_RegisterService_Etcd
+func TestEngines_RegisterService_Etcd(t *testing.T) {
+	handler := newTestingEtcdRegistryEngine(newTestingEtcdServer(t))
+	defer func() { _ = handler.etcdClient.Close() }()
+
+	require.NoError(t, handler.loadingServiceRegistrar())
+	require.NoError(t, handler.RegisterService(context.Background()))
+	require.Equal(t, registrypkg.RegistryTypeEtcd, handler.GetRegistryType())
+
+	// 服务发现：与注册使用相同的 registry_namespace
+	etcdClient, err := handler.GetEtcdClient()
+	require.NoError(t, err)
+	discovery := etcdregistry.New(etcdClient, ToEtcdRegistryOptions(handler.EtcdConfig())...)
+	serviceName := apputil.ID(handler.AppConfig())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	instances, err := discovery.GetService(ctx, serviceName)
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+	require.Equal(t, handler.GetServiceInstance().ID, instances[0].ID)
+	require.Equal(t, []string{"http://127.0.0.1:8081", "grpc://127.0.0.1:9091"}, instances[0].Endpoints)
+
+	watcher, err := discovery.Watch(ctx, serviceName)
+	require.NoError(t, err)
+	defer func() { _ = watcher.Stop() }()
+	instances, err = watcher.Next()
+	require.NoError(t, err)
+	require.Len(t, instances, 1)
+
+	// 注销后：监听到空的服务列表
+	require.NoError(t, handler.DeregisterService(context.Background()))
+	instances, err = watcher.Next()
+	require.NoError(t, err)
+	require.Empty(t, instances)
+	instances, err = discovery.GetService(ctx, serviceName)
+	require.NoError(t, err)
+	require.Empty(t, instances)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_ServiceRegistryType
+func TestEngines_ServiceRegistryType(t *testing.T) {
+	tests := []struct {
+		name    string
+		conf    *configs.Bootstrap
+		want    registrypkg.RegistryType
+		wantErr bool
+	}{
+		{
+			name: "#consul_first",
+			conf: &configs.Bootstrap{
+				Infrastructure: &configs.Infrastructure{
+					Consul: &configs.Infrastructure_Consul{Enable: true},
+					Etcd:   &configs.Infrastructure_Etcd{Enable: true},
+				},
+			},
+			want: registrypkg.RegistryTypeConsul,
+		},
+		{
+			name: "#etcd_enabled",
+			conf: &configs.Bootstrap{
+				Infrastructure: &configs.Infrastructure{Etcd: &configs.Infrastructure_Etcd{Enable: true}},
+			},
+			want: registrypkg.RegistryTypeEtcd,
+		},
+		{
+			name: "#setting",
+			conf: &configs.Bootstrap{
+				Infrastructure: &configs.Infrastructure{Consul: &configs.Infrastructure_Consul{Enable: true}},
+				Setting:        &configs.Setting{ServiceRegistryType: "ETCD"},
+			},
+			want: registrypkg.RegistryTypeEtcd,
+		},
+		{
+			name:    "#none",
+			conf:    &configs.Bootstrap{},
+			wantErr: true,
+		},
+		{
+			name:    "#invalid",
+			conf:    &configs.Bootstrap{Setting: &configs.Setting{ServiceRegistryType: "zookeeper"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := initEngine(&configuration{conf: tt.conf}).serviceRegistryType()
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestToEtcdClientConfig
+func TestToEtcdClientConfig(t *testing.T) {
+	_, err := toEtcdClientConfig(&configs.Infrastructure_Etcd{})
+	require.Error(t, err)
+
+	etcdConfig, err := toEtcdClientConfig(&configs.Infrastructure_Etcd{
+		Endpoints:   []string{"127.0.0.1:2379"},
+		DialTimeout: durationpb.New(3 * time.Second),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3*time.Second, etcdConfig.DialTimeout)
+	require.Nil(t, etcdConfig.TLS)
+
+	etcdConfig, err = toEtcdClientConfig(&configs.Infrastructure_Etcd{
+		Endpoints:          []string{"127.0.0.1:2379"},
+		InsecureSkipVerify: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, etcdConfig.TLS)
+	require.True(t, etcdConfig.TLS.InsecureSkipVerify)
+	require.Equal(t, defaultEtcdDialTimeout, etcdConfig.DialTimeout)
+}
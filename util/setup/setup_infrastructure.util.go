@@ -3,16 +3,12 @@ package setuputil
 import (
 	stdlog "log"
 	"sync"
+	"time"
 
 	consulapi "github.com/hashicorp/consul/api"
 	consulpkg "github.com/ikaiguang/go-srv-kit/data/consul"
 	gormpkg "github.com/ikaiguang/go-srv-kit/data/gorm"
 	jaegerpkg "github.com/ikaiguang/go-srv-kit/data/jaeger"
-	mysqlpkg "github.com/ikaiguang/go-srv-kit/data/mysql"
-	psqlpkg "github.com/ikaiguang/go-srv-kit/data/postgres"
-	redispkg "github.com/ikaiguang/go-srv-kit/data/redis"
-	middlewarepkg "github.com/ikaiguang/go-srv-kit/kratos/middleware"
-	apputil "github.com/my-saas-platform/api-proto/util/app"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel/exporters/jaeger"
@@ -27,7 +23,9 @@ func (s *engines) GetMySQLGormDB() (*gorm.DB, error) {
 	}
 	var err error
 	s.mysqlGormMutex.Do(func() {
+		start := time.Now()
 		s.mysqlGormDB, err = s.loadingMysqlGormDB()
+		s.recordComponent(ComponentMySQL, start, err)
 	})
 	if err != nil {
 		s.mysqlGormMutex = sync.Once{}
@@ -40,41 +38,85 @@ func (s *engines) reloadMysqlGormDB() error {
 	if s.Config.MySQLConfig() == nil {
 		return nil
 	}
+	previous := s.loadDBReplicaPolicy(instanceComponentName("MySQL-GORM", DefaultInstanceName))
 	dbConn, err := s.loadingMysqlGormDB()
 	if err != nil {
 		return err
 	}
 	*s.mysqlGormDB = *dbConn
+	// 关闭替换的从库
+	s.closeReplacedDBReplicaPolicy(previous)
 	return nil
 }
 
 // loadingMysqlGormDB mysql gorm 数据库
 func (s *engines) loadingMysqlGormDB() (*gorm.DB, error) {
-	if s.Config.MySQLConfig() == nil {
-		stdlog.Println("|*** 加载：MySQL-GORM：未初始化")
+	return s.loadingMysqlGormDBByName(DefaultInstanceName)
+}
+
+// loadingMysqlGormDBByName 命名的 mysql gorm 数据库
+func (s *engines) loadingMysqlGormDBByName(name string) (*gorm.DB, error) {
+	component := instanceComponentName("MySQL-GORM", name)
+	cfg := s.Config.MySQLConfigByName(name)
+	if cfg == nil {
+		stdlog.Println("|*** 加载：" + component + "：未初始化")
 		return nil, pkgerrors.WithStack(ErrUninitialized)
 	}
-	stdlog.Println("|*** 加载：MySQL-GORM：...")
+	stdlog.Println("|*** 加载：" + component + "：...")
 
-	// logger writer
-	var (
-		writers []logger.Writer
-		opts    []gormpkg.Option
-	)
-	if s.Config.EnableLoggingConsole() {
-		writers = append(writers, gormpkg.NewStdWriter())
-	}
-	if s.Config.EnableLoggingFile() {
-		writer, err := s.getLoggerFileWriter()
-		if err != nil {
+	opts, err := s.gormLoggerOptions()
+	if err != nil {
+		return nil, err
+	}
+	dialect, err := newMysqlDialector(name, cfg, cfg.Dsn)
+	if err != nil {
+		return nil, err
+	}
+	connOption := newGormConnOption(cfg, opts...)
+	db, err := gormpkg.NewDB(dialect, connOption)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.withGormLogger(db, connOption); err != nil {
+		_ = closeGormDB(db)
+		return nil, err
+	}
+
+	// 从库
+	var policy *replicaPolicy
+	if dsnSlice := replicaDSNs(cfg.Replicas); len(dsnSlice) > 0 {
+		replicaConfig := &dbReplicaConfig{
+			component:           component,
+			policy:              cfg.ReplicaPolicy,
+			healthCheckInterval: cfg.ReplicaHealthCheckInterval,
+			connOption:          connOption,
+		}
+		for i := range dsnSlice {
+			replica, err := newMysqlDialector(name, cfg, dsnSlice[i])
+			if err != nil {
+				_ = closeGormDB(db)
+				return nil, err
+			}
+			replicaConfig.replicas = append(replicaConfig.replicas, replica)
+		}
+		if policy, err = registerDBReplicas(db, replicaConfig); err != nil {
+			_ = closeGormDB(db)
 			return nil, err
 		}
-		writers = append(writers, gormpkg.NewJSONWriter(writer))
 	}
-	if len(writers) > 0 {
-		opts = append(opts, gormpkg.WithWriters(writers...))
+
+	// 指标
+	if err = s.instrumentGormDB(metricsSystemMySQL, name, db, policy); err != nil {
+		if policy != nil {
+			_ = policy.Close()
+		}
+		_ = closeGormDB(db)
+		return nil, err
+	}
+	if policy != nil {
+		s.storeDBReplicaPolicy(policy)
 	}
-	return mysqlpkg.NewMysqlDB(ToMysqlConfig(s.Config.MySQLConfig()), opts...)
+	return db, nil
 }
 
 // GetPostgresGormDB 数据库
@@ -84,7 +126,9 @@ func (s *engines) GetPostgresGormDB() (*gorm.DB, error) {
 	}
 	var err error
 	s.postgresGormMutex.Do(func() {
+		start := time.Now()
 		s.postgresGormDB, err = s.loadingPostgresGormDB()
+		s.recordComponent(ComponentPostgres, start, err)
 	})
 	if err != nil {
 		s.postgresGormMutex = sync.Once{}
@@ -97,41 +141,102 @@ func (s *engines) reloadPostgresGormDB() error {
 	if s.Config.PostgresConfig() == nil {
 		return nil
 	}
+	previous := s.loadDBReplicaPolicy(instanceComponentName("Postgres-GORM", DefaultInstanceName))
 	dbConn, err := s.loadingPostgresGormDB()
 	if err != nil {
 		return err
 	}
 	*s.postgresGormDB = *dbConn
+	// 关闭替换的从库
+	s.closeReplacedDBReplicaPolicy(previous)
 	return nil
 }
 
 // loadingPostgresGormDB postgres gorm 数据库
 func (s *engines) loadingPostgresGormDB() (*gorm.DB, error) {
-	if s.Config.PostgresConfig() == nil {
-		stdlog.Println("|*** 加载：Postgres-GORM：未初始化")
+	return s.loadingPostgresGormDBByName(DefaultInstanceName)
+}
+
+// loadingPostgresGormDBByName 命名的 postgres gorm 数据库
+func (s *engines) loadingPostgresGormDBByName(name string) (*gorm.DB, error) {
+	component := instanceComponentName("Postgres-GORM", name)
+	cfg := s.Config.PostgresConfigByName(name)
+	if cfg == nil {
+		stdlog.Println("|*** 加载：" + component + "：未初始化")
 		return nil, pkgerrors.WithStack(ErrUninitialized)
 	}
-	stdlog.Println("|*** 加载：Postgres-GORM：...")
+	stdlog.Println("|*** 加载：" + component + "：...")
+
+	opts, err := s.gormLoggerOptions()
+	if err != nil {
+		return nil, err
+	}
+	dialect, err := newPostgresDialector(name, cfg, cfg.Dsn)
+	if err != nil {
+		return nil, err
+	}
+	connOption := newGormConnOption(cfg, opts...)
+	db, err := gormpkg.NewDB(dialect, connOption)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.withGormLogger(db, connOption); err != nil {
+		_ = closeGormDB(db)
+		return nil, err
+	}
 
-	// logger writer
-	var (
-		writers []logger.Writer
-		opts    []gormpkg.Option
-	)
-	if s.Config.EnableLoggingConsole() {
-		writers = append(writers, gormpkg.NewStdWriter())
-	}
-	if s.Config.EnableLoggingFile() {
-		writer, err := s.getLoggerFileWriter()
-		if err != nil {
+	// 从库
+	var policy *replicaPolicy
+	if dsnSlice := replicaDSNs(cfg.Replicas); len(dsnSlice) > 0 {
+		replicaConfig := &dbReplicaConfig{
+			component:           component,
+			policy:              cfg.ReplicaPolicy,
+			healthCheckInterval: cfg.ReplicaHealthCheckInterval,
+			connOption:          connOption,
+		}
+		for i := range dsnSlice {
+			replica, err := newPostgresDialector(name, cfg, dsnSlice[i])
+			if err != nil {
+				_ = closeGormDB(db)
+				return nil, err
+			}
+			replicaConfig.replicas = append(replicaConfig.replicas, replica)
+		}
+		if policy, err = registerDBReplicas(db, replicaConfig); err != nil {
+			_ = closeGormDB(db)
 			return nil, err
 		}
-		writers = append(writers, gormpkg.NewJSONWriter(writer))
 	}
-	if len(writers) > 0 {
+
+	// 指标
+	if err = s.instrumentGormDB(metricsSystemPostgres, name, db, policy); err != nil {
+		if policy != nil {
+			_ = policy.Close()
+		}
+		_ = closeGormDB(db)
+		return nil, err
+	}
+	if policy != nil {
+		s.storeDBReplicaPolicy(policy)
+	}
+	return db, nil
+}
+
+// gormLoggerOptions gorm 日志输出
+func (s *engines) gormLoggerOptions() ([]gormpkg.Option, error) {
+	outputs, err := s.gormLoggerOutputs()
+	if err != nil {
+		return nil, err
+	}
+	var opts []gormpkg.Option
+	if len(outputs) > 0 {
+		writers := make([]logger.Writer, 0, len(outputs))
+		for i := range outputs {
+			writers = append(writers, outputs[i].writer)
+		}
 		opts = append(opts, gormpkg.WithWriters(writers...))
 	}
-	return psqlpkg.NewDB(ToPSQLConfig(s.Config.PostgresConfig()), opts...)
+	return opts, nil
 }
 
 // GetRedisClient redis 客户端
@@ -141,7 +246,9 @@ func (s *engines) GetRedisClient() (redis.UniversalClient, error) {
 	}
 	var err error
 	s.redisClientMutex.Do(func() {
+		start := time.Now()
 		s.redisClient, err = s.loadingRedisClient()
+		s.recordComponent(ComponentRedis, start, err)
 	})
 	if err != nil {
 		s.redisClientMutex = sync.Once{}
@@ -164,20 +271,39 @@ func (s *engines) reloadRedisClient() error {
 
 // loadingRedisClient redis 客户端
 func (s *engines) loadingRedisClient() (redis.UniversalClient, error) {
-	if s.Config.RedisConfig() == nil {
-		stdlog.Println("|*** 加载：Redis客户端：未初始化")
+	return s.loadingRedisClientByName(DefaultInstanceName)
+}
+
+// loadingRedisClientByName 命名的 redis 客户端
+func (s *engines) loadingRedisClientByName(name string) (redis.UniversalClient, error) {
+	component := instanceComponentName("Redis客户端", name)
+	cfg := s.Config.RedisConfigByName(name)
+	if cfg == nil {
+		stdlog.Println("|*** 加载：" + component + "：未初始化")
 		return nil, pkgerrors.WithStack(ErrUninitialized)
 	}
-	stdlog.Println("|*** 加载：Redis客户端：...")
+	stdlog.Println("|*** 加载：" + component + "：...")
+
+	client, err := newRedisClient(cfg)
+	if err != nil {
+		return nil, err
+	}
 
-	return redispkg.NewDB(ToRedisConfig(s.Config.RedisConfig()))
+	// 指标
+	if err = s.instrumentRedisClient(name, client); err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	return client, nil
 }
 
 // GetConsulClient consul 客户端
 func (s *engines) GetConsulClient() (*consulapi.Client, error) {
 	var err error
 	s.consulClientMutex.Do(func() {
+		start := time.Now()
 		s.consulClient, err = s.loadingConsulClient()
+		s.recordComponent(ComponentConsul, start, err)
 	})
 	if err != nil {
 		s.consulClientMutex = sync.Once{}
@@ -218,7 +344,9 @@ func (s *engines) GetJaegerExporter() (*jaeger.Exporter, error) {
 	}
 	var err error
 	s.jaegerTraceExporterMutex.Do(func() {
+		start := time.Now()
 		s.jaegerTraceExporter, err = s.loadingJaegerTraceExporter()
+		s.recordComponent(ComponentJaeger, start, err)
 	})
 	if err != nil {
 		s.jaegerTraceExporterMutex = sync.Once{}
@@ -236,112 +364,3 @@ func (s *engines) loadingJaegerTraceExporter() (*jaeger.Exporter, error) {
 
 	return jaegerpkg.NewJaegerExporter(ToJaegerTracerConfig(s.Config.JaegerConfig()))
 }
-
-// InitTracerProvider trace provider
-func (s *engines) InitTracerProvider() error {
-	// 未开启
-	//settingConfig := s.SettingConfig()
-	//if settingConfig == nil || !settingConfig.EnableServiceTracer {
-	//	return nil
-	//}
-
-	stdlog.Println("|*** 加载：服务追踪：Tracer")
-	// Create the Jaeger exporter
-	var opts []middlewarepkg.TracerOption
-	if cfg := s.JaegerConfig(); cfg != nil && cfg.Enable {
-		exp, err := s.GetJaegerExporter()
-		if err != nil {
-			return err
-		}
-		opts = append(opts, middlewarepkg.WithTracerJaegerExporter(exp))
-	}
-
-	appConfig := s.AppConfig()
-
-	return middlewarepkg.SetTracer(apputil.ID(appConfig), opts...)
-}
-
-// loadingSnowflakeWorker 加载雪花算法
-func (s *engines) loadingSnowflakeWorker() error {
-	//workerConfig := s.SnowflakeWorkerConfig()
-	//if workerConfig == nil {
-	//	stdlog.Println("|*** 加载：雪花算法：未初始化")
-	//	return pkgerrors.WithStack(ErrUninitialized)
-	//}
-	//stdlog.Printf("|*** 加载：雪花算法")
-	//
-	//// http 选项
-	//logger, _, err := s.LoggerMiddleware()
-	//if err != nil {
-	//	return err
-	//}
-	//var httpOptions = []http.ClientOption{
-	//	http.WithMiddleware(
-	//		recovery.Recovery(),
-	//		metadata.Client(),
-	//		tracing.Client(),
-	//		apppkg.ClientLog(logger),
-	//	),
-	//	http.WithResponseDecoder(apppkg.ResponseDecoder),
-	//	http.WithEndpoint(workerConfig.Endpoint),
-	//}
-	//if workerConfig.WithDiscovery {
-	//	consulClient, err := s.GetConsulClient()
-	//	if err != nil {
-	//		return err
-	//	}
-	//	r := consul.New(consulClient)
-	//	httpOptions = append(httpOptions, http.WithDiscovery(r))
-	//}
-	//
-	//// http 链接
-	//httpConn, err := clientpkg.NewHTTPClient(context.Background(), httpOptions...)
-	//if err != nil {
-	//	return pkgerrors.WithStack(err)
-	//}
-	//httpClient := servicev1.NewSrvWorkerHTTPClient(httpConn)
-	//
-	//// 雪花算法ID
-	//appConfig := s.AppConfig()
-	//workerReq := &apiv1.GetNodeIdReq{
-	//	InstanceId:   apppkg.ID(appConfig),
-	//	InstanceName: appConfig.Name,
-	//	Endpoints:    appConfig.Endpoints,
-	//	Metadata:     appConfig.Metadata,
-	//}
-	//workerResp, err := httpClient.GetNodeId(context.Background(), workerReq)
-	//if err != nil {
-	//	return pkgerrors.WithStack(err)
-	//}
-	//
-	//// 雪花算法
-	//stdlog.Printf("|*** 加载：雪花算法：nodeId = %d", workerResp.NodeId)
-	//snowflakeNode, err := snowflake.NewNode(workerResp.NodeId)
-	//if err != nil {
-	//	return pkgerrors.WithStack(err)
-	//}
-	//idpkg.SetNode(snowflakeNode)
-	//
-	//// 续期
-	//extendReq := &apiv1.ExtendNodeIdReq{
-	//	Id:         workerResp.Id,
-	//	InstanceId: workerReq.InstanceId,
-	//	NodeId:     workerResp.NodeId,
-	//}
-	//s.snowflakeStopChannel = make(chan int)
-	//go func() {
-	//	ticker := time.NewTicker(time.Second * 3)
-	//	defer ticker.Stop()
-	//	for {
-	//		select {
-	//		case <-ticker.C:
-	//			//debugpkg.Println("雪花算法：续期：", extendReq.String())
-	//			_, _ = httpClient.ExtendNodeId(context.Background(), extendReq)
-	//		case <-s.snowflakeStopChannel:
-	//			return
-	//		}
-	//	}
-	//}()
-
-	return nil
-}
@@ -0,0 +1,230 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+// gormDBInstance 命名的 gorm 数据库实例
+type gormDBInstance struct {
+	mutex sync.Once
+	db    *gorm.DB
+}
+
+// redisClientInstance 命名的 redis 客户端实例
+type redisClientInstance struct {
+	mutex  sync.Once
+	client redis.UniversalClient
+}
+
+// instanceComponentName 组件名称；例：MySQL-GORM(reporting)
+func instanceComponentName(component, name string) string {
+	if name == DefaultInstanceName {
+		return component
+	}
+	return component + "(" + name + ")"
+}
+
+// sortedInstanceNames 实例名称；排序后保证加载与关闭顺序稳定
+func sortedInstanceNames[T any](instances map[string]T) []string {
+	names := make([]string, 0, len(instances))
+	for name := range instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GetMySQLGormDBByName 命名的 mysql gorm 数据库
+func (s *engines) GetMySQLGormDBByName(name string) (*gorm.DB, error) {
+	if name == DefaultInstanceName {
+		return s.GetMySQLGormDB()
+	}
+	instance := s.getGormDBInstance(&s.mysqlGormInstances, name)
+	if instance.db != nil {
+		return instance.db, nil
+	}
+	var err error
+	instance.mutex.Do(func() {
+		start := time.Now()
+		instance.db, err = s.loadingMysqlGormDBByName(name)
+		s.recordComponent(instanceComponentName(ComponentMySQL, name), start, err)
+	})
+	if err != nil {
+		instance.mutex = sync.Once{}
+	}
+	return instance.db, err
+}
+
+// GetPostgresGormDBByName 命名的 postgres gorm 数据库
+func (s *engines) GetPostgresGormDBByName(name string) (*gorm.DB, error) {
+	if name == DefaultInstanceName {
+		return s.GetPostgresGormDB()
+	}
+	instance := s.getGormDBInstance(&s.postgresGormInstances, name)
+	if instance.db != nil {
+		return instance.db, nil
+	}
+	var err error
+	instance.mutex.Do(func() {
+		start := time.Now()
+		instance.db, err = s.loadingPostgresGormDBByName(name)
+		s.recordComponent(instanceComponentName(ComponentPostgres, name), start, err)
+	})
+	if err != nil {
+		instance.mutex = sync.Once{}
+	}
+	return instance.db, err
+}
+
+// GetRedisClientByName 命名的 redis 客户端
+func (s *engines) GetRedisClientByName(name string) (redis.UniversalClient, error) {
+	if name == DefaultInstanceName {
+		return s.GetRedisClient()
+	}
+	instance := s.getRedisClientInstance(name)
+	if instance.client != nil {
+		return instance.client, nil
+	}
+	var err error
+	instance.mutex.Do(func() {
+		start := time.Now()
+		instance.client, err = s.loadingRedisClientByName(name)
+		s.recordComponent(instanceComponentName(ComponentRedis, name), start, err)
+	})
+	if err != nil {
+		instance.mutex = sync.Once{}
+	}
+	return instance.client, err
+}
+
+// getGormDBInstance 获取或创建 gorm 数据库实例
+func (s *engines) getGormDBInstance(instances *map[string]*gormDBInstance, name string) *gormDBInstance {
+	s.instancesMutex.Lock()
+	defer s.instancesMutex.Unlock()
+
+	if *instances == nil {
+		*instances = make(map[string]*gormDBInstance)
+	}
+	instance, ok := (*instances)[name]
+	if !ok {
+		instance = &gormDBInstance{}
+		(*instances)[name] = instance
+	}
+	return instance
+}
+
+// getRedisClientInstance 获取或创建 redis 客户端实例
+func (s *engines) getRedisClientInstance(name string) *redisClientInstance {
+	s.instancesMutex.Lock()
+	defer s.instancesMutex.Unlock()
+
+	if s.redisClientInstances == nil {
+		s.redisClientInstances = make(map[string]*redisClientInstance)
+	}
+	instance, ok := s.redisClientInstances[name]
+	if !ok {
+		instance = &redisClientInstance{}
+		s.redisClientInstances[name] = instance
+	}
+	return instance
+}
+
+// loadingInstances 加载已启用的命名实例
+func (s *engines) loadingInstances() error {
+	cfg := s.Config.InfrastructureConfig()
+	if cfg == nil {
+		return nil
+	}
+
+	// mysql gorm 数据库
+	for _, name := range sortedInstanceNames(cfg.MysqlInstances) {
+		if !cfg.MysqlInstances[name].GetEnable() {
+			continue
+		}
+		if _, err := s.GetMySQLGormDBByName(name); err != nil {
+			return err
+		}
+	}
+
+	// postgres gorm 数据库
+	for _, name := range sortedInstanceNames(cfg.PsqlInstances) {
+		if !cfg.PsqlInstances[name].GetEnable() {
+			continue
+		}
+		if _, err := s.GetPostgresGormDBByName(name); err != nil {
+			return err
+		}
+	}
+
+	// redis 客户端
+	for _, name := range sortedInstanceNames(cfg.RedisInstances) {
+		if !cfg.RedisInstances[name].GetEnable() {
+			continue
+		}
+		if _, err := s.GetRedisClientByName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// closeInstances 关闭命名实例
+func (s *engines) closeInstances() (errInfos []string) {
+	s.instancesMutex.Lock()
+	defer s.instancesMutex.Unlock()
+
+	for _, name := range sortedInstanceNames(s.redisClientInstances) {
+		instance := s.redisClientInstances[name]
+		if instance.client == nil {
+			continue
+		}
+		stdlog.Println("|*** 退出程序：关闭：" + instanceComponentName("Redis客户端", name))
+		if err := instance.client.Close(); err != nil {
+			errorPrefix := "redisClientInstances[" + name + "].Close error : "
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+		s.unregisterRedisClient(name)
+	}
+
+	for _, name := range sortedInstanceNames(s.mysqlGormInstances) {
+		instance := s.mysqlGormInstances[name]
+		if instance.db == nil {
+			continue
+		}
+		stdlog.Println("|*** 退出程序：关闭：" + instanceComponentName("MySQL-GORM", name))
+		errorPrefix := "mysqlGormInstances[" + name + "].Close error : "
+		if err := closeGormDB(instance.db); err != nil {
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+		s.unregisterGormDB(metricsSystemMySQL, name)
+	}
+
+	for _, name := range sortedInstanceNames(s.postgresGormInstances) {
+		instance := s.postgresGormInstances[name]
+		if instance.db == nil {
+			continue
+		}
+		stdlog.Println("|*** 退出程序：关闭：" + instanceComponentName("Postgres-GORM", name))
+		errorPrefix := "postgresGormInstances[" + name + "].Close error : "
+		if err := closeGormDB(instance.db); err != nil {
+			errInfos = append(errInfos, errorPrefix+err.Error())
+		}
+		s.unregisterGormDB(metricsSystemPostgres, name)
+	}
+	return errInfos
+}
+
+// closeGormDB 关闭 gorm 数据库连接池
+func closeGormDB(db *gorm.DB) error {
+	connPool, err := db.DB()
+	if err != nil {
+		return err
+	}
+	return connPool.Close()
+}
@@ -0,0 +1,36 @@
+package setuputil
+
+import (
+	"testing"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestConfiguration_ConfigByName
+func TestConfiguration_ConfigByName(t *testing.T) {
+	defaultMySQL := &configs.Infrastructure_MySQL{Dsn: "default"}
+	reportingMySQL := &configs.Infrastructure_MySQL{Dsn: "reporting"}
+	cacheRedis := &configs.Infrastructure_Redis{Addresses: []string{"cache:6379"}}
+	handler := &configuration{
+		conf: &configs.Bootstrap{
+			Infrastructure: &configs.Infrastructure{
+				Mysql:          defaultMySQL,
+				MysqlInstances: map[string]*configs.Infrastructure_MySQL{"reporting": reportingMySQL},
+				RedisInstances: map[string]*configs.Infrastructure_Redis{"cache": cacheRedis},
+			},
+		},
+	}
+
+	require.Equal(t, defaultMySQL, handler.MySQLConfigByName(DefaultInstanceName))
+	require.Equal(t, reportingMySQL, handler.MySQLConfigByName("reporting"))
+	require.Nil(t, handler.MySQLConfigByName("unknown"))
+	require.Nil(t, handler.PostgresConfigByName(DefaultInstanceName))
+	require.Nil(t, handler.RedisConfigByName(DefaultInstanceName))
+	require.Equal(t, cacheRedis, handler.RedisConfigByName("cache"))
+	require.Nil(t, handler.checkInstanceNames())
+
+	// 保留名称
+	handler.conf.Infrastructure.RedisInstances[DefaultInstanceName] = cacheRedis
+	require.Error(t, handler.checkInstanceNames())
+}
@@ -0,0 +1,69 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	lockutil "github.com/my-saas-platform/api-proto/util/lock"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// defaultLockerCloseTimeout 关闭时释放持有的锁的超时时间
+	defaultLockerCloseTimeout = 5 * time.Second
+)
+
+// GetLocker 分布式锁
+func (s *engines) GetLocker() (*lockutil.Locker, error) {
+	if s.locker != nil {
+		return s.locker, nil
+	}
+	var err error
+	s.lockerMutex.Do(func() {
+		start := time.Now()
+		s.locker, err = s.loadingLocker()
+		s.recordComponent(ComponentLocker, start, err)
+	})
+	if err != nil {
+		s.lockerMutex = sync.Once{}
+	}
+	return s.locker, err
+}
+
+// loadingLocker 分布式锁；配置多个 setting.lock.redlock_redis_instances 时使用 Redlock
+func (s *engines) loadingLocker() (*lockutil.Locker, error) {
+	cfg := s.Config.SettingConfig().GetLock()
+	opts := []lockutil.Option{
+		lockutil.WithKeyPrefix(apputil.KeyPrefix(s.Config.AppConfig()) + lockutil.DefaultKeyPrefix),
+		lockutil.WithTTL(cfg.GetTtl().AsDuration()),
+		lockutil.WithFenceTTL(cfg.GetFenceTtl().AsDuration()),
+	}
+
+	instanceNames := cfg.GetRedlockRedisInstances()
+	if len(instanceNames) <= 1 {
+		instanceName := DefaultInstanceName
+		if len(instanceNames) == 1 {
+			instanceName = instanceNames[0]
+		}
+		redisCC, err := s.GetRedisClientByName(instanceName)
+		if err != nil {
+			return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 分布式锁需启用 redis")
+		}
+		stdlog.Println("|*** 加载：分布式锁：redis")
+		return lockutil.NewLocker(redisCC, opts...), nil
+	}
+
+	clients := make([]redis.UniversalClient, 0, len(instanceNames))
+	for _, instanceName := range instanceNames {
+		redisCC, err := s.GetRedisClientByName(instanceName)
+		if err != nil {
+			return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 分布式锁需启用 redis 命名实例 : "+instanceName)
+		}
+		clients = append(clients, redisCC)
+	}
+	stdlog.Println("|*** 加载：分布式锁：redlock")
+	return lockutil.NewRedlock(clients, opts...)
+}
@@ -0,0 +1,94 @@
+package setuputil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	lockutil "github.com/my-saas-platform/api-proto/util/lock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_GetLocker
+func TestEngines_GetLocker(t *testing.T) {
+	servers := []*miniredis.Miniredis{miniredis.RunT(t), miniredis.RunT(t), miniredis.RunT(t)}
+	app := &configs.App{ProjectName: "testing-project", ServerName: "testing-service", ServerEnv: "testing"}
+	infra := &configs.Infrastructure{
+		Redis: &configs.Infrastructure_Redis{Enable: true, Addresses: []string{servers[0].Addr()}},
+		RedisInstances: map[string]*configs.Infrastructure_Redis{
+			"lock-a": {Enable: true, Addresses: []string{servers[1].Addr()}},
+			"lock-b": {Enable: true, Addresses: []string{servers[2].Addr()}},
+		},
+	}
+	ctx := context.Background()
+
+	t.Run("#redis", func(t *testing.T) {
+		handler := initEngine(&configuration{conf: &configs.Bootstrap{
+			App:            app,
+			Infrastructure: infra,
+			Setting:        &configs.Setting{Lock: &configs.Setting_Lock{Ttl: durationpb.New(time.Second)}},
+		}})
+		locker, err := handler.GetLocker()
+		require.NoError(t, err)
+		require.False(t, locker.IsRedlock())
+
+		lock, err := locker.TryAcquire(ctx, "testing", 0)
+		require.NoError(t, err)
+		require.True(t, servers[0].Exists(apputil.KeyPrefix(app)+"lock:{testing}"))
+		require.InDelta(t, time.Second, servers[0].TTL(apputil.KeyPrefix(app)+"lock:{testing}"), float64(10*time.Millisecond))
+		require.NoError(t, lock.Release(ctx))
+		require.NoError(t, locker.Close(ctx))
+		require.NoError(t, handler.redisClient.Close())
+	})
+
+	t.Run("#redlock", func(t *testing.T) {
+		handler := initEngine(&configuration{conf: &configs.Bootstrap{
+			App:            app,
+			Infrastructure: infra,
+			Setting: &configs.Setting{Lock: &configs.Setting_Lock{
+				RedlockRedisInstances: []string{DefaultInstanceName, "lock-a", "lock-b"},
+			}},
+		}})
+		locker, err := handler.GetLocker()
+		require.NoError(t, err)
+		require.True(t, locker.IsRedlock())
+
+		lock, err := locker.TryAcquire(ctx, "testing", 0)
+		require.NoError(t, err)
+		for i := range servers {
+			require.True(t, servers[i].Exists(apputil.KeyPrefix(app)+"lock:{testing}"))
+		}
+		require.Equal(t, ComponentStateReady, findComponentStatus(handler, ComponentLocker).State)
+		require.NoError(t, locker.Close(ctx))
+		require.False(t, servers[0].Exists(apputil.KeyPrefix(app)+"lock:{testing}"))
+		require.ErrorIs(t, lock.Release(ctx), lockutil.ErrLockNotHeld)
+		require.Empty(t, handler.closeInstances())
+		require.NoError(t, handler.redisClient.Close())
+	})
+
+	t.Run("#unknown_instance", func(t *testing.T) {
+		handler := initEngine(&configuration{conf: &configs.Bootstrap{
+			App:            app,
+			Infrastructure: infra,
+			Setting:        &configs.Setting{Lock: &configs.Setting_Lock{RedlockRedisInstances: []string{"lock-a", "not-exists"}}},
+		}})
+		_, err := handler.GetLocker()
+		require.Error(t, err)
+		require.Equal(t, ComponentStateFailed, findComponentStatus(handler, ComponentLocker).State)
+		require.Empty(t, handler.closeInstances())
+	})
+}
+
+// findComponentStatus ...
+func findComponentStatus(handler *engines, name string) *ComponentStatus {
+	for _, status := range handler.ComponentStatuses() {
+		if status.Name == name {
+			return status
+		}
+	}
+	return nil
+}
@@ -126,19 +126,33 @@ func (s *engines) loadingLoggerWithCallerSkip(skip int) (logger log.Logger, clos
 		return logger, closeFnSlice, err
 	}
 
+	// 运行时级别；输出使用 DEBUG 级别，由 levelLogger 过滤
+	levels, err := s.getLoggerLevels()
+	if err != nil {
+		return logger, closeFnSlice, err
+	}
+
 	// 日志 输出到控制台
 	loggerConfigForConsole := s.LoggerConfigForConsole()
-	if s.Config.EnableLoggingConsole() && loggerConfigForConsole != nil {
+	fieldSchema, err := s.loggerFieldSchema()
+	if err != nil {
+		return logger, closeFnSlice, err
+	}
+	if fieldSchema != nil {
+		jsonLogger := newJSONConsoleLogger(os.Stderr, log.LevelDebug, skip+1, fieldSchema)
+		closeFnSlice = append(closeFnSlice, jsonLogger)
+		stdLogger = newLevelLogger(jsonLogger, levels.console)
+	} else if s.Config.EnableLoggingConsole() && loggerConfigForConsole != nil {
 		stdLoggerConfig := &logpkg.ConfigStd{
-			Level:      logpkg.ParseLevel(loggerConfigForConsole.Level),
-			CallerSkip: skip,
+			Level:      log.LevelDebug,
+			CallerSkip: skip + 1,
 		}
 		stdLoggerImpl, err := logpkg.NewStdLogger(stdLoggerConfig)
 		if err != nil {
 			return logger, closeFnSlice, err
 		}
 		closeFnSlice = append(closeFnSlice, stdLoggerImpl)
-		stdLogger = stdLoggerImpl
+		stdLogger = newLevelLogger(stdLoggerImpl, levels.console)
 	}
 	// 覆盖 stdLogger
 	loggers = append(loggers, stdLogger)
@@ -148,8 +162,8 @@ func (s *engines) loadingLoggerWithCallerSkip(skip int) (logger log.Logger, clos
 	if s.Config.EnableLoggingFile() && loggerConfigForFile != nil {
 		// file logger
 		fileLoggerConfig := &logpkg.ConfigFile{
-			Level:      logpkg.ParseLevel(loggerConfigForFile.Level),
-			CallerSkip: skip,
+			Level:      log.LevelDebug,
+			CallerSkip: skip + 1,
 
 			Dir:      loggerConfigForFile.Dir,
 			Filename: loggerConfigForFile.Filename,
@@ -172,7 +186,7 @@ func (s *engines) loadingLoggerWithCallerSkip(skip int) (logger log.Logger, clos
 		if err != nil {
 			return logger, closeFnSlice, err
 		}
-		loggers = append(loggers, fileLogger)
+		loggers = append(loggers, newLevelLogger(fileLogger, levels.file))
 	}
 
 	// 日志工具
@@ -256,6 +270,16 @@ func (s *engines) setLoggerPrefixField() *LoggerPrefixField {
 
 // withLoggerPrefix ...
 func (s *engines) withLoggerPrefix(logger log.Logger) log.Logger {
+	// 结构化日志：独立的字段
+	if fieldSchema, _ := s.loggerFieldSchema(); fieldSchema != nil {
+		var kvs = s.SetLoggerPrefixField().Fields(fieldSchema)
+		kvs = append(kvs,
+			fieldSchema.TraceID, log.Valuer(loggerTraceID),
+			fieldSchema.SpanID, log.Valuer(loggerSpanID),
+			fieldSchema.UserID, log.Valuer(loggerUserID),
+		)
+		return log.With(logger, kvs...)
+	}
 	//var kvs = []interface{}{
 	//	"app",
 	//	s.SetLoggerPrefixField().String(),
@@ -271,23 +295,11 @@ func (s *engines) withLoggerTracer() log.Valuer {
 		var (
 			res string
 		)
-		span := trace.SpanContextFromContext(ctx)
-		switch {
-		case span.HasTraceID():
-			res += `traceId="` + span.TraceID().String() + `"`
-		default:
-			if httpTr, ok := contextpkg.MatchHTTPServerContext(ctx); ok {
-				if traceId := httpTr.RequestHeader().Get(headerpkg.RequestID); traceId != "" {
-					res += `traceId="` + traceId + `"`
-				}
-			} else if grpcTr, ok := contextpkg.MatchGRPCServerContext(ctx); ok {
-				if traceId := grpcTr.RequestHeader().Get(headerpkg.RequestID); traceId != "" {
-					res += `traceId="` + traceId + `"`
-				}
-			}
+		if traceId := loggerTraceID(ctx); traceId != "" {
+			res += `traceId="` + traceId.(string) + `"`
 		}
-		if span.HasSpanID() {
-			res += ` spanId="` + span.SpanID().String() + `"`
+		if spanId := loggerSpanID(ctx); spanId != "" {
+			res += ` spanId="` + spanId.(string) + `"`
 		}
 		if claims, ok := authpkg.GetAuthClaimsFromContext(ctx); ok && claims.Payload != nil {
 			if claims.Payload.UserID > 0 {
@@ -299,3 +311,39 @@ func (s *engines) withLoggerTracer() log.Valuer {
 		return res
 	}
 }
+
+// loggerTraceID 链路ID；未启用链路追踪时为请求头 headerpkg.RequestID
+func loggerTraceID(ctx context.Context) interface{} {
+	span := trace.SpanContextFromContext(ctx)
+	if span.HasTraceID() {
+		return span.TraceID().String()
+	}
+	if httpTr, ok := contextpkg.MatchHTTPServerContext(ctx); ok {
+		return httpTr.RequestHeader().Get(headerpkg.RequestID)
+	}
+	if grpcTr, ok := contextpkg.MatchGRPCServerContext(ctx); ok {
+		return grpcTr.RequestHeader().Get(headerpkg.RequestID)
+	}
+	return ""
+}
+
+// loggerSpanID ...
+func loggerSpanID(ctx context.Context) interface{} {
+	span := trace.SpanContextFromContext(ctx)
+	if span.HasSpanID() {
+		return span.SpanID().String()
+	}
+	return ""
+}
+
+// loggerUserID 用户ID；无用户ID时为用户UUID
+func loggerUserID(ctx context.Context) interface{} {
+	claims, ok := authpkg.GetAuthClaimsFromContext(ctx)
+	if !ok || claims.Payload == nil {
+		return ""
+	}
+	if claims.Payload.UserID > 0 {
+		return strconv.FormatUint(claims.Payload.UserID, 10)
+	}
+	return claims.Payload.UserUuid
+}
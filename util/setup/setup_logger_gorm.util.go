@@ -0,0 +1,228 @@
+package setuputil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	gormpkg "github.com/ikaiguang/go-srv-kit/data/gorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"gorm.io/gorm/utils"
+)
+
+// gormLoggerOutput gorm 日志输出；级别跟随控制台、文件日志的运行时级别；
+// logger 不为 nil 时输出到 logger(控制台 json 日志)，否则输出到 writer
+type gormLoggerOutput struct {
+	writer logger.Writer
+	logger log.Logger
+	level  *loggerLevel
+}
+
+// print 输出一条日志
+func (o *gormLoggerOutput) print(level logger.LogLevel, format string, args ...interface{}) {
+	if o.logger == nil {
+		o.writer.Printf(format, args...)
+		return
+	}
+	_ = o.logger.Log(toLogLevel(level), log.DefaultMessageKey, fmt.Sprintf(format, args...))
+}
+
+// logLevel 配置的 logger_level(含 LogMode，如：db.Debug())；运行时调整日志输出的级别期间使用调整的级别；
+// LogMode(logger.Silent) 的会话不输出
+func (o *gormLoggerOutput) logLevel(configured logger.LogLevel) logger.LogLevel {
+	if configured <= logger.Silent {
+		return logger.Silent
+	}
+	if level, overridden := o.level.current(); overridden {
+		return toGormLoggerLevel(level)
+	}
+	return configured
+}
+
+// toGormLoggerLevel ...
+func toGormLoggerLevel(level log.Level) logger.LogLevel {
+	switch {
+	case level <= log.LevelInfo:
+		return logger.Info
+	case level == log.LevelWarn:
+		return logger.Warn
+	default:
+		return logger.Error
+	}
+}
+
+// toLogLevel ...
+func toLogLevel(level logger.LogLevel) log.Level {
+	switch level {
+	case logger.Info:
+		return log.LevelInfo
+	case logger.Warn:
+		return log.LevelWarn
+	default:
+		return log.LevelError
+	}
+}
+
+// gormLoggerOutputs gorm 日志输出；控制台输出 json 时，每条日志为一个 json 对象
+func (s *engines) gormLoggerOutputs() ([]*gormLoggerOutput, error) {
+	levels, err := s.getLoggerLevels()
+	if err != nil {
+		return nil, err
+	}
+	fieldSchema, err := s.loggerFieldSchema()
+	if err != nil {
+		return nil, err
+	}
+	var outputs []*gormLoggerOutput
+	if fieldSchema != nil {
+		jsonLogger := s.withLoggerPrefix(newJSONConsoleLogger(os.Stderr, log.LevelDebug, 0, fieldSchema))
+		outputs = append(outputs, &gormLoggerOutput{logger: jsonLogger, level: levels.console})
+	} else if s.Config.EnableLoggingConsole() {
+		outputs = append(outputs, &gormLoggerOutput{writer: gormpkg.NewStdWriter(), level: levels.console})
+	}
+	if s.Config.EnableLoggingFile() {
+		writer, err := s.getLoggerFileWriter()
+		if err != nil {
+			return nil, err
+		}
+		outputs = append(outputs, &gormLoggerOutput{writer: gormpkg.NewJSONWriter(writer), level: levels.file})
+	}
+	return outputs, nil
+}
+
+// withGormLogger gorm 日志的级别跟随控制台、文件日志的运行时级别；未启用 logger_enable 时不输出
+func (s *engines) withGormLogger(db *gorm.DB, connOption *gormpkg.ConnOption) error {
+	if !connOption.LoggerEnable {
+		return nil
+	}
+	outputs, err := s.gormLoggerOutputs()
+	if err != nil {
+		return err
+	}
+	if len(outputs) == 0 {
+		return nil
+	}
+	db.Logger = newGormLogger(logger.Config{
+		LogLevel:                  connOption.LoggerLevel,
+		SlowThreshold:             connOption.SlowThreshold,
+		Colorful:                  connOption.LoggerColorful,
+		IgnoreRecordNotFoundError: connOption.IgnoreRecordNotFoundError,
+	}, outputs)
+	return nil
+}
+
+var _ logger.Interface = (*gormLogger)(nil)
+
+// gormLogger 同 logger.New 的格式；每个日志输出按各自的级别过滤；
+// 直接调用 utils.FileWithLineNum，不包装 logger.Interface，保证调用位置正确
+type gormLogger struct {
+	config  logger.Config
+	outputs []*gormLoggerOutput
+
+	infoStr, warnStr, errStr            string
+	traceStr, traceWarnStr, traceErrStr string
+}
+
+// newGormLogger ...
+func newGormLogger(config logger.Config, outputs []*gormLoggerOutput) *gormLogger {
+	l := &gormLogger{
+		config:  config,
+		outputs: outputs,
+
+		infoStr:      "%s\n[info] ",
+		warnStr:      "%s\n[warn] ",
+		errStr:       "%s\n[error] ",
+		traceStr:     "%s\n[%.3fms] [rows:%v] %s",
+		traceWarnStr: "%s %s\n[%.3fms] [rows:%v] %s",
+		traceErrStr:  "%s %s\n[%.3fms] [rows:%v] %s",
+	}
+	if config.Colorful {
+		l.infoStr = logger.Green + "%s\n" + logger.Reset + logger.Green + "[info] " + logger.Reset
+		l.warnStr = logger.BlueBold + "%s\n" + logger.Reset + logger.Magenta + "[warn] " + logger.Reset
+		l.errStr = logger.Magenta + "%s\n" + logger.Reset + logger.Red + "[error] " + logger.Reset
+		l.traceStr = logger.Green + "%s\n" + logger.Reset + logger.Yellow + "[%.3fms] " + logger.BlueBold + "[rows:%v]" + logger.Reset + " %s"
+		l.traceWarnStr = logger.Green + "%s " + logger.Yellow + "%s\n" + logger.Reset + logger.RedBold + "[%.3fms] " + logger.Yellow + "[rows:%v]" + logger.Magenta + " %s" + logger.Reset
+		l.traceErrStr = logger.RedBold + "%s " + logger.MagentaBold + "%s\n" + logger.Reset + logger.Yellow + "[%.3fms] " + logger.BlueBold + "[rows:%v]" + logger.Reset + " %s"
+	}
+	return l
+}
+
+// LogMode ...
+func (l *gormLogger) LogMode(level logger.LogLevel) logger.Interface {
+	newLogger := *l
+	newLogger.config.LogLevel = level
+	return &newLogger
+}
+
+// logLevel 所有日志输出中最详细的级别
+func (l *gormLogger) logLevel() logger.LogLevel {
+	level := logger.Silent
+	for _, o := range l.outputs {
+		level = max(level, o.logLevel(l.config.LogLevel))
+	}
+	return level
+}
+
+// printf 输出到级别允许的日志输出
+func (l *gormLogger) printf(level logger.LogLevel, format string, args ...interface{}) {
+	for _, o := range l.outputs {
+		if o.logLevel(l.config.LogLevel) >= level {
+			o.print(level, format, args...)
+		}
+	}
+}
+
+// Info ...
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.logLevel() >= logger.Info {
+		l.printf(logger.Info, l.infoStr+msg, append([]interface{}{utils.FileWithLineNum()}, data...)...)
+	}
+}
+
+// Warn ...
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.logLevel() >= logger.Warn {
+		l.printf(logger.Warn, l.warnStr+msg, append([]interface{}{utils.FileWithLineNum()}, data...)...)
+	}
+}
+
+// Error ...
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.logLevel() >= logger.Error {
+		l.printf(logger.Error, l.errStr+msg, append([]interface{}{utils.FileWithLineNum()}, data...)...)
+	}
+}
+
+// Trace 同 logger.New：错误、慢查询、sql
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	level := l.logLevel()
+	if level <= logger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && level >= logger.Error && (!errors.Is(err, logger.ErrRecordNotFound) || !l.config.IgnoreRecordNotFoundError):
+		sql, rows := fc()
+		l.printf(logger.Error, l.traceErrStr, utils.FileWithLineNum(), err, float64(elapsed.Nanoseconds())/1e6, gormRows(rows), sql)
+	case elapsed > l.config.SlowThreshold && l.config.SlowThreshold != 0 && level >= logger.Warn:
+		sql, rows := fc()
+		slowLog := fmt.Sprintf("SLOW SQL >= %v", l.config.SlowThreshold)
+		l.printf(logger.Warn, l.traceWarnStr, utils.FileWithLineNum(), slowLog, float64(elapsed.Nanoseconds())/1e6, gormRows(rows), sql)
+	case level == logger.Info:
+		sql, rows := fc()
+		l.printf(logger.Info, l.traceStr, utils.FileWithLineNum(), float64(elapsed.Nanoseconds())/1e6, gormRows(rows), sql)
+	}
+}
+
+// gormRows 影响的行数；-1 为 "-"
+func gormRows(rows int64) interface{} {
+	if rows == -1 {
+		return "-"
+	}
+	return rows
+}
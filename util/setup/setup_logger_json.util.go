@@ -0,0 +1,186 @@
+package setuputil
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	pkgerrors "github.com/pkg/errors"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+const (
+	// LoggerFormatText 控制台日志：便于阅读
+	LoggerFormatText = "text"
+	// LoggerFormatJSON 控制台日志：每行一个 json 对象
+	LoggerFormatJSON = "json"
+
+	// LoggerFieldSchemaDefault 字段名：下划线风格
+	LoggerFieldSchemaDefault = "default"
+	// LoggerFieldSchemaECS 字段名：Elastic Common Schema
+	LoggerFieldSchemaECS = "ecs"
+)
+
+// LoggerFieldSchema 结构化日志的字段名；发布后保持不变
+type LoggerFieldSchema struct {
+	Time       string
+	Level      string
+	Caller     string
+	Message    string
+	Stacktrace string
+
+	TraceID  string
+	SpanID   string
+	UserID   string
+	Service  string
+	Env      string
+	Version  string
+	Hostname string
+	ServerIP string
+}
+
+// loggerFieldSchemas 字段名
+var loggerFieldSchemas = map[string]*LoggerFieldSchema{
+	LoggerFieldSchemaDefault: {
+		Time:       "time",
+		Level:      "level",
+		Caller:     "caller",
+		Message:    "msg",
+		Stacktrace: "stack",
+
+		TraceID:  "trace_id",
+		SpanID:   "span_id",
+		UserID:   "user_id",
+		Service:  "service",
+		Env:      "env",
+		Version:  "version",
+		Hostname: "hostname",
+		ServerIP: "server_ip",
+	},
+	LoggerFieldSchemaECS: {
+		Time:       "@timestamp",
+		Level:      "log.level",
+		Caller:     "log.origin.file.name",
+		Message:    "message",
+		Stacktrace: "error.stack_trace",
+
+		TraceID:  "trace.id",
+		SpanID:   "span.id",
+		UserID:   "user.id",
+		Service:  "service.name",
+		Env:      "service.environment",
+		Version:  "service.version",
+		Hostname: "host.hostname",
+		ServerIP: "host.ip",
+	},
+}
+
+// GetLoggerFieldSchema 结构化日志的字段名；name 为空时使用 LoggerFieldSchemaDefault
+func GetLoggerFieldSchema(name string) (*LoggerFieldSchema, error) {
+	if name == "" {
+		name = LoggerFieldSchemaDefault
+	}
+	schema, ok := loggerFieldSchemas[name]
+	if !ok {
+		return nil, pkgerrors.Errorf("[请配置服务再启动] config key : infrastructure.log.field_schema : unknown schema %q", name)
+	}
+	return schema, nil
+}
+
+// loggerFieldSchema 控制台输出 json 时的字段名；输出 text 时为 nil
+func (s *engines) loggerFieldSchema() (*LoggerFieldSchema, error) {
+	consoleConfig := s.LoggerConfigForConsole()
+	if !s.Config.EnableLoggingConsole() || consoleConfig == nil {
+		return nil, nil
+	}
+	switch consoleConfig.GetFormat() {
+	case "", LoggerFormatText:
+		return nil, nil
+	case LoggerFormatJSON:
+		return GetLoggerFieldSchema(s.LoggerConfig().GetFieldSchema())
+	}
+	return nil, pkgerrors.Errorf("[请配置服务再启动] config key : infrastructure.log.console.format : unknown format %q", consoleConfig.GetFormat())
+}
+
+var _ log.Logger = (*jsonConsoleLogger)(nil)
+
+// jsonConsoleLogger 控制台日志：每行一个 json 对象
+type jsonConsoleLogger struct {
+	schema        *LoggerFieldSchema
+	loggerHandler *zap.Logger
+}
+
+// newJSONConsoleLogger 控制台日志：每行一个 json 对象
+func newJSONConsoleLogger(writer io.Writer, level log.Level, skip int, schema *LoggerFieldSchema) *jsonConsoleLogger {
+	encoderConf := zapcore.EncoderConfig{
+		// 消息使用 schema.Message 字段；见 Log
+		MessageKey:    "",
+		LevelKey:      schema.Level,
+		TimeKey:       schema.Time,
+		CallerKey:     schema.Caller,
+		StacktraceKey: schema.Stacktrace,
+
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeLevel:    zapcore.CapitalLevelEncoder,
+		EncodeTime:     zapcore.TimeEncoderOfLayout(time.RFC3339Nano),
+		EncodeDuration: zapcore.StringDurationEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
+	zapCore := zapcore.NewCore(
+		zapcore.NewJSONEncoder(encoderConf),
+		zapcore.Lock(zapcore.AddSync(writer)),
+		zap.NewAtomicLevelAt(logpkg.ToZapLevel(level)),
+	)
+	callerSkip := logpkg.DefaultCallerSkip
+	if skip > 0 {
+		callerSkip = skip
+	}
+	return &jsonConsoleLogger{
+		schema: schema,
+		loggerHandler: zap.New(zapCore,
+			zap.WithCaller(true),
+			zap.AddCallerSkip(callerSkip),
+			zap.AddStacktrace(zapcore.DPanicLevel),
+		),
+	}
+}
+
+// Close zap.Logger.Sync
+func (s *jsonConsoleLogger) Close() error {
+	return s.loggerHandler.Sync()
+}
+
+// Log 键值对输出为独立的字段；消息的键 msg 替换为 schema.Message
+func (s *jsonConsoleLogger) Log(level log.Level, keyvals ...interface{}) error {
+	if len(keyvals) == 0 {
+		return nil
+	}
+	if len(keyvals)%2 != 0 {
+		keyvals = append(keyvals, "KEYVALS UNPAIRED")
+	}
+	fields := make([]zap.Field, 0, len(keyvals)/2)
+	for i := 0; i < len(keyvals); i += 2 {
+		key := fmt.Sprint(keyvals[i])
+		if key == log.DefaultMessageKey {
+			key = s.schema.Message
+		}
+		fields = append(fields, zap.Any(key, keyvals[i+1]))
+	}
+
+	switch level {
+	case log.LevelDebug:
+		s.loggerHandler.Debug("", fields...)
+	case log.LevelInfo:
+		s.loggerHandler.Info("", fields...)
+	case log.LevelWarn:
+		s.loggerHandler.Warn("", fields...)
+	case log.LevelError:
+		s.loggerHandler.Error("", fields...)
+	case log.LevelFatal:
+		s.loggerHandler.Fatal("", fields...)
+	}
+	return nil
+}
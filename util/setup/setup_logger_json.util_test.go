@@ -0,0 +1,81 @@
+package setuputil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	authpkg "github.com/ikaiguang/go-srv-kit/kratos/auth"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_loggerFieldSchema
+func TestEngines_loggerFieldSchema(t *testing.T) {
+	newHandler := func(format, schema string) *engines {
+		return initEngine(&configuration{
+			enableLoggingConsole: true,
+			conf: &configs.Bootstrap{
+				App: &configs.App{ServerName: "testing-service", ServerEnv: "testing", ServerVersion: "v1.0.0"},
+				Infrastructure: &configs.Infrastructure{Log: &configs.Infrastructure_Log{
+					Console:     &configs.Infrastructure_Log_Console{Enable: true, Level: "DEBUG", Format: format},
+					FieldSchema: schema,
+				}},
+			},
+		})
+	}
+
+	// text
+	fieldSchema, err := newHandler("", "").loggerFieldSchema()
+	require.NoError(t, err)
+	require.Nil(t, fieldSchema)
+
+	// 无效的配置
+	_, err = newHandler("xml", "").loggerFieldSchema()
+	require.Error(t, err)
+	_, err = newHandler(LoggerFormatJSON, "unknown").loggerFieldSchema()
+	require.Error(t, err)
+	_, _, err = newHandler(LoggerFormatJSON, "unknown").loadingLoggerWithCallerSkip(0)
+	require.Error(t, err)
+
+	for _, name := range []string{LoggerFieldSchemaDefault, LoggerFieldSchemaECS} {
+		t.Run(name, func(t *testing.T) {
+			handler := newHandler(LoggerFormatJSON, name)
+			fieldSchema, err := handler.loggerFieldSchema()
+			require.NoError(t, err)
+			require.NotNil(t, fieldSchema)
+
+			var buf bytes.Buffer
+			logger := handler.withLoggerPrefix(newJSONConsoleLogger(&buf, log.LevelInfo, 0, fieldSchema))
+
+			traceID, _ := trace.TraceIDFromHex("0102030405060708090a0b0c0d0e0f10")
+			spanID, _ := trace.SpanIDFromHex("0102030405060708")
+			ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID}))
+			ctx = authpkg.PutAuthClaimsIntoContext(ctx, &authpkg.Claims{Payload: &authpkg.Payload{UserID: 10}})
+			helper := log.NewHelper(log.WithContext(ctx, logger))
+			helper.Debug("ignored")
+			helper.Infow("msg", "testing", "count", 2)
+
+			entry := map[string]interface{}{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &entry), buf.String())
+			require.Equal(t, "testing", entry[fieldSchema.Message])
+			require.Equal(t, "INFO", entry[fieldSchema.Level])
+			require.Contains(t, entry, fieldSchema.Time)
+			require.Contains(t, entry, fieldSchema.Caller)
+			require.Equal(t, float64(2), entry["count"])
+			require.Equal(t, traceID.String(), entry[fieldSchema.TraceID])
+			require.Equal(t, spanID.String(), entry[fieldSchema.SpanID])
+			require.Equal(t, "10", entry[fieldSchema.UserID])
+			require.Equal(t, "testing-service", entry[fieldSchema.Service])
+			require.Equal(t, "testing", entry[fieldSchema.Env])
+			require.Equal(t, "v1.0.0", entry[fieldSchema.Version])
+			require.Contains(t, entry, fieldSchema.Hostname)
+			require.Contains(t, entry, fieldSchema.ServerIP)
+			require.NotContains(t, entry, "tracer")
+			require.NotContains(t, entry, "app")
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package setuputil
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// LoggerOutputConsole 日志输出：控制台
+	LoggerOutputConsole = "console"
+	// LoggerOutputFile 日志输出：文件
+	LoggerOutputFile = "file"
+
+	// AdminLoggerLevelPath 管理端点：日志级别；路径为 server.admin.path + AdminLoggerLevelPath
+	AdminLoggerLevelPath = "/log-level"
+
+	// loggerConfigKey 配置：日志
+	loggerConfigKey = "infrastructure.log"
+)
+
+// LoggerLevelStatus 日志的运行时级别
+type LoggerLevelStatus struct {
+	Output string `json:"output"`
+	// Level 当前的级别
+	Level string `json:"level"`
+	// ConfiguredLevel 配置的级别
+	ConfiguredLevel string `json:"configured_level"`
+	// ExpireAt 运行时调整的级别到期时间；到期后恢复配置的级别
+	ExpireAt *time.Time `json:"expire_at,omitempty"`
+}
+
+// loggerLevel 日志输出的运行时级别
+type loggerLevel struct {
+	output string
+	level  atomic.Int32
+
+	mu         sync.Mutex
+	configured log.Level
+	overridden bool
+	expireAt   time.Time
+	timer      *time.Timer
+}
+
+// newLoggerLevel ...
+func newLoggerLevel(output string, configured log.Level) *loggerLevel {
+	l := &loggerLevel{
+		output:     output,
+		configured: configured,
+	}
+	l.level.Store(int32(configured))
+	return l
+}
+
+// Level 当前的级别
+func (l *loggerLevel) Level() log.Level {
+	return log.Level(l.level.Load())
+}
+
+// current 当前的级别；是否为运行时调整的级别
+func (l *loggerLevel) current() (log.Level, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.Level(), l.overridden
+}
+
+// set 运行时调整；ttl 大于 0 时到期恢复配置的级别
+func (l *loggerLevel) set(level log.Level, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.stopTimer()
+	l.overridden = true
+	l.level.Store(int32(level))
+	if ttl > 0 {
+		l.expireAt = time.Now().Add(ttl)
+		var timer *time.Timer
+		timer = time.AfterFunc(ttl, func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			// 已被后续的调整取代
+			if l.timer != timer {
+				return
+			}
+			l.revert()
+		})
+		l.timer = timer
+	}
+}
+
+// reset 恢复配置的级别
+func (l *loggerLevel) reset() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.revert()
+}
+
+// setConfigured 配置的级别变更时取代运行时调整的级别；级别未变更(如：仅变更文件切割)时保留
+func (l *loggerLevel) setConfigured(level log.Level) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if level == l.configured {
+		return
+	}
+	l.configured = level
+	l.revert()
+}
+
+// revert 恢复配置的级别；需持有锁
+func (l *loggerLevel) revert() {
+	l.stopTimer()
+	l.overridden = false
+	l.level.Store(int32(l.configured))
+}
+
+// stopTimer 需持有锁
+func (l *loggerLevel) stopTimer() {
+	if l.timer != nil {
+		l.timer.Stop()
+		l.timer = nil
+	}
+	l.expireAt = time.Time{}
+}
+
+// status ...
+func (l *loggerLevel) status() *LoggerLevelStatus {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	status := &LoggerLevelStatus{
+		Output:          l.output,
+		Level:           l.Level().String(),
+		ConfiguredLevel: l.configured.String(),
+	}
+	if !l.expireAt.IsZero() {
+		expireAt := l.expireAt
+		status.ExpireAt = &expireAt
+	}
+	return status
+}
+
+var _ log.Logger = (*levelLogger)(nil)
+
+// levelLogger 按运行时级别过滤；被包装的日志使用 DEBUG 级别，caller skip 加 1
+type levelLogger struct {
+	logger log.Logger
+	level  *loggerLevel
+}
+
+// newLevelLogger ...
+func newLevelLogger(logger log.Logger, level *loggerLevel) log.Logger {
+	return &levelLogger{logger: logger, level: level}
+}
+
+// Log ...
+func (l *levelLogger) Log(level log.Level, keyvals ...interface{}) error {
+	if level < l.level.Level() {
+		return nil
+	}
+	return l.logger.Log(level, keyvals...)
+}
+
+// loggerLevels 控制台、文件日志的运行时级别；Logger、LoggerHelper、LoggerMiddleware 与 gorm 日志共享
+type loggerLevels struct {
+	console *loggerLevel
+	file    *loggerLevel
+}
+
+// loggerLevelOutputs 启用的日志输出
+func (s *engines) loggerLevelOutputs(levels *loggerLevels) []*loggerLevel {
+	var outputs []*loggerLevel
+	if s.Config.EnableLoggingConsole() && s.LoggerConfigForConsole() != nil {
+		outputs = append(outputs, levels.console)
+	}
+	if s.Config.EnableLoggingFile() && s.LoggerConfigForFile() != nil {
+		outputs = append(outputs, levels.file)
+	}
+	return outputs
+}
+
+// getLoggerLevels 日志的运行时级别
+func (s *engines) getLoggerLevels() (*loggerLevels, error) {
+	if s.loggerLevels != nil {
+		return s.loggerLevels, nil
+	}
+	var err error
+	s.loggerLevelsMutex.Do(func() {
+		s.loggerLevels, err = s.loadingLoggerLevels()
+	})
+	if err != nil {
+		s.loggerLevelsMutex = sync.Once{}
+	}
+	return s.loggerLevels, err
+}
+
+// loadingLoggerLevels 日志的运行时级别；监听配置 infrastructure.log
+func (s *engines) loadingLoggerLevels() (*loggerLevels, error) {
+	levels := &loggerLevels{
+		console: newLoggerLevel(LoggerOutputConsole, logpkg.ParseLevel(s.LoggerConfigForConsole().GetLevel())),
+		file:    newLoggerLevel(LoggerOutputFile, logpkg.ParseLevel(s.LoggerConfigForFile().GetLevel())),
+	}
+
+	// 配置变更
+	var observer = func(k string, v config.Value) {
+		logConfig := &configs.Infrastructure_Log{}
+		if err := v.Scan(logConfig); err != nil {
+			if s.logger != nil {
+				_ = s.logger.Log(log.LevelError,
+					"watch config.Log",
+					"config.Value.Scan(logConfig) err : "+err.Error(),
+				)
+			}
+			return
+		}
+		levels.console.setConfigured(logpkg.ParseLevel(logConfig.GetConsole().GetLevel()))
+		levels.file.setConfigured(logpkg.ParseLevel(logConfig.GetFile().GetLevel()))
+	}
+	err := s.Watch(loggerConfigKey, observer)
+	if err != nil && !pkgerrors.Is(err, config.ErrNotFound) && !IsUninitializedError(err) {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return levels, nil
+}
+
+// LoggerLevels 日志的运行时级别；仅包含启用的日志输出
+func (s *engines) LoggerLevels() ([]*LoggerLevelStatus, error) {
+	levels, err := s.getLoggerLevels()
+	if err != nil {
+		return nil, err
+	}
+	outputs := s.loggerLevelOutputs(levels)
+	statuses := make([]*LoggerLevelStatus, 0, len(outputs))
+	for _, output := range outputs {
+		statuses = append(statuses, output.status())
+	}
+	return statuses, nil
+}
+
+// SetLoggerLevel 调整日志级别；output 为空时调整所有启用的日志输出；ttl 大于 0 时到期恢复配置的级别
+func (s *engines) SetLoggerLevel(output, level string, ttl time.Duration) error {
+	lv, err := parseLoggerLevel(level)
+	if err != nil {
+		return err
+	}
+	if ttl < 0 {
+		return pkgerrors.Errorf("logger level : invalid ttl %s", ttl)
+	}
+	outputs, err := s.matchLoggerLevelOutputs(output)
+	if err != nil {
+		return err
+	}
+	for _, o := range outputs {
+		o.set(lv, ttl)
+	}
+	return nil
+}
+
+// ResetLoggerLevel 恢复配置的级别；output 为空时恢复所有启用的日志输出
+func (s *engines) ResetLoggerLevel(output string) error {
+	outputs, err := s.matchLoggerLevelOutputs(output)
+	if err != nil {
+		return err
+	}
+	for _, o := range outputs {
+		o.reset()
+	}
+	return nil
+}
+
+// matchLoggerLevelOutputs ...
+func (s *engines) matchLoggerLevelOutputs(output string) ([]*loggerLevel, error) {
+	levels, err := s.getLoggerLevels()
+	if err != nil {
+		return nil, err
+	}
+	outputs := s.loggerLevelOutputs(levels)
+	if output == "" {
+		return outputs, nil
+	}
+	for _, o := range outputs {
+		if o.output == output {
+			return []*loggerLevel{o}, nil
+		}
+	}
+	return nil, pkgerrors.Errorf("logger level : output %q is not enabled", output)
+}
+
+// parseLoggerLevel DEBUG、INFO、WARN、ERROR、FATAL；不区分大小写
+func parseLoggerLevel(level string) (log.Level, error) {
+	lv := log.ParseLevel(level)
+	if lv.String() != strings.ToUpper(level) {
+		return lv, pkgerrors.Errorf("logger level : invalid level %q", level)
+	}
+	return lv, nil
+}
+
+// LoggerLevelRequest 管理端点：调整日志级别
+type LoggerLevelRequest struct {
+	// Output 为空时调整所有启用的日志输出；console、file
+	Output string `json:"output"`
+	// Level DEBUG、INFO、WARN、ERROR、FATAL
+	Level string `json:"level"`
+	// TTL 到期恢复配置的级别；例：10m；为空时不恢复
+	TTL string `json:"ttl"`
+}
+
+// LoggerLevelHandler 管理端点：日志级别；GET 查询，PUT 调整，DELETE 恢复配置的级别(?output=)；
+// 配置 server.admin 的基础认证与 ip 白名单
+func (s *engines) LoggerLevelHandler() (http.Handler, error) {
+	cfg := s.Config.AdminConfig()
+	allowList, err := newAdminIPAllowList(cfg)
+	if err != nil {
+		return nil, err
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			req := &LoggerLevelRequest{}
+			if err = json.NewDecoder(http.MaxBytesReader(w, r.Body, 4<<10)).Decode(req); err != nil {
+				http.Error(w, "invalid request body", http.StatusBadRequest)
+				return
+			}
+			var ttl time.Duration
+			if req.TTL != "" {
+				if ttl, err = time.ParseDuration(req.TTL); err != nil {
+					http.Error(w, "invalid ttl", http.StatusBadRequest)
+					return
+				}
+			}
+			err = s.SetLoggerLevel(req.Output, req.Level, ttl)
+		case http.MethodDelete:
+			err = s.ResetLoggerLevel(r.URL.Query().Get("output"))
+		default:
+			w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPut, http.MethodDelete}, ", "))
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		statuses, err := s.LoggerLevels()
+		if err != nil {
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		_ = encoder.Encode(statuses)
+	})
+	return withAdminAccess(cfg, allowList, handler), nil
+}
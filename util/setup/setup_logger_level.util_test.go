@@ -0,0 +1,256 @@
+package setuputil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	gormpkg "github.com/ikaiguang/go-srv-kit/data/gorm"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestingLoggerLevelEngine 启用控制台与文件日志
+func newTestingLoggerLevelEngine(consoleLevel, fileLevel string) *engines {
+	return initEngine(&configuration{
+		enableLoggingConsole: true,
+		enableLoggingFile:    true,
+		conf: &configs.Bootstrap{
+			App: &configs.App{ServerName: "testing-service"},
+			Infrastructure: &configs.Infrastructure{Log: &configs.Infrastructure_Log{
+				Console: &configs.Infrastructure_Log_Console{Enable: true, Level: consoleLevel, Format: LoggerFormatJSON},
+				File:    &configs.Infrastructure_Log_File{Enable: true, Level: fileLevel},
+			}},
+			Server: &configs.Server{Admin: &configs.Server_Admin{Enable: true}},
+		},
+	})
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_SetLoggerLevel
+func TestEngines_SetLoggerLevel(t *testing.T) {
+	handler := newTestingLoggerLevelEngine("INFO", "WARN")
+	levels, err := handler.getLoggerLevels()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	fieldSchema, err := handler.loggerFieldSchema()
+	require.NoError(t, err)
+	// log.NewHelper：logpkg.DefaultCallerSkip；levelLogger：加 1
+	jsonLogger := newJSONConsoleLogger(&buf, log.LevelDebug, logpkg.DefaultCallerSkip+1, fieldSchema)
+	helper := log.NewHelper(handler.withLoggerPrefix(newLevelLogger(jsonLogger, levels.console)))
+
+	helper.Debug("debug-1")
+	require.Empty(t, buf.String())
+
+	// 运行时调整；到期恢复配置的级别
+	require.NoError(t, handler.SetLoggerLevel(LoggerOutputConsole, "debug", 50*time.Millisecond))
+	helper.Debug("debug-2")
+	entry := map[string]interface{}{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry), buf.String())
+	require.Equal(t, "debug-2", entry[fieldSchema.Message])
+	require.Contains(t, entry[fieldSchema.Caller], "setup_logger_level.util_test.go")
+
+	statuses, err := handler.LoggerLevels()
+	require.NoError(t, err)
+	require.Len(t, statuses, 2)
+	require.Equal(t, "DEBUG", statuses[0].Level)
+	require.Equal(t, "INFO", statuses[0].ConfiguredLevel)
+	require.NotNil(t, statuses[0].ExpireAt)
+	require.Equal(t, "WARN", statuses[1].Level)
+	require.Eventually(t, func() bool {
+		return levels.console.Level() == log.LevelInfo
+	}, time.Second, 10*time.Millisecond)
+	buf.Reset()
+	helper.Debug("debug-3")
+	require.Empty(t, buf.String())
+
+	// 所有输出；后续的调整取代到期恢复
+	require.NoError(t, handler.SetLoggerLevel("", "ERROR", 20*time.Millisecond))
+	require.NoError(t, handler.SetLoggerLevel("", "DEBUG", 0))
+	time.Sleep(50 * time.Millisecond)
+	require.Equal(t, log.LevelDebug, levels.console.Level())
+	require.Equal(t, log.LevelDebug, levels.file.Level())
+	require.NoError(t, handler.ResetLoggerLevel(LoggerOutputFile))
+	require.Equal(t, log.LevelWarn, levels.file.Level())
+
+	// 配置的级别未变更：保留运行时调整的级别
+	levels.console.setConfigured(log.LevelInfo)
+	require.Equal(t, log.LevelDebug, levels.console.Level())
+
+	// 配置的级别变更：取代运行时调整的级别
+	levels.console.setConfigured(log.LevelError)
+	require.Equal(t, log.LevelError, levels.console.Level())
+
+	// 无效的参数
+	require.Error(t, handler.SetLoggerLevel("", "VERBOSE", 0))
+	require.Error(t, handler.SetLoggerLevel("unknown", "DEBUG", 0))
+	require.Error(t, handler.SetLoggerLevel("", "DEBUG", -time.Second))
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_LoggerLevelHandler
+func TestEngines_LoggerLevelHandler(t *testing.T) {
+	handler := newTestingLoggerLevelEngine("INFO", "INFO")
+	levelHandler, err := handler.LoggerLevelHandler()
+	require.NoError(t, err)
+	serve := func(method, target, body string) (*httptest.ResponseRecorder, []*LoggerLevelStatus) {
+		recorder := httptest.NewRecorder()
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		// 未配置基础认证与 ip 白名单：仅允许本机访问
+		req.RemoteAddr = "127.0.0.1:5000"
+		levelHandler.ServeHTTP(recorder, req)
+		var statuses []*LoggerLevelStatus
+		if recorder.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &statuses))
+		}
+		return recorder, statuses
+	}
+
+	recorder, statuses := serve(http.MethodPut, DefaultAdminPath+AdminLoggerLevelPath, `{"output":"console","level":"DEBUG","ttl":"10m"}`)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "DEBUG", statuses[0].Level)
+	require.WithinDuration(t, time.Now().Add(10*time.Minute), *statuses[0].ExpireAt, time.Minute)
+	require.Equal(t, "INFO", statuses[1].Level)
+
+	_, statuses = serve(http.MethodGet, DefaultAdminPath+AdminLoggerLevelPath, "")
+	require.Equal(t, "DEBUG", statuses[0].Level)
+
+	recorder, statuses = serve(http.MethodDelete, DefaultAdminPath+AdminLoggerLevelPath+"?output=console", "")
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, "INFO", statuses[0].Level)
+	require.Nil(t, statuses[0].ExpireAt)
+
+	recorder, _ = serve(http.MethodPut, DefaultAdminPath+AdminLoggerLevelPath, `{"level":"DEBUG","ttl":"soon"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = serve(http.MethodPut, DefaultAdminPath+AdminLoggerLevelPath, `{"level":"VERBOSE"}`)
+	require.Equal(t, http.StatusBadRequest, recorder.Code)
+	recorder, _ = serve(http.MethodPost, DefaultAdminPath+AdminLoggerLevelPath, "")
+	require.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+
+	recorder = httptest.NewRecorder()
+	levelHandler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPut, DefaultAdminPath+AdminLoggerLevelPath, strings.NewReader(`{"level":"DEBUG"}`)))
+	require.Equal(t, http.StatusForbidden, recorder.Code)
+}
+
+// testingGormWriter ...
+type testingGormWriter struct {
+	lines []string
+}
+
+// Printf ...
+func (w *testingGormWriter) Printf(format string, args ...interface{}) {
+	w.lines = append(w.lines, fmt.Sprintf(format, args...))
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestGormLogger
+func TestGormLogger(t *testing.T) {
+	var (
+		ctx          = context.Background()
+		consoleLevel = newLoggerLevel(LoggerOutputConsole, log.LevelInfo)
+		fileLevel    = newLoggerLevel(LoggerOutputFile, log.LevelError)
+		console      = &testingGormWriter{}
+		file         = &testingGormWriter{}
+		trace        = func() (string, int64) { return "SELECT 1", 1 }
+	)
+	gormLogger := newGormLogger(logger.Config{LogLevel: logger.Warn, SlowThreshold: time.Second}, []*gormLoggerOutput{
+		{writer: console, level: consoleLevel},
+		{writer: file, level: fileLevel},
+	})
+
+	// 未调整：使用配置的 logger_level，与日志输出的级别无关
+	gormLogger.Trace(ctx, time.Now(), trace, nil)
+	require.Empty(t, console.lines)
+	gormLogger.Trace(ctx, time.Now().Add(-2*time.Second), trace, nil)
+	require.Len(t, console.lines, 1)
+	require.Contains(t, console.lines[0], "SLOW SQL")
+	require.Len(t, file.lines, 1)
+	gormLogger.Trace(ctx, time.Now(), trace, logger.ErrRecordNotFound)
+	require.Len(t, console.lines, 2)
+	require.Len(t, file.lines, 2)
+	require.Contains(t, file.lines[1], "setup_logger_level.util_test.go")
+	console.lines, file.lines = nil, nil
+
+	// db.Debug()：日志输出的级别为 ERROR 时仍输出 sql
+	gormLogger.LogMode(logger.Info).Trace(ctx, time.Now(), trace, nil)
+	require.Len(t, file.lines, 1)
+	require.Contains(t, file.lines[0], "SELECT 1")
+	console.lines, file.lines = nil, nil
+
+	// 运行时调整期间使用调整的级别
+	consoleLevel.set(log.LevelDebug, 0)
+	fileLevel.set(log.LevelError, 0)
+	gormLogger.Trace(ctx, time.Now(), trace, nil)
+	require.Len(t, console.lines, 1)
+	require.Contains(t, console.lines[0], "SELECT 1")
+	require.Empty(t, file.lines)
+	gormLogger.Warn(ctx, "warn")
+	require.Len(t, console.lines, 2)
+	require.Empty(t, file.lines)
+	gormLogger.LogMode(logger.Silent).Info(ctx, "info")
+	require.Len(t, console.lines, 2)
+
+	consoleLevel.reset()
+	fileLevel.reset()
+	gormLogger.Info(ctx, "info")
+	require.Len(t, console.lines, 2)
+	gormLogger.Warn(ctx, "warn")
+	require.Len(t, console.lines, 3)
+	require.Len(t, file.lines, 1)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestGormLogger_JSONConsole
+func TestGormLogger_JSONConsole(t *testing.T) {
+	reader, writer, err := os.Pipe()
+	require.NoError(t, err)
+	stderr := os.Stderr
+	os.Stderr = writer
+	defer func() { os.Stderr = stderr }()
+
+	handler := initEngine(&configuration{
+		enableLoggingConsole: true,
+		conf: &configs.Bootstrap{
+			App: &configs.App{ServerName: "testing-service"},
+			Infrastructure: &configs.Infrastructure{Log: &configs.Infrastructure_Log{
+				Console: &configs.Infrastructure_Log_Console{Enable: true, Level: "DEBUG", Format: LoggerFormatJSON},
+			}},
+		},
+	})
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	require.NoError(t, err)
+	require.NoError(t, handler.withGormLogger(db, &gormpkg.ConnOption{
+		LoggerEnable:   true,
+		LoggerLevel:    logger.Info,
+		LoggerColorful: true,
+		SlowThreshold:  time.Nanosecond,
+	}))
+	db = db.Session(&gorm.Session{Logger: db.Logger.LogMode(logger.Info)})
+	require.NoError(t, db.Exec("SELECT 1").Error)
+	require.Error(t, db.Exec("SELECT * FROM not_exists").Error)
+	db.Logger.Info(context.Background(), "info %d", 1)
+	os.Stderr = stderr
+	require.NoError(t, writer.Close())
+
+	output, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	require.GreaterOrEqual(t, len(lines), 3, string(output))
+	for _, line := range lines {
+		entry := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal([]byte(line), &entry), line)
+		require.Contains(t, entry, "msg")
+		require.Equal(t, "testing-service", entry["service"])
+	}
+	require.Contains(t, string(output), "not_exists")
+}
@@ -0,0 +1,53 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	loginguardutil "github.com/my-saas-platform/api-proto/util/loginguard"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// GetLoginGuard 登录错误锁定
+func (s *engines) GetLoginGuard() (*loginguardutil.Guard, error) {
+	if s.loginGuard != nil {
+		return s.loginGuard, nil
+	}
+	var err error
+	s.loginGuardMutex.Do(func() {
+		start := time.Now()
+		s.loginGuard, err = s.loadingLoginGuard()
+		s.recordComponent(ComponentLoginGuard, start, err)
+	})
+	if err != nil {
+		s.loginGuardMutex = sync.Once{}
+	}
+	return s.loginGuard, err
+}
+
+// loadingLoginGuard 登录错误锁定；规则为 setting.login
+func (s *engines) loadingLoginGuard() (*loginguardutil.Guard, error) {
+	cfg := s.Config.SettingConfig().GetLogin()
+	location := time.Local
+	if cfg.GetTimezone() != "" {
+		var err error
+		if location, err = time.LoadLocation(cfg.GetTimezone()); err != nil {
+			return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] setting.login.timezone")
+		}
+	}
+	redisCC, err := s.GetRedisClient()
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 登录错误锁定需启用 redis")
+	}
+
+	stdlog.Println("|*** 加载：登录错误锁定")
+	return loginguardutil.NewGuard(redisCC, loginguardutil.Config{
+		SerialTimes:     int64(cfg.GetPasswordErrSerialTimes()),
+		SerialDuration:  cfg.GetPasswordErrSerialDuration().AsDuration(),
+		LockDuration:    cfg.GetPasswordErrLockDuration().AsDuration(),
+		DailyLimitTimes: int64(cfg.GetPasswordErrDailyLimitTimes()),
+		Location:        location,
+	}, loginguardutil.WithKeyPrefix(apputil.KeyPrefix(s.Config.AppConfig())+loginguardutil.DefaultKeyPrefix)), nil
+}
@@ -0,0 +1,57 @@
+package setuputil
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	loginguardutil "github.com/my-saas-platform/api-proto/util/loginguard"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_GetLoginGuard
+func TestEngines_GetLoginGuard(t *testing.T) {
+	server := miniredis.RunT(t)
+	app := &configs.App{ProjectName: "testing-project", ServerName: "testing-service", ServerEnv: "testing"}
+	infra := &configs.Infrastructure{
+		Redis: &configs.Infrastructure_Redis{Enable: true, Addresses: []string{server.Addr()}},
+	}
+	ctx := context.Background()
+
+	t.Run("#redis", func(t *testing.T) {
+		handler := initEngine(&configuration{conf: &configs.Bootstrap{
+			App:            app,
+			Infrastructure: infra,
+			Setting: &configs.Setting{Login: &configs.Setting_Login{
+				PasswordErrSerialTimes:     1,
+				PasswordErrLockDuration:    durationpb.New(time.Minute),
+				PasswordErrDailyLimitTimes: 10,
+				Timezone:                   "Asia/Shanghai",
+			}},
+		}})
+		guard, err := handler.GetLoginGuard()
+		require.NoError(t, err)
+
+		_, err = guard.RecordFailure(ctx, "user-1", "")
+		require.ErrorIs(t, err, loginguardutil.ErrLocked)
+		require.True(t, server.Exists(apputil.ID(app)+":login:{account:user-1}:lock"))
+		require.Equal(t, time.Minute, server.TTL(apputil.ID(app)+":login:{account:user-1}:lock"))
+		require.Equal(t, ComponentStateReady, findComponentStatus(handler, ComponentLoginGuard).State)
+		require.NoError(t, handler.redisClient.Close())
+	})
+
+	t.Run("#invalid_timezone", func(t *testing.T) {
+		handler := initEngine(&configuration{conf: &configs.Bootstrap{
+			App:            app,
+			Infrastructure: infra,
+			Setting:        &configs.Setting{Login: &configs.Setting_Login{Timezone: "testing"}},
+		}})
+		_, err := handler.GetLoginGuard()
+		require.Error(t, err)
+		require.Equal(t, ComponentStateFailed, findComponentStatus(handler, ComponentLoginGuard).State)
+	})
+}
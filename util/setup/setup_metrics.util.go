@@ -0,0 +1,128 @@
+package setuputil
+
+import (
+	"database/sql"
+	stdlog "log"
+	"sync"
+	"time"
+
+	metricsutil "github.com/my-saas-platform/api-proto/util/metrics"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	// 数据库指标的 system 标签
+	metricsSystemMySQL    = "mysql"
+	metricsSystemPostgres = "postgres"
+)
+
+// GetMetrics prometheus 指标
+func (s *engines) GetMetrics() (*metricsutil.Metrics, error) {
+	if s.metrics != nil {
+		return s.metrics, nil
+	}
+	var err error
+	s.metricsMutex.Do(func() {
+		start := time.Now()
+		s.metrics, err = s.loadingMetrics()
+		s.recordComponent(ComponentMetrics, start, err)
+	})
+	if err != nil {
+		s.metricsMutex = sync.Once{}
+	}
+	return s.metrics, err
+}
+
+// loadingMetrics prometheus 指标；指标名称前缀为 app.server_name
+func (s *engines) loadingMetrics() (*metricsutil.Metrics, error) {
+	cfg := s.Config.MetricsConfig()
+	if cfg == nil {
+		stdlog.Println("|*** 加载：Prometheus指标：未初始化")
+		return nil, pkgerrors.WithStack(ErrUninitialized)
+	}
+	stdlog.Println("|*** 加载：Prometheus指标：...")
+
+	serverName := s.Config.AppConfig().GetServerName()
+	if metricsutil.Namespace(serverName) == "" {
+		return nil, pkgerrors.New("[请配置服务再启动] config key : app.server_name")
+	}
+	return metricsutil.NewMetrics(serverName,
+		metricsutil.WithPath(cfg.Path),
+		metricsutil.WithDurationBuckets(cfg.DurationBuckets),
+	)
+}
+
+// enabledMetrics 配置 server.metrics.enable = true 时的指标；未启用时为 nil
+func (s *engines) enabledMetrics() (*metricsutil.Metrics, error) {
+	if cfg := s.Config.MetricsConfig(); cfg == nil || !cfg.Enable {
+		return nil, nil
+	}
+	return s.GetMetrics()
+}
+
+// instrumentGormDB 数据库指标：查询耗时、连接池；包括从库的连接池
+func (s *engines) instrumentGormDB(system, name string, db *gorm.DB, policy *replicaPolicy) error {
+	m, err := s.enabledMetrics()
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		// 重新加载时关闭指标：移除替换的连接池
+		s.unregisterGormDB(system, name)
+		return nil
+	}
+	if err = db.Use(m.GormPlugin(system, name)); err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	connPool, err := db.DB()
+	if err != nil {
+		return pkgerrors.WithStack(err)
+	}
+	m.RegisterDBStats(system, name, connPool)
+
+	// 从库；序号为配置的从库序号
+	var replicas []*sql.DB
+	if policy != nil {
+		replicas = make([]*sql.DB, len(policy.replicaPools))
+		for i := range policy.replicaPools {
+			connPool := policy.replicaPools[i]
+			if preparedStmtDB, ok := connPool.(*gorm.PreparedStmtDB); ok {
+				connPool = preparedStmtDB.ConnPool
+			}
+			replicas[i], _ = connPool.(*sql.DB)
+		}
+	}
+	m.RegisterDBReplicaStats(system, name, replicas)
+	return nil
+}
+
+// unregisterGormDB 关闭数据库后移除连接池指标；包括从库；重新加载时由注册替换
+func (s *engines) unregisterGormDB(system, name string) {
+	if s.metrics != nil {
+		s.metrics.UnregisterDBStats(system, name)
+	}
+}
+
+// instrumentRedisClient redis 指标：命令耗时、连接池
+func (s *engines) instrumentRedisClient(name string, client redis.UniversalClient) error {
+	m, err := s.enabledMetrics()
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		s.unregisterRedisClient(name)
+		return nil
+	}
+	client.AddHook(m.RedisHook(name))
+	m.RegisterRedisStats(name, client)
+	return nil
+}
+
+// unregisterRedisClient 关闭 redis 客户端后移除连接池指标；重新加载时由注册替换
+func (s *engines) unregisterRedisClient(name string) {
+	if s.metrics != nil {
+		s.metrics.UnregisterRedisStats(name)
+	}
+}
@@ -0,0 +1,96 @@
+package setuputil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	gormpkg "github.com/ikaiguang/go-srv-kit/data/gorm"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_GetMetrics
+func TestEngines_GetMetrics(t *testing.T) {
+	server := miniredis.RunT(t)
+	handler := initEngine(&configuration{
+		conf: &configs.Bootstrap{
+			App: &configs.App{ServerName: "saas-ping-server"},
+			Server: &configs.Server{
+				Metrics: &configs.Server_Metrics{Enable: true, Path: "/internal/metrics"},
+			},
+			Infrastructure: &configs.Infrastructure{
+				Redis: &configs.Infrastructure_Redis{Enable: true, Addresses: []string{server.Addr()}},
+			},
+		},
+	})
+
+	metrics, err := handler.GetMetrics()
+	require.NoError(t, err)
+	again, err := handler.GetMetrics()
+	require.NoError(t, err)
+	require.Same(t, metrics, again)
+	require.Equal(t, "/internal/metrics", metrics.Path())
+
+	// redis 自动记录指标
+	redisCC, err := handler.GetRedisClient()
+	require.NoError(t, err)
+	defer func() { _ = redisCC.Close() }()
+	require.NoError(t, redisCC.Set(context.Background(), "testing", "value", 0).Err())
+
+	count, err := testutil.GatherAndCount(metrics.Registry(),
+		"saas_ping_server_redis_command_duration_seconds",
+		"saas_ping_server_redis_pool_connections",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+
+	// 数据库：主库与从库的连接池
+	db, err := gorm.Open(sqlite.Open("file::memory:"), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	require.NoError(t, err)
+	primary, err := db.DB()
+	require.NoError(t, err)
+	defer func() { _ = primary.Close() }()
+	policy, err := registerDBReplicas(db, &dbReplicaConfig{
+		component:  "MySQL-GORM",
+		replicas:   []gorm.Dialector{sqlite.Open("file::memory:")},
+		connOption: &gormpkg.ConnOption{},
+	})
+	require.NoError(t, err)
+	defer func() { _ = policy.Close() }()
+	require.NoError(t, handler.instrumentGormDB(metricsSystemMySQL, DefaultInstanceName, db, policy))
+	count, err = testutil.GatherAndCount(metrics.Registry(), "saas_ping_server_db_pool_max_open_connections")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	// 关闭后移除连接池指标
+	handler.unregisterGormDB(metricsSystemMySQL, DefaultInstanceName)
+	handler.unregisterRedisClient(DefaultInstanceName)
+	count, err = testutil.GatherAndCount(metrics.Registry(),
+		"saas_ping_server_db_pool_max_open_connections",
+		"saas_ping_server_redis_pool_connections",
+	)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_GetMetrics_NoServerName
+func TestEngines_GetMetrics_NoServerName(t *testing.T) {
+	handler := initEngine(&configuration{
+		conf: &configs.Bootstrap{
+			Server: &configs.Server{Metrics: &configs.Server_Metrics{Enable: true}},
+		},
+	})
+	_, err := handler.GetMetrics()
+	require.Error(t, err)
+
+	// 未启用：不记录指标
+	handler = initEngine(&configuration{conf: &configs.Bootstrap{}})
+	metrics, err := handler.enabledMetrics()
+	require.NoError(t, err)
+	require.Nil(t, metrics)
+}
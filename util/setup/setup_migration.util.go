@@ -0,0 +1,115 @@
+package setuputil
+
+import (
+	"context"
+	stdlog "log"
+	"os"
+	"sync"
+	"time"
+
+	migrationutil "github.com/my-saas-platform/api-proto/util/migration"
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// MigrateCommand 数据库迁移命令：status、up、down、redo；仅加载配置、日志与数据库，
+// 不注册服务，不启动定时任务、事务发件箱与雪花算法
+// 例：./service -conf ./configs migrate status
+//
+//	if args := flag.Args(); len(args) > 0 && args[0] == "migrate" {
+//		err = setuputil.MigrateCommand(args[1:], setuputil.WithMigrations(migrations...))
+//	}
+func MigrateCommand(args []string, opts ...Option) (err error) {
+	setupOpts := newSetupOptions(opts...)
+	configHandler, err := newConfigHandler(setupOpts)
+	if err != nil {
+		return err
+	}
+	engineHandler, err := newMigrateEngine(configHandler, setupOpts)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := engineHandler.Close(); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	migrator, err := engineHandler.GetMigrator()
+	if err != nil {
+		return err
+	}
+	return migrationutil.RunCommand(context.Background(), migrator, args, os.Stdout)
+}
+
+// newMigrateEngine 数据库迁移命令：配置、日志与数据库
+func newMigrateEngine(configHandler Config, setupOpts *options) (handler *engines, err error) {
+	handler = initEngine(configHandler)
+	handler.migrations = setupOpts.migrations
+	handler.migrationOptions = setupOpts.migrationOptions
+	defer func() {
+		if err != nil {
+			_ = handler.Close()
+		}
+	}()
+
+	if _, err = handler.loadingLogHelper(); err != nil {
+		return nil, err
+	}
+	if _, err = handler.GetMigrator(); err != nil {
+		return nil, err
+	}
+	return handler, nil
+}
+
+// GetMigrator 数据库迁移
+func (s *engines) GetMigrator() (*migrationutil.Migrator, error) {
+	if s.migrator != nil {
+		return s.migrator, nil
+	}
+	var err error
+	s.migratorMutex.Do(func() {
+		start := time.Now()
+		s.migrator, err = s.loadingMigrator()
+		s.recordComponent(ComponentMigrator, start, err)
+	})
+	if err != nil {
+		s.migratorMutex = sync.Once{}
+	}
+	return s.migrator, err
+}
+
+// loadingMigrator 数据库迁移；使用 mysql 数据库，未启用时使用 postgres 数据库
+func (s *engines) loadingMigrator() (*migrationutil.Migrator, error) {
+	var (
+		db  *gorm.DB
+		err error
+	)
+	switch {
+	case s.Config.MySQLConfig() != nil && s.Config.MySQLConfig().Enable:
+		db, err = s.GetMySQLGormDB()
+	case s.Config.PostgresConfig() != nil && s.Config.PostgresConfig().Enable:
+		db, err = s.GetPostgresGormDB()
+	default:
+		stdlog.Println("|*** 加载：数据库迁移：未初始化")
+		return nil, pkgerrors.WithMessage(ErrUninitialized, "[请配置服务再启动] 数据库迁移需启用 mysql 或 psql")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return migrationutil.NewMigrator(db, s.migrations, s.migrationOptions...)
+}
+
+// runMigrations 执行数据库迁移
+func (s *engines) runMigrations() error {
+	stdlog.Printf("|*** 加载：数据库迁移：迁移数量 = %d", len(s.migrations))
+	migrator, err := s.GetMigrator()
+	if err != nil {
+		return err
+	}
+	applied, err := migrator.Up(context.Background())
+	for i := range applied {
+		stdlog.Printf("|*** 加载：数据库迁移：%d_%s", applied[i].Version, applied[i].Name)
+	}
+	return err
+}
@@ -0,0 +1,61 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	outboxutil "github.com/my-saas-platform/api-proto/util/outbox"
+	pkgerrors "github.com/pkg/errors"
+	"gorm.io/gorm"
+)
+
+// GetOutbox 事务发件箱
+func (s *engines) GetOutbox() (*outboxutil.Outbox, error) {
+	if s.outbox != nil {
+		return s.outbox, nil
+	}
+	var err error
+	s.outboxMutex.Do(func() {
+		start := time.Now()
+		s.outbox, err = s.loadingOutbox()
+		s.recordComponent(ComponentOutbox, start, err)
+	})
+	if err != nil {
+		s.outboxMutex = sync.Once{}
+	}
+	return s.outbox, err
+}
+
+// loadingOutbox 事务发件箱；使用 mysql 数据库，未启用时使用 postgres 数据库
+func (s *engines) loadingOutbox() (*outboxutil.Outbox, error) {
+	var (
+		db  *gorm.DB
+		err error
+	)
+	switch {
+	case s.Config.MySQLConfig() != nil && s.Config.MySQLConfig().Enable:
+		db, err = s.GetMySQLGormDB()
+	case s.Config.PostgresConfig() != nil && s.Config.PostgresConfig().Enable:
+		db, err = s.GetPostgresGormDB()
+	default:
+		stdlog.Println("|*** 加载：事务发件箱：未初始化")
+		return nil, pkgerrors.WithMessage(ErrUninitialized, "[请配置服务再启动] 事务发件箱需启用 mysql 或 psql")
+	}
+	if err != nil {
+		return nil, err
+	}
+	conn, err := s.GetRabbitmqConn()
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 事务发件箱需启用 rabbitmq")
+	}
+
+	outbox := outboxutil.NewOutbox(db, conn)
+	if cfg := s.Config.SettingConfig(); cfg != nil && cfg.EnableOutboxRelay {
+		stdlog.Println("|*** 加载：事务发件箱：启动转发")
+		outbox.Start()
+	} else {
+		stdlog.Println("|*** 加载：事务发件箱")
+	}
+	return outbox, nil
+}
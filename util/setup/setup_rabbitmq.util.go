@@ -0,0 +1,129 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	rabbitmqutil "github.com/my-saas-platform/api-proto/util/rabbitmq"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// defaultRabbitmqConsumerDrainTimeout 关闭时等待处理中消息的超时时间
+	defaultRabbitmqConsumerDrainTimeout = 30 * time.Second
+)
+
+// GetRabbitmqConn rabbitmq 链接
+func (s *engines) GetRabbitmqConn() (*rabbitmqutil.Connection, error) {
+	if s.rabbitmqConn != nil {
+		return s.rabbitmqConn, nil
+	}
+	var err error
+	s.rabbitmqConnMutex.Do(func() {
+		start := time.Now()
+		s.rabbitmqConn, err = s.loadingRabbitmqConn()
+		s.recordComponent(ComponentRabbitmq, start, err)
+	})
+	if err != nil {
+		s.rabbitmqConnMutex = sync.Once{}
+	}
+	return s.rabbitmqConn, err
+}
+
+// GetRabbitmqConsumer rabbitmq 消费者
+func (s *engines) GetRabbitmqConsumer() (*rabbitmqutil.Consumer, error) {
+	if s.rabbitmqConsumer != nil {
+		return s.rabbitmqConsumer, nil
+	}
+	var err error
+	s.rabbitmqConsumerMutex.Do(func() {
+		start := time.Now()
+		s.rabbitmqConsumer, err = s.loadingRabbitmqConsumer()
+		s.recordComponent(ComponentRabbitmqConsumer, start, err)
+	})
+	if err != nil {
+		s.rabbitmqConsumerMutex = sync.Once{}
+	}
+	return s.rabbitmqConsumer, err
+}
+
+// loadingRabbitmqConsumer rabbitmq 消费者
+func (s *engines) loadingRabbitmqConsumer() (*rabbitmqutil.Consumer, error) {
+	conn, err := s.GetRabbitmqConn()
+	if err != nil {
+		return nil, err
+	}
+	stdlog.Println("|*** 加载：RabbitMQ消费者：...")
+
+	cfg := s.Config.RabbitmqConfig()
+	return rabbitmqutil.NewConsumer(conn,
+		rabbitmqutil.WithConsumerConcurrency(int(cfg.ConsumerConcurrency)),
+		rabbitmqutil.WithConsumerMaxAttempts(int(cfg.ConsumerMaxAttempts)),
+		rabbitmqutil.WithConsumerRetryBackoff(cfg.ConsumerRetryInitialBackoff.AsDuration(), cfg.ConsumerRetryMaxBackoff.AsDuration()),
+	), nil
+}
+
+// rabbitmqConsumerDrainTimeout 关闭时等待处理中消息的超时时间
+func (s *engines) rabbitmqConsumerDrainTimeout() time.Duration {
+	if cfg := s.Config.RabbitmqConfig(); cfg != nil && cfg.ConsumerDrainTimeout.AsDuration() > 0 {
+		return cfg.ConsumerDrainTimeout.AsDuration()
+	}
+	return defaultRabbitmqConsumerDrainTimeout
+}
+
+// loadingRabbitmqConn rabbitmq 链接
+func (s *engines) loadingRabbitmqConn() (*rabbitmqutil.Connection, error) {
+	cfg := s.Config.RabbitmqConfig()
+	if cfg == nil {
+		stdlog.Println("|*** 加载：RabbitMQ：未初始化")
+		return nil, pkgerrors.WithStack(ErrUninitialized)
+	}
+	stdlog.Println("|*** 加载：RabbitMQ：...")
+
+	url, opts, err := toRabbitmqConnOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if s.rabbitmqDialer != nil {
+		opts = append(opts, rabbitmqutil.WithDialer(s.rabbitmqDialer))
+	}
+	conn, err := rabbitmqutil.NewConnection(url, opts...)
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "rabbitmq connection failed")
+	}
+	return conn, nil
+}
+
+// toRabbitmqConnOptions 链接地址与选项；配置 tls_*_pem 时使用 tls_address
+func toRabbitmqConnOptions(cfg *configs.Infrastructure_Rabbitmq) (url string, opts []rabbitmqutil.Option, err error) {
+	opts = []rabbitmqutil.Option{
+		rabbitmqutil.WithChannelPoolSize(int(cfg.ChannelPoolSize)),
+		rabbitmqutil.WithPublisherConfirms(cfg.EnablePublisherConfirms),
+		rabbitmqutil.WithReconnectBackoff(cfg.ReconnectInitialBackoff.AsDuration(), cfg.ReconnectMaxBackoff.AsDuration()),
+	}
+
+	// tls
+	hasTLSPem := cfg.TlsCaPem != "" || cfg.TlsCertPem != "" || cfg.TlsKeyPem != ""
+	if !hasTLSPem && !cfg.InsecureSkipVerify {
+		if cfg.Url == "" {
+			return url, opts, pkgerrors.New("[请配置服务再启动] config key : rabbitmq.url")
+		}
+		return cfg.Url, opts, nil
+	}
+	if cfg.TlsAddress == "" {
+		return url, opts, pkgerrors.New("[请配置服务再启动] 配置 rabbitmq.tls_*_pem 需配置 rabbitmq.tls_address")
+	}
+	tlsConfig, err := newTLSConfig(&tlsConfigOption{
+		insecureSkipVerify: cfg.InsecureSkipVerify,
+		caPem:              cfg.TlsCaPem,
+		certPem:            cfg.TlsCertPem,
+		keyPem:             cfg.TlsKeyPem,
+	})
+	if err != nil {
+		return url, opts, pkgerrors.WithMessage(err, "[请配置服务再启动] rabbitmq")
+	}
+	opts = append(opts, rabbitmqutil.WithTLSConfig(tlsConfig))
+	return cfg.TlsAddress, opts, nil
+}
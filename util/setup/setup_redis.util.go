@@ -0,0 +1,165 @@
+package setuputil
+
+import (
+	"context"
+	"strings"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// RedisModeSingle 单节点
+	RedisModeSingle = "single"
+	// RedisModeSentinel 哨兵
+	RedisModeSentinel = "sentinel"
+	// RedisModeCluster 集群
+	RedisModeCluster = "cluster"
+
+	// RedisReadFromMaster 只读命令路由到主节点
+	RedisReadFromMaster = "master"
+	// RedisReadFromReplica 只读命令路由到从节点；仅 cluster：
+	// go-redis 的哨兵客户端无法仅将只读命令路由到从节点，ReplicaOnly 的写命令同样发往从节点
+	RedisReadFromReplica = "replica"
+	// RedisReadFromLatency 只读命令按延迟路由
+	RedisReadFromLatency = "latency"
+	// RedisReadFromRandom 只读命令随机路由
+	RedisReadFromRandom = "random"
+)
+
+// newRedisClient redis 客户端
+func newRedisClient(cfg *configs.Infrastructure_Redis) (redis.UniversalClient, error) {
+	mode, opts, err := toRedisUniversalOptions(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var client redis.UniversalClient
+	switch mode {
+	case RedisModeSentinel:
+		if opts.RouteByLatency || opts.RouteRandomly {
+			client = redis.NewFailoverClusterClient(opts.Failover())
+		} else {
+			client = redis.NewFailoverClient(opts.Failover())
+		}
+	case RedisModeCluster:
+		client = redis.NewClusterClient(opts.Cluster())
+	default:
+		client = redis.NewClient(opts.Simple())
+	}
+
+	// ping 测试连接
+	if err = client.Ping(context.Background()).Err(); err != nil {
+		_ = client.Close()
+		return nil, pkgerrors.WithMessage(err, "redis connection ping failed ; mode = "+mode)
+	}
+	return client, nil
+}
+
+// toRedisUniversalOptions 解析部署模式与选项；配置组合无效时返回错误
+func toRedisUniversalOptions(cfg *configs.Infrastructure_Redis) (mode string, opts *redis.UniversalOptions, err error) {
+	redisConfig := ToRedisConfig(cfg)
+	opts = &redis.UniversalOptions{
+		Addrs:            redisConfig.Addresses,
+		Username:         redisConfig.Username,
+		Password:         redisConfig.Password,
+		DB:               int(redisConfig.Db),
+		SentinelUsername: cfg.SentinelUsername,
+		SentinelPassword: cfg.SentinelPassword,
+		MasterName:       cfg.MasterName,
+		DialTimeout:      redisConfig.DialTimeout.AsDuration(),
+		ReadTimeout:      redisConfig.ReadTimeout.AsDuration(),
+		WriteTimeout:     redisConfig.WriteTimeout.AsDuration(),
+		PoolSize:         int(redisConfig.ConnMaxActive),
+		ConnMaxLifetime:  redisConfig.ConnMaxLifetime.AsDuration(),
+		MinIdleConns:     int(redisConfig.ConnMinIdle),
+		MaxIdleConns:     int(redisConfig.ConnMaxIdle),
+		ConnMaxIdleTime:  redisConfig.ConnMaxIdleTime.AsDuration(),
+	}
+	if len(opts.Addrs) == 0 {
+		return mode, opts, pkgerrors.New("[请配置服务再启动] config key : redis.addresses")
+	}
+
+	// 部署模式
+	mode = strings.ToLower(strings.TrimSpace(cfg.Mode))
+	switch mode {
+	case RedisModeSingle, RedisModeSentinel, RedisModeCluster:
+	case "":
+		switch {
+		case opts.MasterName != "":
+			mode = RedisModeSentinel
+		case len(opts.Addrs) > 1:
+			mode = RedisModeCluster
+		default:
+			mode = RedisModeSingle
+		}
+	default:
+		return mode, opts, pkgerrors.New("[请配置服务再启动] 不支持的 redis.mode : " + cfg.Mode)
+	}
+
+	// 只读命令路由
+	readFrom := strings.ToLower(strings.TrimSpace(cfg.ReadFrom))
+	switch readFrom {
+	case "", RedisReadFromMaster:
+	case RedisReadFromReplica:
+		opts.ReadOnly = true
+	case RedisReadFromLatency:
+		opts.RouteByLatency = true
+	case RedisReadFromRandom:
+		opts.RouteRandomly = true
+	default:
+		return mode, opts, pkgerrors.New("[请配置服务再启动] 不支持的 redis.read_from : " + cfg.ReadFrom)
+	}
+
+	// 配置组合
+	switch mode {
+	case RedisModeSingle:
+		if len(opts.Addrs) > 1 {
+			return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = single 仅支持一个 redis.addresses")
+		}
+		if opts.MasterName != "" {
+			return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = single 不支持 redis.master_name")
+		}
+		if readFrom != "" && readFrom != RedisReadFromMaster {
+			return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = single 不支持 redis.read_from = " + readFrom)
+		}
+	case RedisModeSentinel:
+		if opts.MasterName == "" {
+			return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = sentinel 需配置 redis.master_name")
+		}
+		if readFrom == RedisReadFromReplica {
+			return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = sentinel 不支持 redis.read_from = replica" +
+				"：哨兵客户端无法仅将只读命令路由到从节点；读从节点请使用 latency 或 random(写命令仍发往主节点)")
+		}
+	case RedisModeCluster:
+		if opts.MasterName != "" {
+			return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = cluster 不支持 redis.master_name")
+		}
+		if opts.DB != 0 {
+			return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = cluster 不支持 redis.db")
+		}
+	}
+	if mode != RedisModeSentinel && (opts.SentinelUsername != "" || opts.SentinelPassword != "") {
+		return mode, opts, pkgerrors.New("[请配置服务再启动] redis.mode = " + mode + " 不支持 redis.sentinel_username、redis.sentinel_password")
+	}
+
+	// tls
+	hasTLSPem := cfg.TlsCaPem != "" || cfg.TlsCertPem != "" || cfg.TlsKeyPem != ""
+	if !cfg.EnableTls && hasTLSPem {
+		return mode, opts, pkgerrors.New("[请配置服务再启动] 配置 redis.tls_*_pem 需启用 redis.enable_tls")
+	}
+	if cfg.EnableTls {
+		opts.TLSConfig, err = newTLSConfig(&tlsConfigOption{
+			serverName:         cfg.TlsServerName,
+			insecureSkipVerify: cfg.InsecureSkipVerify,
+			caPem:              cfg.TlsCaPem,
+			certPem:            cfg.TlsCertPem,
+			keyPem:             cfg.TlsKeyPem,
+		})
+		if err != nil {
+			return mode, opts, pkgerrors.WithMessage(err, "[请配置服务再启动] redis")
+		}
+	}
+	return mode, opts, nil
+}
@@ -0,0 +1,107 @@
+package setuputil
+
+import (
+	"testing"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestToRedisUniversalOptions
+func TestToRedisUniversalOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *configs.Infrastructure_Redis
+		wantMode string
+		wantErr  bool
+	}{
+		{
+			name:     "#implicit_single",
+			cfg:      &configs.Infrastructure_Redis{Addresses: []string{"127.0.0.1:6379"}},
+			wantMode: RedisModeSingle,
+		},
+		{
+			name:     "#implicit_cluster",
+			cfg:      &configs.Infrastructure_Redis{Addresses: []string{"10.0.0.1:6379", "10.0.0.2:6379"}},
+			wantMode: RedisModeCluster,
+		},
+		{
+			name:     "#implicit_sentinel",
+			cfg:      &configs.Infrastructure_Redis{Addresses: []string{"10.0.0.1:26379"}, MasterName: "mymaster"},
+			wantMode: RedisModeSentinel,
+		},
+		{
+			name:     "#sentinel_latency",
+			cfg:      &configs.Infrastructure_Redis{Mode: "sentinel", Addresses: []string{"10.0.0.1:26379"}, MasterName: "mymaster", ReadFrom: "latency", SentinelPassword: "secret"},
+			wantMode: RedisModeSentinel,
+		},
+		{
+			name:     "#cluster_replica",
+			cfg:      &configs.Infrastructure_Redis{Mode: "cluster", Addresses: []string{"10.0.0.1:6379"}, ReadFrom: "replica"},
+			wantMode: RedisModeCluster,
+		},
+		{
+			name:    "#no_addresses",
+			cfg:     &configs.Infrastructure_Redis{Mode: "single"},
+			wantErr: true,
+		},
+		{
+			name:    "#unknown_mode",
+			cfg:     &configs.Infrastructure_Redis{Mode: "ring", Addresses: []string{"127.0.0.1:6379"}},
+			wantErr: true,
+		},
+		{
+			name:    "#single_with_many_addresses",
+			cfg:     &configs.Infrastructure_Redis{Mode: "single", Addresses: []string{"10.0.0.1:6379", "10.0.0.2:6379"}},
+			wantErr: true,
+		},
+		{
+			name:    "#single_read_from_replica",
+			cfg:     &configs.Infrastructure_Redis{Mode: "single", Addresses: []string{"127.0.0.1:6379"}, ReadFrom: "replica"},
+			wantErr: true,
+		},
+		{
+			name:    "#sentinel_without_master_name",
+			cfg:     &configs.Infrastructure_Redis{Mode: "sentinel", Addresses: []string{"10.0.0.1:26379"}},
+			wantErr: true,
+		},
+		{
+			name:    "#sentinel_read_from_replica",
+			cfg:     &configs.Infrastructure_Redis{Mode: "sentinel", Addresses: []string{"10.0.0.1:26379"}, MasterName: "mymaster", ReadFrom: "replica"},
+			wantErr: true,
+		},
+		{
+			name:    "#cluster_with_db",
+			cfg:     &configs.Infrastructure_Redis{Mode: "cluster", Addresses: []string{"10.0.0.1:6379"}, Db: 1},
+			wantErr: true,
+		},
+		{
+			name:    "#cluster_with_sentinel_password",
+			cfg:     &configs.Infrastructure_Redis{Mode: "cluster", Addresses: []string{"10.0.0.1:6379"}, SentinelPassword: "secret"},
+			wantErr: true,
+		},
+		{
+			name:    "#tls_pem_without_enable_tls",
+			cfg:     &configs.Infrastructure_Redis{Addresses: []string{"127.0.0.1:6379"}, TlsCaPem: "pem"},
+			wantErr: true,
+		},
+		{
+			name:    "#tls_invalid_ca",
+			cfg:     &configs.Infrastructure_Redis{Addresses: []string{"127.0.0.1:6379"}, EnableTls: true, TlsCaPem: "pem"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mode, opts, err := toRedisUniversalOptions(tt.cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				t.Log(err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantMode, mode)
+			require.NotNil(t, opts)
+		})
+	}
+}
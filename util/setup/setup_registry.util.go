@@ -1,6 +1,35 @@
 package setuputil
 
-import registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
+import (
+	"context"
+	stdlog "log"
+	"net/url"
+	"strings"
+	"time"
+
+	consulregistry "github.com/go-kratos/kratos/contrib/registry/consul/v2"
+	etcdregistry "github.com/go-kratos/kratos/contrib/registry/etcd/v2"
+	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/google/uuid"
+	consulapi "github.com/hashicorp/consul/api"
+	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// 服务注册的健康检查
+	RegistryHealthCheckTTL  = "ttl"
+	RegistryHealthCheckHTTP = "http"
+
+	defaultRegistryHealthCheckPath             = "/health"
+	defaultRegistryHealthCheckInterval         = 10 * time.Second
+	defaultRegistryDeregisterCriticalAfter     = 600 * time.Second
+	defaultRegistryHealthCheckTimeout          = 5 * time.Second
+	defaultRegistryRegisterOrDeregisterTimeout = 10 * time.Second
+)
 
 // SetRegistryType 设置 服务注册类型
 func (s *engines) SetRegistryType(rt registrypkg.RegistryType) {
@@ -11,3 +40,228 @@ func (s *engines) SetRegistryType(rt registrypkg.RegistryType) {
 func (s *engines) GetRegistryType() registrypkg.RegistryType {
 	return s.registryType
 }
+
+// GetServiceInstance 服务注册的服务实例；未启用服务注册时为 nil
+func (s *engines) GetServiceInstance() *registry.ServiceInstance {
+	return s.serviceInstance
+}
+
+// serviceRegistryType 服务注册类型；未配置 setting.service_registry_type 时：启用的 consul，其次启用的 etcd
+func (s *engines) serviceRegistryType() (registrypkg.RegistryType, error) {
+	switch registryType := strings.ToLower(s.Config.SettingConfig().GetServiceRegistryType()); registryType {
+	case string(registrypkg.RegistryTypeConsul):
+		return registrypkg.RegistryTypeConsul, nil
+	case string(registrypkg.RegistryTypeEtcd):
+		return registrypkg.RegistryTypeEtcd, nil
+	case "":
+		if cfg := s.Config.ConsulConfig(); cfg != nil && cfg.Enable {
+			return registrypkg.RegistryTypeConsul, nil
+		}
+		if cfg := s.Config.EtcdConfig(); cfg != nil && cfg.Enable {
+			return registrypkg.RegistryTypeEtcd, nil
+		}
+		return "", pkgerrors.New("[请配置服务再启动] 服务注册需启用 consul 或 etcd")
+	default:
+		return "", pkgerrors.Errorf("[请配置服务再启动] 无效的 setting.service_registry_type : %s", registryType)
+	}
+}
+
+// loadingServiceRegistrar 服务注册：consul、etcd；服务名称为 apputil.ID(app)；
+// 仅创建与校验，服务启动后由 RegisterService 注册
+func (s *engines) loadingServiceRegistrar() error {
+	registryType, err := s.serviceRegistryType()
+	if err != nil {
+		return err
+	}
+	stdlog.Println("|*** 加载：服务注册：" + string(registryType))
+
+	instance, err := newServiceInstance(s.Config.AppConfig())
+	if err != nil {
+		return err
+	}
+	var (
+		registrar registry.Registrar
+		cancel    context.CancelFunc
+	)
+	switch registryType {
+	case registrypkg.RegistryTypeEtcd:
+		etcdClient, err := s.GetEtcdClient()
+		if err != nil {
+			return err
+		}
+		// 注销时取消续约，避免续约失败后重新注册
+		var registrarCtx context.Context
+		registrarCtx, cancel = context.WithCancel(context.Background())
+		opts := append(ToEtcdRegistryOptions(s.Config.EtcdConfig()), etcdregistry.Context(registrarCtx))
+		registrar = etcdregistry.New(etcdClient, opts...)
+	default:
+		consulConfig := s.Config.ConsulConfig()
+		if consulConfig == nil {
+			return pkgerrors.WithMessage(ErrUninitialized, "[请配置服务再启动] 服务注册需配置 consul")
+		}
+		registryOpts, err := toConsulRegistryOptions(consulConfig, s.Config.AppConfig())
+		if err != nil {
+			return err
+		}
+		consulClient, err := s.GetConsulClient()
+		if err != nil {
+			return err
+		}
+		registrar = consulregistry.New(consulClient, registryOpts...)
+	}
+
+	s.serviceRegistryMutex.Lock()
+	defer s.serviceRegistryMutex.Unlock()
+	s.serviceRegistrar = registrar
+	s.serviceRegistrarCancel = cancel
+	s.serviceInstance = instance
+	s.SetRegistryType(registryType)
+	return nil
+}
+
+// ServiceRegistryOptions 服务启动后注册，停止前注销；未启用服务注册时为空
+//
+//	app := kratos.New(append(opts, engineHandler.ServiceRegistryOptions()...)...)
+func (s *engines) ServiceRegistryOptions() []kratos.Option {
+	if cfg := s.Config.SettingConfig(); cfg == nil || !cfg.EnableServiceRegistry {
+		return nil
+	}
+	return []kratos.Option{
+		kratos.AfterStart(s.RegisterService),
+		kratos.BeforeStop(s.DeregisterService),
+	}
+}
+
+// RegisterService 服务注册；在服务开始监听后调用，如：kratos.AfterStart；未启用服务注册时忽略
+func (s *engines) RegisterService(ctx context.Context) error {
+	s.serviceRegistryMutex.Lock()
+	defer s.serviceRegistryMutex.Unlock()
+	if s.serviceRegistrar == nil || s.serviceRegistered {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, defaultRegistryRegisterOrDeregisterTimeout)
+	defer cancel()
+	if err := s.serviceRegistrar.Register(ctx, s.serviceInstance); err != nil {
+		return pkgerrors.WithMessage(err, string(s.GetRegistryType())+" register service failed")
+	}
+	s.serviceRegistered = true
+	return nil
+}
+
+// DeregisterService 服务注销；在服务停止前调用，如：kratos.BeforeStop；Close 时注销未注销的服务
+func (s *engines) DeregisterService(ctx context.Context) error {
+	s.serviceRegistryMutex.Lock()
+	defer s.serviceRegistryMutex.Unlock()
+	if s.serviceRegistrarCancel != nil {
+		s.serviceRegistrarCancel()
+		s.serviceRegistrarCancel = nil
+	}
+	if s.serviceRegistrar == nil || !s.serviceRegistered {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), defaultRegistryRegisterOrDeregisterTimeout)
+	defer cancel()
+	if err := s.serviceRegistrar.Deregister(ctx, s.serviceInstance); err != nil {
+		return pkgerrors.WithMessage(err, string(s.GetRegistryType())+" deregister service failed")
+	}
+	s.serviceRegistered = false
+	return nil
+}
+
+// newServiceInstance 服务实例；端点未指定 scheme 时使用 http:// 与 grpc://
+func newServiceInstance(appConfig *configs.App) (*registry.ServiceInstance, error) {
+	if appConfig == nil || appConfig.ServerName == "" {
+		return nil, pkgerrors.New("[请配置服务再启动] config key : app.server_name")
+	}
+	endpoints := make([]string, 0, len(appConfig.HttpEndpoints)+len(appConfig.GrpcEndpoints))
+	for _, endpoint := range appConfig.HttpEndpoints {
+		endpoints = append(endpoints, withEndpointScheme("http", endpoint))
+	}
+	for _, endpoint := range appConfig.GrpcEndpoints {
+		endpoints = append(endpoints, withEndpointScheme("grpc", endpoint))
+	}
+	if len(endpoints) == 0 {
+		return nil, pkgerrors.New("[请配置服务再启动] 服务注册需配置 app.http_endpoints 或 app.grpc_endpoints")
+	}
+	for _, endpoint := range endpoints {
+		if _, err := url.Parse(endpoint); err != nil {
+			return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 无效的服务端点 : "+endpoint)
+		}
+	}
+
+	serviceName := apputil.ID(appConfig)
+	metadata := make(map[string]string, len(appConfig.Metadata))
+	for k, v := range appConfig.Metadata {
+		metadata[k] = v
+	}
+	return &registry.ServiceInstance{
+		ID:        serviceName + ":" + uuid.NewString(),
+		Name:      serviceName,
+		Version:   appConfig.ServerVersion,
+		Metadata:  metadata,
+		Endpoints: endpoints,
+	}, nil
+}
+
+// withEndpointScheme ...
+func withEndpointScheme(scheme, endpoint string) string {
+	if strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	return scheme + "://" + endpoint
+}
+
+// toConsulRegistryOptions 健康检查：ttl(心跳)、http(另有各端点的tcp检查)
+func toConsulRegistryOptions(cfg *configs.Infrastructure_Consul, appConfig *configs.App) ([]consulregistry.Option, error) {
+	interval := defaultRegistryHealthCheckInterval
+	if cfg.RegistryHealthCheckInterval.AsDuration() > 0 {
+		interval = cfg.RegistryHealthCheckInterval.AsDuration()
+	}
+	deregisterAfter := defaultRegistryDeregisterCriticalAfter
+	if cfg.RegistryDeregisterCriticalAfter.AsDuration() > 0 {
+		deregisterAfter = cfg.RegistryDeregisterCriticalAfter.AsDuration()
+	}
+	opts := []consulregistry.Option{
+		consulregistry.WithHealthCheckInterval(durationSeconds(interval)),
+		consulregistry.WithDeregisterCriticalServiceAfter(durationSeconds(deregisterAfter)),
+	}
+
+	switch strings.ToLower(cfg.RegistryHealthCheck) {
+	case "", RegistryHealthCheckTTL:
+		opts = append(opts,
+			consulregistry.WithHeartbeat(true),
+			consulregistry.WithHealthCheck(false),
+		)
+	case RegistryHealthCheckHTTP:
+		if len(appConfig.GetHttpEndpoints()) == 0 {
+			return nil, pkgerrors.New("[请配置服务再启动] http 健康检查需配置 app.http_endpoints")
+		}
+		checkPath := cfg.RegistryHealthCheckPath
+		if checkPath == "" {
+			checkPath = defaultRegistryHealthCheckPath
+		}
+		checkURL := strings.TrimSuffix(withEndpointScheme("http", appConfig.HttpEndpoints[0]), "/") + "/" + strings.TrimPrefix(checkPath, "/")
+		opts = append(opts,
+			consulregistry.WithHeartbeat(false),
+			consulregistry.WithHealthCheck(true),
+			consulregistry.WithServiceCheck(&consulapi.AgentServiceCheck{
+				HTTP:                           checkURL,
+				Method:                         "GET",
+				Interval:                       interval.String(),
+				Timeout:                        defaultRegistryHealthCheckTimeout.String(),
+				DeregisterCriticalServiceAfter: deregisterAfter.String(),
+			}),
+		)
+	default:
+		return nil, pkgerrors.Errorf("[请配置服务再启动] 无效的 consul.registry_health_check : %s", cfg.RegistryHealthCheck)
+	}
+	return opts, nil
+}
+
+// durationSeconds 秒；最小为1
+func durationSeconds(d time.Duration) int {
+	if seconds := int(d / time.Second); seconds > 0 {
+		return seconds
+	}
+	return 1
+}
@@ -0,0 +1,193 @@
+package setuputil
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	consulapi "github.com/hashicorp/consul/api"
+	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	"github.com/stretchr/testify/require"
+)
+
+// testingConsulAgent consul agent：服务注册与注销
+type testingConsulAgent struct {
+	mutex    sync.Mutex
+	services map[string]*consulapi.AgentServiceRegistration
+}
+
+func newTestingConsulAgent(t *testing.T) (*testingConsulAgent, *httptest.Server) {
+	agent := &testingConsulAgent{services: make(map[string]*consulapi.AgentServiceRegistration)}
+	server := httptest.NewServer(agent)
+	t.Cleanup(server.Close)
+	return agent, server
+}
+
+func (a *testingConsulAgent) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	switch {
+	case r.URL.Path == "/v1/agent/service/register":
+		asr := &consulapi.AgentServiceRegistration{}
+		if err := json.NewDecoder(r.Body).Decode(asr); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		a.services[asr.ID] = asr
+	case strings.HasPrefix(r.URL.Path, "/v1/agent/service/deregister/"):
+		delete(a.services, strings.TrimPrefix(r.URL.Path, "/v1/agent/service/deregister/"))
+	case strings.HasPrefix(r.URL.Path, "/v1/agent/check/update/"):
+	case strings.HasPrefix(r.URL.Path, "/v1/kv/"):
+		// 客户端连接检查
+		_, _ = w.Write([]byte("true"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (a *testingConsulAgent) registrations() []*consulapi.AgentServiceRegistration {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	var asrs []*consulapi.AgentServiceRegistration
+	for _, asr := range a.services {
+		asrs = append(asrs, asr)
+	}
+	return asrs
+}
+
+func newTestingRegistryEngine(server *httptest.Server, consulConfig *configs.Infrastructure_Consul) *engines {
+	consulConfig.Scheme = "http"
+	consulConfig.Address = strings.TrimPrefix(server.URL, "http://")
+	return initEngine(&configuration{
+		conf: &configs.Bootstrap{
+			App: &configs.App{
+				ProjectName:   "testing-project",
+				ServerName:    "testing-service",
+				ServerEnv:     "testing",
+				ServerVersion: "v1.0.0",
+				HttpEndpoints: []string{"127.0.0.1:8081"},
+				GrpcEndpoints: []string{"grpc://127.0.0.1:9091"},
+				Metadata:      map[string]string{"zone": "testing"},
+			},
+			Infrastructure: &configs.Infrastructure{Consul: consulConfig},
+			Setting:        &configs.Setting{EnableServiceRegistry: true},
+		},
+	})
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_RegisterService_TTL
+func TestEngines_RegisterService_TTL(t *testing.T) {
+	agent, server := newTestingConsulAgent(t)
+	handler := newTestingRegistryEngine(server, &configs.Infrastructure_Consul{Enable: true})
+
+	// 加载时不注册；服务开始监听后注册
+	require.NoError(t, handler.loadingServiceRegistrar())
+	require.Empty(t, agent.registrations())
+	require.Len(t, handler.ServiceRegistryOptions(), 2)
+	require.NoError(t, handler.RegisterService(context.Background()))
+	require.NoError(t, handler.RegisterService(context.Background()))
+	require.Equal(t, registrypkg.RegistryTypeConsul, handler.GetRegistryType())
+
+	asrs := agent.registrations()
+	require.Len(t, asrs, 1)
+	asr := asrs[0]
+	require.Equal(t, apputil.ID(handler.AppConfig()), asr.Name)
+	require.Equal(t, handler.GetServiceInstance().ID, asr.ID)
+	require.Equal(t, map[string]string{"zone": "testing"}, asr.Meta)
+	require.Equal(t, []string{"version=v1.0.0"}, asr.Tags)
+	require.Equal(t, "http://127.0.0.1:8081", asr.TaggedAddresses["http"].Address)
+	require.Equal(t, "grpc://127.0.0.1:9091", asr.TaggedAddresses["grpc"].Address)
+	require.Len(t, asr.Checks, 1)
+	require.Equal(t, "20s", asr.Checks[0].TTL)
+
+	require.NoError(t, handler.DeregisterService(context.Background()))
+	require.Empty(t, agent.registrations())
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_RegisterService_HTTP
+func TestEngines_RegisterService_HTTP(t *testing.T) {
+	agent, server := newTestingConsulAgent(t)
+	handler := newTestingRegistryEngine(server, &configs.Infrastructure_Consul{
+		Enable:                  true,
+		RegistryHealthCheck:     RegistryHealthCheckHTTP,
+		RegistryHealthCheckPath: "/api/health",
+	})
+
+	require.NoError(t, handler.loadingServiceRegistrar())
+	require.NoError(t, handler.RegisterService(context.Background()))
+	asrs := agent.registrations()
+	require.Len(t, asrs, 1)
+
+	var httpChecks []string
+	for _, check := range asrs[0].Checks {
+		require.Empty(t, check.TTL)
+		if check.HTTP != "" {
+			httpChecks = append(httpChecks, check.HTTP)
+			require.Equal(t, "10s", check.Interval)
+		}
+	}
+	require.Equal(t, []string{"http://127.0.0.1:8081/api/health"}, httpChecks)
+
+	require.NoError(t, handler.DeregisterService(context.Background()))
+	require.Empty(t, agent.registrations())
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestNewServiceInstance
+func TestNewServiceInstance(t *testing.T) {
+	tests := []struct {
+		name          string
+		app           *configs.App
+		wantEndpoints []string
+		wantErr       bool
+	}{
+		{
+			name:          "#endpoints",
+			app:           &configs.App{ServerName: "testing", HttpEndpoints: []string{"127.0.0.1:8081"}, GrpcEndpoints: []string{"127.0.0.1:9091"}},
+			wantEndpoints: []string{"http://127.0.0.1:8081", "grpc://127.0.0.1:9091"},
+		},
+		{
+			name:          "#https",
+			app:           &configs.App{ServerName: "testing", HttpEndpoints: []string{"https://example.com:443"}},
+			wantEndpoints: []string{"https://example.com:443"},
+		},
+		{
+			name:    "#no_endpoints",
+			app:     &configs.App{ServerName: "testing"},
+			wantErr: true,
+		},
+		{
+			name:    "#no_server_name",
+			app:     &configs.App{HttpEndpoints: []string{"127.0.0.1:8081"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			instance, err := newServiceInstance(tt.app)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantEndpoints, instance.Endpoints)
+			require.Equal(t, apputil.ID(tt.app), instance.Name)
+		})
+	}
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestToConsulRegistryOptions
+func TestToConsulRegistryOptions(t *testing.T) {
+	app := &configs.App{GrpcEndpoints: []string{"127.0.0.1:9091"}}
+	_, err := toConsulRegistryOptions(&configs.Infrastructure_Consul{RegistryHealthCheck: "tcp"}, app)
+	require.Error(t, err)
+	_, err = toConsulRegistryOptions(&configs.Infrastructure_Consul{RegistryHealthCheck: RegistryHealthCheckHTTP}, app)
+	require.Error(t, err)
+	_, err = toConsulRegistryOptions(&configs.Infrastructure_Consul{}, app)
+	require.NoError(t, err)
+}
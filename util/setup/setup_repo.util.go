@@ -1,23 +1,47 @@
 package setuputil
 
 import (
+	"context"
 	strerrors "errors"
 	"io"
+	"net/http"
 	"strings"
+	"time"
 
+	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
 	consulapi "github.com/hashicorp/consul/api"
 	apppkg "github.com/ikaiguang/go-srv-kit/kratos/app"
 	authpkg "github.com/ikaiguang/go-srv-kit/kratos/auth"
 	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
 	configs "github.com/my-saas-platform/api-proto/api/config"
+	cacheutil "github.com/my-saas-platform/api-proto/util/cache"
+	captchautil "github.com/my-saas-platform/api-proto/util/captcha"
+	lockutil "github.com/my-saas-platform/api-proto/util/lock"
+	loginguardutil "github.com/my-saas-platform/api-proto/util/loginguard"
+	metricsutil "github.com/my-saas-platform/api-proto/util/metrics"
+	migrationutil "github.com/my-saas-platform/api-proto/util/migration"
+	outboxutil "github.com/my-saas-platform/api-proto/util/outbox"
+	rabbitmqutil "github.com/my-saas-platform/api-proto/util/rabbitmq"
+	scheduleutil "github.com/my-saas-platform/api-proto/util/schedule"
+	servicesignutil "github.com/my-saas-platform/api-proto/util/servicesign"
+	transferencryptutil "github.com/my-saas-platform/api-proto/util/transferencrypt"
 	pkgerrors "github.com/pkg/errors"
 	"github.com/redis/go-redis/v9"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"gorm.io/gorm"
 )
 
+const (
+	// DefaultInstanceName 默认实例名称；对应 infrastructure.mysql、infrastructure.psql、infrastructure.redis
+	DefaultInstanceName = "default"
+)
+
 var (
 	_ Config = &configuration{}
 	_ Engine = &engines{}
@@ -71,6 +95,31 @@ func (s *LoggerPrefixField) Prefix() []interface{} {
 	}
 }
 
+// Fields 日志前缀；结构化日志的独立字段
+func (s *LoggerPrefixField) Fields(schema *LoggerFieldSchema) []interface{} {
+	return []interface{}{
+		schema.Service, s.AppName,
+		schema.Env, s.AppEnv,
+		schema.Version, s.AppVersion,
+		schema.Hostname, s.Hostname,
+		schema.ServerIP, s.ServerIP,
+	}
+}
+
+// 配置来源
+const (
+	ConfigSourceTypeFile   = "file"
+	ConfigSourceTypeConsul = "consul"
+	ConfigSourceTypeCustom = "custom"
+)
+
+// ConfigSource 配置来源；file 为配置路径，consul 为配置中心的 key 路径
+type ConfigSource struct {
+	Type    string `json:"type"`
+	Path    string `json:"path,omitempty"`
+	Address string `json:"address,omitempty"`
+}
+
 // Config 配置
 type Config interface {
 	Close() error
@@ -87,27 +136,46 @@ type Config interface {
 	// EnableLoggingFile 是否启用 日志输出到文件
 	EnableLoggingFile() bool
 
+	// LoggerConfig 日志配置
+	LoggerConfig() *configs.Infrastructure_Log
 	// LoggerConfigForConsole 日志配置 控制台
 	LoggerConfigForConsole() *configs.Infrastructure_Log_Console
 	// LoggerConfigForFile 日志配置 文件
 	LoggerConfigForFile() *configs.Infrastructure_Log_File
 
+	// ConfigSource 配置来源
+	ConfigSource() ConfigSource
+
 	// AppConfig APP配置
 	AppConfig() *configs.App
 	HTTPConfig() *configs.Server_HTTP
 	GRPCConfig() *configs.Server_GRPC
+	MetricsConfig() *configs.Server_Metrics
+	AdminConfig() *configs.Server_Admin
 	SettingConfig() *configs.Setting
 	InfrastructureConfig() *configs.Infrastructure
 	ClientApiConfig() *configs.ClientApi
 	TokenEncryptConfig() *configs.Setting_EncryptSecret_TokenEncrypt
+	TransferEncryptConfig() *configs.Setting_EncryptSecret_TransferEncrypt
+	ServiceEncryptConfig() *configs.Setting_EncryptSecret_ServiceEncrypt
 
 	// MySQLConfig mysql配置
 	MySQLConfig() *configs.Infrastructure_MySQL
 	PostgresConfig() *configs.Infrastructure_PSQL
 	RedisConfig() *configs.Infrastructure_Redis
 	ConsulConfig() *configs.Infrastructure_Consul
+	EtcdConfig() *configs.Infrastructure_Etcd
 	JaegerConfig() *configs.Infrastructure_Jaeger
+	OtlpConfig() *configs.Infrastructure_Otlp
+	RabbitmqConfig() *configs.Infrastructure_Rabbitmq
 	SnowflakeWorkerConfig() *configs.Infrastructure_Snowflake
+
+	// MySQLConfigByName 命名的mysql配置；name = DefaultInstanceName 时为 MySQLConfig
+	MySQLConfigByName(name string) *configs.Infrastructure_MySQL
+	// PostgresConfigByName 命名的postgres配置；name = DefaultInstanceName 时为 PostgresConfig
+	PostgresConfigByName(name string) *configs.Infrastructure_PSQL
+	// RedisConfigByName 命名的redis配置；name = DefaultInstanceName 时为 RedisConfig
+	RedisConfigByName(name string) *configs.Infrastructure_Redis
 }
 
 // Engine 引擎模块、组件、单元
@@ -132,15 +200,91 @@ type Engine interface {
 	GetPostgresGormDB() (*gorm.DB, error)
 	GetRedisClient() (redis.UniversalClient, error)
 
+	// GetMySQLGormDBByName 命名的 mysql gorm 数据库；name = DefaultInstanceName 时为 GetMySQLGormDB
+	GetMySQLGormDBByName(name string) (*gorm.DB, error)
+	// GetPostgresGormDBByName 命名的 postgres gorm 数据库；name = DefaultInstanceName 时为 GetPostgresGormDB
+	GetPostgresGormDBByName(name string) (*gorm.DB, error)
+	// GetRedisClientByName 命名的 redis 客户端；name = DefaultInstanceName 时为 GetRedisClient
+	GetRedisClientByName(name string) (redis.UniversalClient, error)
+
+	// GetMigrator 数据库迁移；使用 mysql 数据库，未启用时使用 postgres 数据库
+	GetMigrator() (*migrationutil.Migrator, error)
+
+	// GetScheduler 定时任务；需配置 setting.enable_schedule_task = true
+	GetScheduler() (*scheduleutil.Scheduler, error)
+
+	// GetRabbitmqConn rabbitmq 链接；断开后自动重连
+	GetRabbitmqConn() (*rabbitmqutil.Connection, error)
+	// GetRabbitmqConsumer rabbitmq 消费者；注册处理函数后调用 Consumer.Start；Close 时等待处理中的消息
+	GetRabbitmqConsumer() (*rabbitmqutil.Consumer, error)
+
+	// GetOutbox 事务发件箱；使用 mysql 数据库(未启用时使用 postgres 数据库)与 rabbitmq 链接；
+	// 配置 setting.enable_outbox_relay = true 时启动转发
+	GetOutbox() (*outboxutil.Outbox, error)
+
+	// GetMetrics prometheus 指标；配置 server.metrics.enable = true 时
+	// 自动记录数据库、redis 与 clientutil 客户端的指标；服务端使用 middlewareutil.NewServerMiddlewares
+	GetMetrics() (*metricsutil.Metrics, error)
+
+	// GetLocker 分布式锁；需启用 redis，配置多个 setting.lock.redlock_redis_instances 时使用 Redlock；Close 时释放持有的锁
+	GetLocker() (*lockutil.Locker, error)
+	// GetLoginGuard 登录错误锁定；需启用 redis；规则为 setting.login
+	GetLoginGuard() (*loginguardutil.Guard, error)
+	// NewCaptchaService 验证码；需启用 redis；规则为 setting.captcha；短信、邮件需 captchautil.WithSender
+	NewCaptchaService(opts ...captchautil.Option) (*captchautil.Service, error)
+	// GetTransferCipher 传输加密：解密客户端使用公钥加密的字段；密钥为 setting.encrypt_secret.transfer_encrypt
+	GetTransferCipher() (*transferencryptutil.Cipher, error)
+	// GetServiceSigner 服务间请求签名：调用方；配置 setting.encrypt_secret.service_encrypt.enable = true 时 clientutil 的链接签名请求
+	GetServiceSigner() (*servicesignutil.Signer, error)
+	// GetServiceVerifier 服务间请求签名：服务端；middlewareutil.NewServiceVerifyMiddleware
+	GetServiceVerifier() (*servicesignutil.Verifier, error)
+	// NewCache 两级缓存：进程内 LRU + redis；需启用 redis；Close 时关闭
+	NewCache(name string, opts ...cacheutil.Option) (*cacheutil.Cache, error)
+
+	// GetIDGenerator 雪花算法ID生成器；需配置 setting.enable_snowflake_worker = true
+	GetIDGenerator() (IDGenerator, error)
+
 	// SetRegistryType 设置 服务注册类型
 	SetRegistryType(rt registrypkg.RegistryType)
 	GetRegistryType() registrypkg.RegistryType
+	// GetServiceInstance 服务注册的服务实例；配置 setting.enable_service_registry = true 时注册到 consul 或 etcd
+	GetServiceInstance() *registry.ServiceInstance
+	// ServiceRegistryOptions 服务启动后注册(kratos.AfterStart)，停止前注销(kratos.BeforeStop)；未启用服务注册时为空
+	ServiceRegistryOptions() []kratos.Option
+	// RegisterService 服务注册；在服务开始监听后调用；未启用服务注册时忽略
+	RegisterService(ctx context.Context) error
+	// DeregisterService 服务注销；在服务停止前调用；Close 时注销未注销的服务
+	DeregisterService(ctx context.Context) error
 	GetConsulClient() (*consulapi.Client, error)
+	// GetEtcdClient etcd 客户端；服务注册与发现
+	GetEtcdClient() (*clientv3.Client, error)
 	GetJaegerExporter() (*jaeger.Exporter, error)
+	// GetOtlpExporter otlp 链路追踪导出；jaeger 为遗留选项
+	GetOtlpExporter() (*otlptrace.Exporter, error)
 
-	// InitTracerProvider ...
+	// InitTracerProvider 设置 otel.TracerProvider；Close 时导出剩余的追踪数据
 	InitTracerProvider() error
 
+	// EngineStatus 引擎状态：组件状态、服务注册与配置来源
+	EngineStatus() *EngineStatus
+	// AdminHandler 管理端点：引擎状态(json)；配置 server.admin 的基础认证与 ip 白名单
+	AdminHandler() (http.Handler, error)
+	// RegisterAdminHTTPServer 在 http 服务上暴露管理端点；需配置 server.admin.enable = true；
+	// 包含 path + AdminLoggerLevelPath
+	RegisterAdminHTTPServer(srv *khttp.Server) error
+
+	// LoggerLevels 日志的运行时级别；仅包含启用的日志输出
+	LoggerLevels() ([]*LoggerLevelStatus, error)
+	// SetLoggerLevel 调整日志级别；Logger、LoggerHelper、LoggerMiddleware 与 gorm 日志生效；
+	// output 为空时调整所有启用的日志输出；ttl 大于 0 时到期恢复配置的级别
+	SetLoggerLevel(output, level string, ttl time.Duration) error
+	// ResetLoggerLevel 恢复配置的级别；output 为空时恢复所有启用的日志输出
+	ResetLoggerLevel(output string) error
+	// LoggerLevelHandler 管理端点：日志级别；GET 查询，PUT 调整，DELETE 恢复配置的级别
+	LoggerLevelHandler() (http.Handler, error)
+	// RegisterTransferPublicKeyHTTPServer 在 http 服务上暴露传输加密的公钥；未配置 setting.encrypt_secret.transfer_encrypt 时忽略
+	RegisterTransferPublicKeyHTTPServer(srv *khttp.Server) error
+
 	// GetAuthTokenRepo 验证Token工具
 	GetAuthTokenRepo(redisCC redis.UniversalClient) (authpkg.AuthRepo, error)
 }
@@ -1,6 +1,7 @@
 package setuputil
 
 import (
+	"context"
 	"io"
 	stdlog "log"
 	"sync"
@@ -14,10 +15,24 @@ import (
 	registrypkg "github.com/ikaiguang/go-srv-kit/kratos/registry"
 	configs "github.com/my-saas-platform/api-proto/api/config"
 	apputil "github.com/my-saas-platform/api-proto/util/app"
+	cacheutil "github.com/my-saas-platform/api-proto/util/cache"
+	lockutil "github.com/my-saas-platform/api-proto/util/lock"
+	loginguardutil "github.com/my-saas-platform/api-proto/util/loginguard"
+	metricsutil "github.com/my-saas-platform/api-proto/util/metrics"
+	migrationutil "github.com/my-saas-platform/api-proto/util/migration"
+	outboxutil "github.com/my-saas-platform/api-proto/util/outbox"
+	rabbitmqutil "github.com/my-saas-platform/api-proto/util/rabbitmq"
+	scheduleutil "github.com/my-saas-platform/api-proto/util/schedule"
+	servicesignutil "github.com/my-saas-platform/api-proto/util/servicesign"
+	transferencryptutil "github.com/my-saas-platform/api-proto/util/transferencrypt"
 	pkgerrors "github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
 	"go.opentelemetry.io/otel/exporters/jaeger"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
 	consulapi "github.com/hashicorp/consul/api"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
@@ -29,6 +44,16 @@ type engines struct {
 
 	// registryType 服务注册类型
 	registryType registrypkg.RegistryType
+	// serviceRegistrar 服务注册；setting.enable_service_registry
+	serviceRegistryMutex   sync.Mutex
+	serviceRegistrar       registry.Registrar
+	serviceRegistrarCancel context.CancelFunc
+	serviceInstance        *registry.ServiceInstance
+	serviceRegistered      bool
+
+	// componentsMutex 组件最近一次加载的结果；管理端点
+	componentsMutex sync.Mutex
+	components      map[string]*componentRecord
 
 	// loggerPrefixFieldMutex 日志前缀
 	loggerPrefixFieldMutex sync.Once
@@ -41,6 +66,10 @@ type engines struct {
 	// debugHelperCloseFnSlice debug工具
 	debugHelperCloseFnSlice []io.Closer
 
+	// loggerLevelsMutex 日志的运行时级别
+	loggerLevelsMutex sync.Once
+	loggerLevels      *loggerLevels
+
 	// loggerMutex 日志
 	loggerMutex                  sync.Once
 	logger                       log.Logger
@@ -60,20 +89,89 @@ type engines struct {
 	postgresGormMutex sync.Once
 	postgresGormDB    *gorm.DB
 
+	// dbReplicaPoliciesMutex 数据库从库
+	dbReplicaPoliciesMutex sync.Mutex
+	dbReplicaPolicies      map[string]*replicaPolicy
+
+	// migratorMutex 数据库迁移
+	migratorMutex    sync.Once
+	migrator         *migrationutil.Migrator
+	migrations       []*migrationutil.Migration
+	migrationOptions []migrationutil.Option
+
+	// schedulerMutex 定时任务
+	schedulerMutex sync.Once
+	scheduler      *scheduleutil.Scheduler
+
+	// rabbitmqConnMutex rabbitmq 链接
+	rabbitmqConnMutex sync.Once
+	rabbitmqConn      *rabbitmqutil.Connection
+	// rabbitmqDialer 拨号；nil 时使用 rabbitmqutil.DefaultDialer
+	rabbitmqDialer rabbitmqutil.Dialer
+	// rabbitmqConsumerMutex rabbitmq 消费者
+	rabbitmqConsumerMutex sync.Once
+	rabbitmqConsumer      *rabbitmqutil.Consumer
+
+	// outboxMutex 事务发件箱
+	outboxMutex sync.Once
+	outbox      *outboxutil.Outbox
+
 	// redisClientMutex redis客户端
 	redisClientMutex sync.Once
 	redisClient      redis.UniversalClient
 
+	// lockerMutex 分布式锁
+	lockerMutex sync.Once
+	locker      *lockutil.Locker
+
+	// loginGuardMutex 登录错误锁定
+	loginGuardMutex sync.Once
+	loginGuard      *loginguardutil.Guard
+
+	// transferCipherMutex 传输加密
+	transferCipherMutex sync.Once
+	transferCipher      *transferencryptutil.Cipher
+
+	// serviceSignMutex 服务间请求签名
+	serviceSignMutex sync.Once
+	serviceSigner    *servicesignutil.Signer
+	serviceVerifier  *servicesignutil.Verifier
+
+	// cachesMutex 两级缓存
+	cachesMutex sync.Mutex
+	caches      []*cacheutil.Cache
+
 	// consulClientMutex consul客户端
 	consulClientMutex sync.Once
 	consulClient      *consulapi.Client
 
+	// etcdClientMutex etcd
+	etcdClientMutex sync.Once
+	etcdClient      *clientv3.Client
+
+	// instancesMutex 命名实例：mysql、postgres、redis
+	instancesMutex        sync.Mutex
+	mysqlGormInstances    map[string]*gormDBInstance
+	postgresGormInstances map[string]*gormDBInstance
+	redisClientInstances  map[string]*redisClientInstance
+
 	// jaegerTraceExporterMutex jaeger trace
 	jaegerTraceExporterMutex sync.Once
 	jaegerTraceExporter      *jaeger.Exporter
 
-	// snowflakeStopChannel 雪花算法
-	snowflakeStopChannel chan int
+	// metricsMutex prometheus 指标
+	metricsMutex sync.Once
+	metrics      *metricsutil.Metrics
+
+	// otlpTraceExporterMutex otlp trace
+	otlpTraceExporterMutex sync.Once
+	otlpTraceExporter      *otlptrace.Exporter
+	// tracerProvider InitTracerProvider
+	tracerProvider *sdktrace.TracerProvider
+
+	// snowflakeWorker 雪花算法
+	snowflakeWorker            *snowflakeWorker
+	snowflakeNodeAllocatorFunc SnowflakeNodeAllocatorFunc
 
 	// authTokenRepoMutex 验证Token工具
 	authTokenRepoMutex sync.Once
@@ -96,17 +194,34 @@ type configuration struct {
 	enableLoggingConsole bool
 	// enableLoggingFile 是否启用 日志输出到文件
 	enableLoggingFile bool
+
+	// source 配置来源
+	source ConfigSource
 }
 
 // NewConfiguration 配置处理手柄
 func NewConfiguration(opts ...config.Option) (Config, error) {
-	handler := &configuration{}
+	handler, err := newConfiguration(ConfigSource{Type: ConfigSourceTypeCustom}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return handler, nil
+}
+
+// newConfiguration 配置处理手柄
+func newConfiguration(source ConfigSource, opts ...config.Option) (*configuration, error) {
+	handler := &configuration{source: source}
 	if err := handler.init(opts...); err != nil {
 		return nil, err
 	}
 	return handler, nil
 }
 
+// ConfigSource 配置来源
+func (s *configuration) ConfigSource() ConfigSource {
+	return s.source
+}
+
 // init 初始化
 func (s *configuration) init(opts ...config.Option) (err error) {
 	// 处理手柄
@@ -146,6 +261,11 @@ func (s *configuration) init(opts ...config.Option) (err error) {
 		s.enableDebug = apppkg.IsDebugMode()
 	}
 
+	// 命名实例
+	if err = s.checkInstanceNames(); err != nil {
+		return err
+	}
+
 	// 日志
 	if cfg := s.LoggerConfigForConsole(); cfg != nil {
 		s.enableLoggingConsole = cfg.Enable
@@ -177,7 +297,11 @@ func newConfigWithFiles(setupOpts *options) (Config, error) {
 	var opts []config.Option
 	stdlog.Println("|*** 加载：配置文件路径: ", confPath)
 	opts = append(opts, config.WithSource(file.NewSource(confPath)))
-	return NewConfiguration(opts...)
+	handler, err := newConfiguration(ConfigSource{Type: ConfigSourceTypeFile, Path: confPath}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return handler, nil
 }
 
 // newConfigWithConsul 初始化配置手柄
@@ -243,11 +367,16 @@ func newConfigWithConsul(setupOpts *options) (configImpl Config, consulClient *c
 	opts = append(opts, config.WithSource(cs))
 
 	// config impl
-	configImpl, err = NewConfiguration(opts...)
+	source := ConfigSource{
+		Type:    ConfigSourceTypeConsul,
+		Path:    consulKeyPath,
+		Address: cfg.Infrastructure.Consul.Address,
+	}
+	handler, err := newConfiguration(source, opts...)
 	if err != nil {
 		return configImpl, consulClient, err
 	}
-	return configImpl, consulClient, err
+	return handler, consulClient, err
 }
 
 // ParseEnv 解析环境
@@ -255,8 +384,11 @@ func (s *configuration) ParseEnv(appEnv string) apppkg.RuntimeEnvEnum_RuntimeEnv
 	return apppkg.ParseEnv(appEnv)
 }
 
-// Watch 监听
+// Watch 监听；未加载配置源时返回 ErrUninitialized
 func (s *configuration) Watch(key string, o config.Observer) error {
+	if s.handler == nil {
+		return pkgerrors.WithMessage(ErrUninitialized, "config : watch "+key)
+	}
 	return s.handler.Watch(key, o)
 }
 
@@ -316,6 +448,22 @@ func (s *configuration) GRPCConfig() *configs.Server_GRPC {
 	return s.conf.Server.Grpc
 }
 
+// MetricsConfig prometheus 指标配置
+func (s *configuration) MetricsConfig() *configs.Server_Metrics {
+	if s.conf.Server == nil {
+		return nil
+	}
+	return s.conf.Server.Metrics
+}
+
+// AdminConfig 管理端点配置
+func (s *configuration) AdminConfig() *configs.Server_Admin {
+	if s.conf.Server == nil {
+		return nil
+	}
+	return s.conf.Server.Admin
+}
+
 // SettingConfig APP配置
 func (s *configuration) SettingConfig() *configs.Setting {
 	return s.conf.Setting
@@ -339,6 +487,30 @@ func (s *configuration) TokenEncryptConfig() *configs.Setting_EncryptSecret_Toke
 	return s.conf.Setting.EncryptSecret.TokenEncrypt
 }
 
+// TransferEncryptConfig ...
+func (s *configuration) TransferEncryptConfig() *configs.Setting_EncryptSecret_TransferEncrypt {
+	if s.conf.Setting == nil || s.conf.Setting.EncryptSecret == nil {
+		return nil
+	}
+	return s.conf.Setting.EncryptSecret.TransferEncrypt
+}
+
+// ServiceEncryptConfig ...
+func (s *configuration) ServiceEncryptConfig() *configs.Setting_EncryptSecret_ServiceEncrypt {
+	if s.conf.Setting == nil || s.conf.Setting.EncryptSecret == nil {
+		return nil
+	}
+	return s.conf.Setting.EncryptSecret.ServiceEncrypt
+}
+
+// LoggerConfig 日志配置
+func (s *configuration) LoggerConfig() *configs.Infrastructure_Log {
+	if s.conf.Infrastructure == nil {
+		return nil
+	}
+	return s.conf.Infrastructure.Log
+}
+
 // LoggerConfigForConsole 日志配置 控制台
 func (s *configuration) LoggerConfigForConsole() *configs.Infrastructure_Log_Console {
 	if s.conf.Infrastructure == nil || s.conf.Infrastructure.Log == nil {
@@ -395,6 +567,30 @@ func (s *configuration) JaegerConfig() *configs.Infrastructure_Jaeger {
 	return s.conf.Infrastructure.Jaeger
 }
 
+// OtlpConfig otlp 配置
+func (s *configuration) OtlpConfig() *configs.Infrastructure_Otlp {
+	if s.conf.Infrastructure == nil {
+		return nil
+	}
+	return s.conf.Infrastructure.Otlp
+}
+
+// EtcdConfig etcd 配置
+func (s *configuration) EtcdConfig() *configs.Infrastructure_Etcd {
+	if s.conf.Infrastructure == nil {
+		return nil
+	}
+	return s.conf.Infrastructure.Etcd
+}
+
+// RabbitmqConfig rabbitmq 配置
+func (s *configuration) RabbitmqConfig() *configs.Infrastructure_Rabbitmq {
+	if s.conf.Infrastructure == nil {
+		return nil
+	}
+	return s.conf.Infrastructure.Rabbitmq
+}
+
 // SnowflakeWorkerConfig snowflake worker 配置
 func (s *configuration) SnowflakeWorkerConfig() *configs.Infrastructure_Snowflake {
 	if s.conf.Infrastructure == nil {
@@ -402,3 +598,54 @@ func (s *configuration) SnowflakeWorkerConfig() *configs.Infrastructure_Snowflak
 	}
 	return s.conf.Infrastructure.Snowflake
 }
+
+// MySQLConfigByName 命名的mysql配置
+func (s *configuration) MySQLConfigByName(name string) *configs.Infrastructure_MySQL {
+	if name == DefaultInstanceName {
+		return s.MySQLConfig()
+	}
+	if s.conf.Infrastructure == nil {
+		return nil
+	}
+	return s.conf.Infrastructure.MysqlInstances[name]
+}
+
+// PostgresConfigByName 命名的postgres配置
+func (s *configuration) PostgresConfigByName(name string) *configs.Infrastructure_PSQL {
+	if name == DefaultInstanceName {
+		return s.PostgresConfig()
+	}
+	if s.conf.Infrastructure == nil {
+		return nil
+	}
+	return s.conf.Infrastructure.PsqlInstances[name]
+}
+
+// RedisConfigByName 命名的redis配置
+func (s *configuration) RedisConfigByName(name string) *configs.Infrastructure_Redis {
+	if name == DefaultInstanceName {
+		return s.RedisConfig()
+	}
+	if s.conf.Infrastructure == nil {
+		return nil
+	}
+	return s.conf.Infrastructure.RedisInstances[name]
+}
+
+// checkInstanceNames 命名实例不可使用默认实例名称
+func (s *configuration) checkInstanceNames() error {
+	cfg := s.conf.Infrastructure
+	if cfg == nil {
+		return nil
+	}
+	if _, ok := cfg.MysqlInstances[DefaultInstanceName]; ok {
+		return pkgerrors.New("[请配置服务再启动] config key : infrastructure.mysql_instances." + DefaultInstanceName + " 为保留名称；请使用 infrastructure.mysql")
+	}
+	if _, ok := cfg.PsqlInstances[DefaultInstanceName]; ok {
+		return pkgerrors.New("[请配置服务再启动] config key : infrastructure.psql_instances." + DefaultInstanceName + " 为保留名称；请使用 infrastructure.psql")
+	}
+	if _, ok := cfg.RedisInstances[DefaultInstanceName]; ok {
+		return pkgerrors.New("[请配置服务再启动] config key : infrastructure.redis_instances." + DefaultInstanceName + " 为保留名称；请使用 infrastructure.redis")
+	}
+	return nil
+}
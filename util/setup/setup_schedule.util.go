@@ -0,0 +1,47 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	scheduleutil "github.com/my-saas-platform/api-proto/util/schedule"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// GetScheduler 定时任务
+func (s *engines) GetScheduler() (*scheduleutil.Scheduler, error) {
+	if s.scheduler != nil {
+		return s.scheduler, nil
+	}
+	var err error
+	s.schedulerMutex.Do(func() {
+		start := time.Now()
+		s.scheduler, err = s.loadingScheduler()
+		s.recordComponent(ComponentScheduler, start, err)
+	})
+	if err != nil {
+		s.schedulerMutex = sync.Once{}
+	}
+	return s.scheduler, err
+}
+
+// loadingScheduler 定时任务；使用 redis 竞争每次触发的运行权
+func (s *engines) loadingScheduler() (*scheduleutil.Scheduler, error) {
+	if cfg := s.Config.SettingConfig(); cfg == nil || !cfg.EnableScheduleTask {
+		stdlog.Println("|*** 加载：定时任务：未初始化")
+		return nil, pkgerrors.WithMessage(ErrUninitialized, "[请配置服务再启动] setting.enable_schedule_task")
+	}
+	redisCC, err := s.GetRedisClient()
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] 定时任务需启用 redis")
+	}
+	stdlog.Println("|*** 加载：定时任务")
+
+	scheduler := scheduleutil.NewScheduler(redisCC,
+		scheduleutil.WithKeyPrefix(apputil.KeyPrefix(s.Config.AppConfig())),
+	)
+	scheduler.Start()
+	return scheduler, nil
+}
@@ -0,0 +1,92 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	servicesignutil "github.com/my-saas-platform/api-proto/util/servicesign"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// GetServiceSigner 服务间请求签名：调用方；私钥为 setting.encrypt_secret.service_encrypt.private_key
+func (s *engines) GetServiceSigner() (*servicesignutil.Signer, error) {
+	if err := s.loadServiceSign(); err != nil {
+		return nil, err
+	}
+	if s.serviceSigner == nil {
+		return nil, pkgerrors.New("[请配置服务再启动] config key : setting.encrypt_secret.service_encrypt.private_key")
+	}
+	return s.serviceSigner, nil
+}
+
+// GetServiceVerifier 服务间请求签名：服务端；信任 setting.encrypt_secret.service_encrypt.trusted_public_keys 与本服务的公钥
+func (s *engines) GetServiceVerifier() (*servicesignutil.Verifier, error) {
+	if err := s.loadServiceSign(); err != nil {
+		return nil, err
+	}
+	return s.serviceVerifier, nil
+}
+
+// loadServiceSign 服务间请求签名
+func (s *engines) loadServiceSign() error {
+	if s.serviceVerifier != nil {
+		return nil
+	}
+	var err error
+	s.serviceSignMutex.Do(func() {
+		start := time.Now()
+		s.serviceSigner, s.serviceVerifier, err = s.loadingServiceSign()
+		s.recordComponent(ComponentServiceSign, start, err)
+	})
+	if err != nil {
+		s.serviceSignMutex = sync.Once{}
+	}
+	return err
+}
+
+// loadingServiceSign 服务间请求签名；校验密钥；仅接受签名给本服务(app.server_name)的请求；启用 redis 时多个副本共享随机数
+func (s *engines) loadingServiceSign() (*servicesignutil.Signer, *servicesignutil.Verifier, error) {
+	cfg := s.Config.ServiceEncryptConfig()
+	serverName := s.Config.AppConfig().GetServerName()
+	if serverName == "" {
+		return nil, nil, pkgerrors.New("[请配置服务再启动] config key : app.server_name")
+	}
+
+	var (
+		signer *servicesignutil.Signer
+		err    error
+	)
+	if cfg.GetPrivateKey() != "" {
+		signer, err = servicesignutil.NewSigner(serverName, cfg.GetPrivateKey())
+		if err != nil {
+			return nil, nil, pkgerrors.WithMessage(err, "[请配置服务再启动] setting.encrypt_secret.service_encrypt.private_key")
+		}
+	}
+
+	trustedPublicKeys := make(map[string]string, len(cfg.GetTrustedPublicKeys())+1)
+	if cfg.GetPublicKey() != "" {
+		trustedPublicKeys[serverName] = cfg.GetPublicKey()
+	}
+	for service, publicKey := range cfg.GetTrustedPublicKeys() {
+		trustedPublicKeys[service] = publicKey
+	}
+	opts := []servicesignutil.Option{
+		servicesignutil.WithReplayWindow(cfg.GetReplayWindow().AsDuration()),
+	}
+	if s.Config.RedisConfig().GetEnable() {
+		redisCC, err := s.GetRedisClient()
+		if err != nil {
+			return nil, nil, err
+		}
+		keyPrefix := apputil.KeyPrefix(s.Config.AppConfig()) + servicesignutil.DefaultKeyPrefix
+		opts = append(opts, servicesignutil.WithNonceStore(servicesignutil.NewRedisNonceStore(redisCC, keyPrefix)))
+	}
+	verifier, err := servicesignutil.NewVerifier(serverName, trustedPublicKeys, opts...)
+	if err != nil {
+		return nil, nil, pkgerrors.WithMessage(err, "[请配置服务再启动] setting.encrypt_secret.service_encrypt.trusted_public_keys")
+	}
+	stdlog.Println("|*** 加载：服务间请求签名")
+	return signer, verifier, nil
+}
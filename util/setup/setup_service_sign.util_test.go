@@ -0,0 +1,68 @@
+package setuputil
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	servicesignutil "github.com/my-saas-platform/api-proto/util/servicesign"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_GetServiceSigner
+func TestEngines_GetServiceSigner(t *testing.T) {
+	server := miniredis.RunT(t)
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	require.NoError(t, err)
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	app := &configs.App{ProjectName: "testing-project", ServerName: "testing-service", ServerEnv: "testing"}
+	newHandler := func(cfg *configs.Setting_EncryptSecret_ServiceEncrypt) *engines {
+		return initEngine(&configuration{conf: &configs.Bootstrap{
+			App: app,
+			Infrastructure: &configs.Infrastructure{
+				Redis: &configs.Infrastructure_Redis{Enable: true, Addresses: []string{server.Addr()}},
+			},
+			Setting: &configs.Setting{EncryptSecret: &configs.Setting_EncryptSecret{ServiceEncrypt: cfg}},
+		}})
+	}
+
+	t.Run("#self", func(t *testing.T) {
+		handler := newHandler(&configs.Setting_EncryptSecret_ServiceEncrypt{
+			Enable:     true,
+			PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		})
+		defer func() { require.NoError(t, handler.redisClient.Close()) }()
+		signer, err := handler.GetServiceSigner()
+		require.NoError(t, err)
+		verifier, err := handler.GetServiceVerifier()
+		require.NoError(t, err)
+		require.Equal(t, ComponentStateReady, findComponentStatus(handler, ComponentServiceSign).State)
+
+		// 信任本服务的其他副本；随机数记录在 redis
+		header, req := http.Header{}, &servicesignutil.Request{Audience: "testing-service", Path: "/saas.api.ping.servicev1.SrvPingV1/Ping"}
+		require.NoError(t, signer.Sign(header, req))
+		caller, err := verifier.Verify(context.Background(), header, req)
+		require.NoError(t, err)
+		require.Equal(t, "testing-service", caller)
+		require.Len(t, server.Keys(), 1)
+		require.Contains(t, server.Keys()[0], apputil.KeyPrefix(app)+"service_sign:")
+	})
+
+	t.Run("#invalid_private_key", func(t *testing.T) {
+		handler := newHandler(&configs.Setting_EncryptSecret_ServiceEncrypt{Enable: true, PrivateKey: "invalid"})
+		_, err := handler.GetServiceSigner()
+		require.Error(t, err)
+		require.Equal(t, ComponentStateFailed, findComponentStatus(handler, ComponentServiceSign).State)
+	})
+}
@@ -0,0 +1,365 @@
+package setuputil
+
+import (
+	"context"
+	strerrors "errors"
+	"fmt"
+	stdlog "log"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	logpkg "github.com/ikaiguang/go-srv-kit/kratos/log"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// defaultSnowflakeLeaseTTL 节点ID租约时长
+	defaultSnowflakeLeaseTTL = 60 * time.Second
+	// defaultSnowflakeRenewInterval 续期间隔
+	defaultSnowflakeRenewInterval = 5 * time.Second
+	// defaultSnowflakeStartupTimeout 启动时获取节点ID的超时时间
+	defaultSnowflakeStartupTimeout = 30 * time.Second
+	// snowflakeAllocateRetryInterval 获取节点ID的重试间隔
+	snowflakeAllocateRetryInterval = time.Second
+	// snowflakeReleaseTimeout 释放节点ID的超时时间
+	snowflakeReleaseTimeout = 5 * time.Second
+	// snowflakeWaitInterval 等待重新获取节点ID的检查间隔
+	snowflakeWaitInterval = 100 * time.Millisecond
+)
+
+var (
+	// ErrSnowflakeLeaseExpired 节点ID租约已过期；停止生成ID，直到重新获取节点ID
+	ErrSnowflakeLeaseExpired = strerrors.New("snowflake node lease expired")
+	// ErrSnowflakeLeaseLost 节点ID已被其他实例占用
+	ErrSnowflakeLeaseLost = strerrors.New("snowflake node lease lost")
+)
+
+// SnowflakeNodeRequest 获取节点ID
+type SnowflakeNodeRequest struct {
+	// InstanceID 实例ID；唯一
+	InstanceID   string
+	InstanceName string
+	Endpoints    []string
+	Metadata     map[string]string
+	// LeaseTTL 租约时长
+	LeaseTTL time.Duration
+}
+
+// SnowflakeNodeLease 节点ID租约
+type SnowflakeNodeLease struct {
+	// ID 租约ID；由分配者定义
+	ID         uint64
+	InstanceID string
+	NodeID     int64
+	// ExpiredAt 租约过期时间
+	ExpiredAt time.Time
+}
+
+// SnowflakeNodeAllocator 雪花算法节点ID分配
+type SnowflakeNodeAllocator interface {
+	// Allocate 获取节点ID
+	Allocate(ctx context.Context, req *SnowflakeNodeRequest) (*SnowflakeNodeLease, error)
+	// Renew 续期；节点ID已被其他实例占用时返回 ErrSnowflakeLeaseLost
+	Renew(ctx context.Context, lease *SnowflakeNodeLease, leaseTTL time.Duration) (*SnowflakeNodeLease, error)
+	// Release 释放节点ID
+	Release(ctx context.Context, lease *SnowflakeNodeLease) error
+}
+
+// SnowflakeNodeAllocatorFunc 创建节点ID分配者；例：clientutil.NewSnowflakeNodeAllocator
+type SnowflakeNodeAllocatorFunc func(engineHandler Engine) (SnowflakeNodeAllocator, error)
+
+// WithSnowflakeNodeAllocator 雪花算法节点ID分配者；
+// 例：setuputil.New(setuputil.WithSnowflakeNodeAllocator(clientutil.NewSnowflakeNodeAllocator))
+func WithSnowflakeNodeAllocator(fn SnowflakeNodeAllocatorFunc) Option {
+	return func(o *options) {
+		o.snowflakeNodeAllocatorFunc = fn
+	}
+}
+
+// IDGenerator ID生成器；
+// 不设置 idpkg 的全局节点：idpkg.New 无法在租约丢失后停止生成ID，请使用 IDGenerator
+type IDGenerator interface {
+	// NextID 生成ID；租约过期时返回 ErrSnowflakeLeaseExpired
+	NextID() (int64, error)
+	// WaitNextID 生成ID；租约过期时等待重新获取节点ID，ctx 结束或已停止时返回 ErrSnowflakeLeaseExpired
+	WaitNextID(ctx context.Context) (int64, error)
+	// NodeID 节点ID
+	NodeID() int64
+}
+
+// GetIDGenerator ID生成器
+func (s *engines) GetIDGenerator() (IDGenerator, error) {
+	if s.snowflakeWorker == nil {
+		return nil, pkgerrors.WithMessage(ErrUninitialized, "[请配置服务再启动] setting.enable_snowflake_worker")
+	}
+	return s.snowflakeWorker, nil
+}
+
+// loadingSnowflakeWorker 加载雪花算法
+// 设置 WithSnowflakeNodeAllocator 时使用 snowflake-service 分配节点ID；否则使用 redis
+func (s *engines) loadingSnowflakeWorker() error {
+	var (
+		workerConfig = s.SnowflakeWorkerConfig()
+		allocator    SnowflakeNodeAllocator
+		err          error
+	)
+	switch {
+	case s.snowflakeNodeAllocatorFunc != nil:
+		stdlog.Println("|*** 加载：雪花算法：snowflake-service")
+		allocator, err = s.snowflakeNodeAllocatorFunc(s)
+		if err != nil {
+			return err
+		}
+	case workerConfig != nil && workerConfig.Enable:
+		return pkgerrors.New("[请配置服务再启动] 使用 snowflake-service 需设置 setuputil.WithSnowflakeNodeAllocator(clientutil.NewSnowflakeNodeAllocator)")
+	default:
+		stdlog.Println("|*** 加载：雪花算法：redis")
+		redisCC, err := s.GetRedisClient()
+		if err != nil {
+			return pkgerrors.WithMessage(err, "[请配置服务再启动] 雪花算法需启用 redis 或 snowflake-service")
+		}
+		allocator = newRedisSnowflakeNodeAllocator(redisCC)
+	}
+
+	// 租约
+	var (
+		leaseTTL       = workerConfig.GetLeaseTtl().AsDuration()
+		renewInterval  = workerConfig.GetRenewInterval().AsDuration()
+		startupTimeout = workerConfig.GetStartupTimeout().AsDuration()
+	)
+	if leaseTTL <= 0 {
+		leaseTTL = defaultSnowflakeLeaseTTL
+	}
+	if renewInterval <= 0 {
+		renewInterval = defaultSnowflakeRenewInterval
+	}
+	if startupTimeout <= 0 {
+		startupTimeout = defaultSnowflakeStartupTimeout
+	}
+	if renewInterval >= leaseTTL {
+		return pkgerrors.New("[请配置服务再启动] snowflake.renew_interval 需小于 snowflake.lease_ttl")
+	}
+
+	appConfig := s.AppConfig()
+	hostname, _ := os.Hostname()
+	req := &SnowflakeNodeRequest{
+		InstanceID:   fmt.Sprintf("%s:%s:%d", apputil.ID(appConfig), hostname, os.Getpid()),
+		InstanceName: appConfig.ServerName,
+		Endpoints:    append(append([]string{}, appConfig.HttpEndpoints...), appConfig.GrpcEndpoints...),
+		Metadata:     appConfig.Metadata,
+		LeaseTTL:     leaseTTL,
+	}
+	worker := newSnowflakeWorker(allocator, req, renewInterval)
+	if err = worker.start(startupTimeout); err != nil {
+		return err
+	}
+	stdlog.Printf("|*** 加载：雪花算法：nodeId = %d", worker.NodeID())
+	s.snowflakeWorker = worker
+	return nil
+}
+
+// snowflakeWorker 雪花算法节点；后台续期，停止时释放节点ID
+type snowflakeWorker struct {
+	allocator     SnowflakeNodeAllocator
+	req           *SnowflakeNodeRequest
+	renewInterval time.Duration
+
+	mutex     sync.RWMutex
+	lease     *SnowflakeNodeLease
+	expiredAt time.Time
+	node      *snowflake.Node
+
+	stopOnce    sync.Once
+	stopChannel chan struct{}
+	doneChannel chan struct{}
+}
+
+// newSnowflakeWorker ...
+func newSnowflakeWorker(allocator SnowflakeNodeAllocator, req *SnowflakeNodeRequest, renewInterval time.Duration) *snowflakeWorker {
+	return &snowflakeWorker{
+		allocator:     allocator,
+		req:           req,
+		renewInterval: renewInterval,
+		stopChannel:   make(chan struct{}),
+		doneChannel:   make(chan struct{}),
+	}
+}
+
+// NextID 生成ID
+func (w *snowflakeWorker) NextID() (int64, error) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.node == nil || !time.Now().Before(w.expiredAt) {
+		return 0, pkgerrors.WithStack(ErrSnowflakeLeaseExpired)
+	}
+	return w.node.Generate().Int64(), nil
+}
+
+// NodeID 节点ID
+func (w *snowflakeWorker) NodeID() int64 {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+	if w.lease == nil {
+		return -1
+	}
+	return w.lease.NodeID
+}
+
+// WaitNextID 生成ID；租约过期时等待重新获取节点ID
+func (w *snowflakeWorker) WaitNextID(ctx context.Context) (int64, error) {
+	ticker := time.NewTicker(snowflakeWaitInterval)
+	defer ticker.Stop()
+	for {
+		id, err := w.NextID()
+		if err == nil {
+			return id, nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, err
+		case <-w.stopChannel:
+			return 0, err
+		case <-ticker.C:
+		}
+	}
+}
+
+// start 获取节点ID；超时后返回错误
+func (w *snowflakeWorker) start(startupTimeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), startupTimeout)
+	defer cancel()
+
+	for {
+		err := w.allocate(ctx)
+		if err == nil {
+			break
+		}
+		stdlog.Printf("|*** 加载：雪花算法：获取节点ID失败：%s", err.Error())
+		select {
+		case <-ctx.Done():
+			return pkgerrors.WithMessagef(err, "[请配置服务再启动] 雪花算法：%s 内未获取到节点ID", startupTimeout)
+		case <-time.After(snowflakeAllocateRetryInterval):
+		}
+	}
+
+	go w.renewLoop()
+	return nil
+}
+
+// allocate 获取节点ID
+func (w *snowflakeWorker) allocate(ctx context.Context) error {
+	requestedAt := time.Now()
+	lease, err := w.allocator.Allocate(ctx, w.req)
+	if err != nil {
+		return err
+	}
+	node, err := snowflake.NewNode(lease.NodeID)
+	if err != nil {
+		releaseCtx, cancel := context.WithTimeout(context.Background(), snowflakeReleaseTimeout)
+		defer cancel()
+		_ = w.allocator.Release(releaseCtx, lease)
+		return pkgerrors.WithStack(err)
+	}
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.lease = lease
+	w.node = node
+	w.expiredAt = leaseExpiredAt(lease, requestedAt, w.req.LeaseTTL)
+	return nil
+}
+
+// renewLoop 定时续期；租约丢失或过期后重新获取节点ID
+func (w *snowflakeWorker) renewLoop() {
+	defer close(w.doneChannel)
+
+	ticker := time.NewTicker(w.renewInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-w.stopChannel:
+			return
+		case <-ticker.C:
+			w.renew()
+		}
+	}
+}
+
+// renew 续期
+func (w *snowflakeWorker) renew() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.renewInterval)
+	defer cancel()
+
+	w.mutex.RLock()
+	lease := w.lease
+	expiredAt := w.expiredAt
+	w.mutex.RUnlock()
+
+	requestedAt := time.Now()
+	renewed, err := w.allocator.Renew(ctx, lease, w.req.LeaseTTL)
+	if err == nil {
+		w.mutex.Lock()
+		w.lease = renewed
+		w.expiredAt = leaseExpiredAt(renewed, requestedAt, w.req.LeaseTTL)
+		w.mutex.Unlock()
+		return
+	}
+
+	lost := strerrors.Is(pkgerrors.Cause(err), ErrSnowflakeLeaseLost)
+	logpkg.Warnw(
+		"snowflake.nodeId", lease.NodeID,
+		"snowflake.leaseLost", lost,
+		"snowflake.error", "renew failed : "+err.Error(),
+	)
+	if !lost && time.Now().Before(expiredAt) {
+		return
+	}
+
+	// 停止生成ID，重新获取节点ID
+	w.mutex.Lock()
+	w.expiredAt = time.Time{}
+	w.mutex.Unlock()
+	if err = w.allocate(ctx); err != nil {
+		logpkg.Errorw(
+			"snowflake.nodeId", lease.NodeID,
+			"snowflake.error", "reallocate failed : "+err.Error(),
+		)
+		return
+	}
+	logpkg.Warnw(
+		"snowflake.nodeId", w.NodeID(),
+		"snowflake.previousNodeId", lease.NodeID,
+		"snowflake.message", "reallocated",
+	)
+}
+
+// Stop 停止续期并释放节点ID
+func (w *snowflakeWorker) Stop() error {
+	var err error
+	w.stopOnce.Do(func() {
+		close(w.stopChannel)
+		<-w.doneChannel
+
+		w.mutex.Lock()
+		lease := w.lease
+		w.expiredAt = time.Time{}
+		w.mutex.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), snowflakeReleaseTimeout)
+		defer cancel()
+		err = w.allocator.Release(ctx, lease)
+	})
+	return err
+}
+
+// leaseExpiredAt 本地的租约过期时间；以请求时间计算，早于分配者返回的过期时间
+func leaseExpiredAt(lease *SnowflakeNodeLease, requestedAt time.Time, leaseTTL time.Duration) time.Time {
+	expiredAt := requestedAt.Add(leaseTTL)
+	if !lease.ExpiredAt.IsZero() && lease.ExpiredAt.Before(expiredAt) {
+		return lease.ExpiredAt
+	}
+	return expiredAt
+}
@@ -0,0 +1,111 @@
+package setuputil
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestRedisSnowflakeNodeAllocator
+func TestRedisSnowflakeNodeAllocator(t *testing.T) {
+	ctx := context.Background()
+	mr := miniredis.RunT(t)
+	redisCC := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer func() { _ = redisCC.Close() }()
+	allocator := newRedisSnowflakeNodeAllocator(redisCC)
+
+	// 同一实例ID的起始位置相同；节点ID不重复
+	req := &SnowflakeNodeRequest{InstanceID: "instance-a", LeaseTTL: time.Minute}
+	leaseA, err := allocator.Allocate(ctx, req)
+	require.NoError(t, err)
+	leaseB, err := allocator.Allocate(ctx, &SnowflakeNodeRequest{InstanceID: "instance-a", LeaseTTL: time.Minute})
+	require.NoError(t, err)
+	require.NotEqual(t, leaseA.NodeID, leaseB.NodeID)
+
+	// 续期
+	renewed, err := allocator.Renew(ctx, leaseA, 2*time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, leaseA.NodeID, renewed.NodeID)
+	require.Equal(t, 2*time.Minute, mr.TTL(snowflakeRedisKeyPrefix+itoa(leaseA.NodeID)))
+
+	// 过期后被其他实例占用
+	mr.Del(snowflakeRedisKeyPrefix + itoa(leaseA.NodeID))
+	require.NoError(t, mr.Set(snowflakeRedisKeyPrefix+itoa(leaseA.NodeID), "instance-c"))
+	_, err = allocator.Renew(ctx, leaseA, time.Minute)
+	require.ErrorIs(t, err, ErrSnowflakeLeaseLost)
+
+	// 仅释放属于当前实例的节点ID
+	require.NoError(t, allocator.Release(ctx, leaseA))
+	require.True(t, mr.Exists(snowflakeRedisKeyPrefix+itoa(leaseA.NodeID)))
+	require.NoError(t, allocator.Release(ctx, leaseB))
+	require.False(t, mr.Exists(snowflakeRedisKeyPrefix+itoa(leaseB.NodeID)))
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestSnowflakeWorker
+func TestSnowflakeWorker(t *testing.T) {
+	mr := miniredis.RunT(t)
+	redisCC := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer func() { _ = redisCC.Close() }()
+
+	req := &SnowflakeNodeRequest{InstanceID: "instance-a", LeaseTTL: time.Second}
+	worker := newSnowflakeWorker(newRedisSnowflakeNodeAllocator(redisCC), req, 20*time.Millisecond)
+	require.NoError(t, worker.start(time.Second))
+	nodeID := worker.NodeID()
+
+	id1, err := worker.NextID()
+	require.NoError(t, err)
+	id2, err := worker.NextID()
+	require.NoError(t, err)
+	require.Greater(t, id2, id1)
+
+	// 节点ID被其他实例占用后重新获取
+	key := snowflakeRedisKeyPrefix + itoa(nodeID)
+	require.NoError(t, mr.Set(key, "instance-b"))
+	require.Eventually(t, func() bool {
+		return worker.NodeID() != nodeID
+	}, time.Second, 10*time.Millisecond)
+	_, err = worker.NextID()
+	require.NoError(t, err)
+
+	// 租约过期：等待重新获取节点ID
+	worker.mutex.Lock()
+	worker.expiredAt = time.Time{}
+	worker.mutex.Unlock()
+	_, err = worker.NextID()
+	require.ErrorIs(t, err, ErrSnowflakeLeaseExpired)
+	require.NoError(t, mr.Set(snowflakeRedisKeyPrefix+itoa(worker.NodeID()), "instance-b"))
+	waitCtx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	_, err = worker.WaitNextID(waitCtx)
+	require.NoError(t, err)
+
+	// 停止后释放节点ID
+	newKey := snowflakeRedisKeyPrefix + itoa(worker.NodeID())
+	require.True(t, mr.Exists(newKey))
+	require.NoError(t, worker.Stop())
+	require.False(t, mr.Exists(newKey))
+	_, err = worker.NextID()
+	require.ErrorIs(t, err, ErrSnowflakeLeaseExpired)
+	_, err = worker.WaitNextID(context.Background())
+	require.ErrorIs(t, err, ErrSnowflakeLeaseExpired)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestSnowflakeWorker_StartupTimeout
+func TestSnowflakeWorker_StartupTimeout(t *testing.T) {
+	// 无法连接
+	redisCC := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", MaxRetries: -1})
+	defer func() { _ = redisCC.Close() }()
+
+	req := &SnowflakeNodeRequest{InstanceID: "instance-a", LeaseTTL: time.Second}
+	worker := newSnowflakeWorker(newRedisSnowflakeNodeAllocator(redisCC), req, 20*time.Millisecond)
+	require.Error(t, worker.start(100*time.Millisecond))
+}
+
+func itoa(i int64) string {
+	return strconv.FormatInt(i, 10)
+}
@@ -0,0 +1,102 @@
+package setuputil
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	pkgerrors "github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// snowflakeRedisKeyPrefix 节点ID；全部服务共用，保证节点ID全局唯一
+	snowflakeRedisKeyPrefix = "snowflake:node_id:"
+)
+
+var (
+	// _snowflakeRenewScript 续期；节点ID仍属于当前实例时续期
+	_snowflakeRenewScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+	// _snowflakeReleaseScript 释放；节点ID仍属于当前实例时删除
+	_snowflakeReleaseScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+)
+
+// redisSnowflakeNodeAllocator redis 分配节点ID；SET NX 占用节点ID，过期自动释放
+type redisSnowflakeNodeAllocator struct {
+	redisCC   redis.UniversalClient
+	maxNodeID int64
+}
+
+// newRedisSnowflakeNodeAllocator ...
+func newRedisSnowflakeNodeAllocator(redisCC redis.UniversalClient) SnowflakeNodeAllocator {
+	return &redisSnowflakeNodeAllocator{
+		redisCC:   redisCC,
+		maxNodeID: -1 ^ (-1 << snowflake.NodeBits),
+	}
+}
+
+// Allocate 获取节点ID；从实例ID的哈希位置开始查找空闲的节点ID
+func (s *redisSnowflakeNodeAllocator) Allocate(ctx context.Context, req *SnowflakeNodeRequest) (*SnowflakeNodeLease, error) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(req.InstanceID))
+	var (
+		total = s.maxNodeID + 1
+		start = int64(h.Sum64() % uint64(total))
+	)
+	for i := int64(0); i < total; i++ {
+		nodeID := (start + i) % total
+		ok, err := s.redisCC.SetNX(ctx, s.key(nodeID), req.InstanceID, req.LeaseTTL).Result()
+		if err != nil {
+			return nil, pkgerrors.WithStack(err)
+		}
+		if ok {
+			return &SnowflakeNodeLease{
+				ID:         uint64(nodeID),
+				InstanceID: req.InstanceID,
+				NodeID:     nodeID,
+				ExpiredAt:  time.Now().Add(req.LeaseTTL),
+			}, nil
+		}
+	}
+	return nil, pkgerrors.Errorf("snowflake : 节点ID已用尽 : max = %d", s.maxNodeID)
+}
+
+// Renew 续期
+func (s *redisSnowflakeNodeAllocator) Renew(ctx context.Context, lease *SnowflakeNodeLease, leaseTTL time.Duration) (*SnowflakeNodeLease, error) {
+	renewedAt := time.Now()
+	res, err := _snowflakeRenewScript.Run(ctx, s.redisCC,
+		[]string{s.key(lease.NodeID)}, lease.InstanceID, leaseTTL.Milliseconds(),
+	).Int64()
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	if res == 0 {
+		return nil, pkgerrors.WithStack(ErrSnowflakeLeaseLost)
+	}
+	renewed := *lease
+	renewed.ExpiredAt = renewedAt.Add(leaseTTL)
+	return &renewed, nil
+}
+
+// Release 释放节点ID
+func (s *redisSnowflakeNodeAllocator) Release(ctx context.Context, lease *SnowflakeNodeLease) error {
+	err := _snowflakeReleaseScript.Run(ctx, s.redisCC, []string{s.key(lease.NodeID)}, lease.InstanceID).Err()
+	return pkgerrors.WithStack(err)
+}
+
+// key ...
+func (s *redisSnowflakeNodeAllocator) key(nodeID int64) string {
+	return snowflakeRedisKeyPrefix + strconv.FormatInt(nodeID, 10)
+}
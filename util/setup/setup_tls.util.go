@@ -0,0 +1,48 @@
+package setuputil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// tlsConfigOption tls配置；证书为PEM内容
+type tlsConfigOption struct {
+	serverName         string
+	insecureSkipVerify bool
+	caPem              string
+	certPem            string
+	keyPem             string
+}
+
+// newTLSConfig tls配置
+func newTLSConfig(opt *tlsConfigOption) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		ServerName:         opt.serverName,
+		InsecureSkipVerify: opt.insecureSkipVerify,
+		MinVersion:         tls.VersionTLS12,
+	}
+
+	// 证书颁发机构
+	if opt.caPem != "" {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM([]byte(opt.caPem)) {
+			return nil, pkgerrors.New("tls : 无效的 tls_ca_pem")
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	// 客户端证书
+	switch {
+	case opt.certPem != "" && opt.keyPem != "":
+		cert, err := tls.X509KeyPair([]byte(opt.certPem), []byte(opt.keyPem))
+		if err != nil {
+			return nil, pkgerrors.WithMessage(err, "tls : 无效的 tls_cert_pem 或 tls_key_pem")
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case opt.certPem != "" || opt.keyPem != "":
+		return nil, pkgerrors.New("tls : tls_cert_pem 与 tls_key_pem 需同时配置")
+	}
+	return tlsConfig, nil
+}
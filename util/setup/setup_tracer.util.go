@@ -0,0 +1,246 @@
+package setuputil
+
+import (
+	"context"
+	stdlog "log"
+	"strings"
+	"sync"
+	"time"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	pkgerrors "github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"google.golang.org/grpc/credentials"
+	// 注册 grpc gzip 压缩
+	_ "google.golang.org/grpc/encoding/gzip"
+)
+
+const (
+	// otlp 传输协议
+	OtlpProtocolGRPC = "grpc"
+	OtlpProtocolHTTP = "http"
+
+	// otlp 压缩
+	OtlpCompressionNone = "none"
+	OtlpCompressionGzip = "gzip"
+
+	// 采样
+	TracerSamplerAlwaysOn                = "always_on"
+	TracerSamplerAlwaysOff               = "always_off"
+	TracerSamplerTraceIDRatio            = "traceidratio"
+	TracerSamplerParentBasedAlwaysOn     = "parentbased_always_on"
+	TracerSamplerParentBasedAlwaysOff    = "parentbased_always_off"
+	TracerSamplerParentBasedTraceIDRatio = "parentbased_traceidratio"
+)
+
+// GetOtlpExporter otlp 链路追踪导出
+func (s *engines) GetOtlpExporter() (*otlptrace.Exporter, error) {
+	if s.otlpTraceExporter != nil {
+		return s.otlpTraceExporter, nil
+	}
+	var err error
+	s.otlpTraceExporterMutex.Do(func() {
+		start := time.Now()
+		s.otlpTraceExporter, err = s.loadingOtlpTraceExporter()
+		s.recordComponent(ComponentOtlp, start, err)
+	})
+	if err != nil {
+		s.otlpTraceExporterMutex = sync.Once{}
+	}
+	return s.otlpTraceExporter, err
+}
+
+// loadingOtlpTraceExporter otlp 链路追踪导出
+func (s *engines) loadingOtlpTraceExporter() (*otlptrace.Exporter, error) {
+	cfg := s.Config.OtlpConfig()
+	if cfg == nil {
+		stdlog.Println("|*** 加载：OtlpTrace：未初始化")
+		return nil, pkgerrors.WithStack(ErrUninitialized)
+	}
+	stdlog.Println("|*** 加载：OtlpTrace：...")
+
+	client, err := newOtlpTraceClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// 不阻塞链接：grpc 后台链接，http 导出时请求
+	exporter, err := otlptrace.New(context.Background(), client)
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "otlp trace exporter failed")
+	}
+	return exporter, nil
+}
+
+// newOtlpTraceClient otlp 客户端；protocol：grpc(默认)、http
+func newOtlpTraceClient(cfg *configs.Infrastructure_Otlp) (otlptrace.Client, error) {
+	if cfg.Endpoint == "" {
+		return nil, pkgerrors.New("[请配置服务再启动] config key : otlp.endpoint")
+	}
+	compression := strings.ToLower(cfg.Compression)
+	if compression != "" && compression != OtlpCompressionNone && compression != OtlpCompressionGzip {
+		return nil, pkgerrors.Errorf("[请配置服务再启动] 无效的 otlp.compression : %s", cfg.Compression)
+	}
+
+	// tls
+	var tlsConfigOpt *tlsConfigOption
+	if !cfg.Insecure {
+		tlsConfigOpt = &tlsConfigOption{
+			insecureSkipVerify: cfg.InsecureSkipVerify,
+			caPem:              cfg.TlsCaPem,
+			certPem:            cfg.TlsCertPem,
+			keyPem:             cfg.TlsKeyPem,
+		}
+	}
+
+	switch strings.ToLower(cfg.Protocol) {
+	case "", OtlpProtocolGRPC:
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.Endpoint)}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(cfg.Headers))
+		}
+		if compression == OtlpCompressionGzip {
+			opts = append(opts, otlptracegrpc.WithCompressor(OtlpCompressionGzip))
+		}
+		if cfg.Timeout.AsDuration() > 0 {
+			opts = append(opts, otlptracegrpc.WithTimeout(cfg.Timeout.AsDuration()))
+		}
+		if tlsConfigOpt == nil {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		} else {
+			tlsConfig, err := newTLSConfig(tlsConfigOpt)
+			if err != nil {
+				return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] otlp")
+			}
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+		return otlptracegrpc.NewClient(opts...), nil
+	case OtlpProtocolHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.UrlPath != "" {
+			opts = append(opts, otlptracehttp.WithURLPath(cfg.UrlPath))
+		}
+		if len(cfg.Headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+		if compression == OtlpCompressionGzip {
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		}
+		if cfg.Timeout.AsDuration() > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(cfg.Timeout.AsDuration()))
+		}
+		if tlsConfigOpt == nil {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		} else {
+			tlsConfig, err := newTLSConfig(tlsConfigOpt)
+			if err != nil {
+				return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] otlp")
+			}
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		}
+		return otlptracehttp.NewClient(opts...), nil
+	default:
+		return nil, pkgerrors.Errorf("[请配置服务再启动] 无效的 otlp.protocol : %s", cfg.Protocol)
+	}
+}
+
+// newTracerSampler 采样；默认 parentbased_traceidratio；sampler_ratio 未配置时为 1
+func newTracerSampler(cfg *configs.Infrastructure_Otlp) (sdktrace.Sampler, error) {
+	var (
+		name  string
+		ratio = 1.0
+	)
+	if cfg != nil {
+		name = strings.ToLower(cfg.Sampler)
+		// 未配置时为 1；0 时不采样
+		if cfg.SamplerRatio != nil {
+			ratio = cfg.GetSamplerRatio()
+		}
+		if ratio < 0 || ratio > 1 {
+			return nil, pkgerrors.Errorf("[请配置服务再启动] 无效的 otlp.sampler_ratio : %v", ratio)
+		}
+	}
+	switch name {
+	case "", TracerSamplerParentBasedTraceIDRatio:
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio)), nil
+	case TracerSamplerTraceIDRatio:
+		return sdktrace.TraceIDRatioBased(ratio), nil
+	case TracerSamplerAlwaysOn:
+		return sdktrace.AlwaysSample(), nil
+	case TracerSamplerAlwaysOff:
+		return sdktrace.NeverSample(), nil
+	case TracerSamplerParentBasedAlwaysOn:
+		return sdktrace.ParentBased(sdktrace.AlwaysSample()), nil
+	case TracerSamplerParentBasedAlwaysOff:
+		return sdktrace.ParentBased(sdktrace.NeverSample()), nil
+	default:
+		return nil, pkgerrors.Errorf("[请配置服务再启动] 无效的 otlp.sampler : %s", cfg.Sampler)
+	}
+}
+
+// newTracerResource 资源；service.name = apputil.ID(app)，app 生成的属性优先于 otlp.resource_attributes
+func newTracerResource(appConfig *configs.App, cfg *configs.Infrastructure_Otlp) *resource.Resource {
+	var attrs []attribute.KeyValue
+	if cfg != nil {
+		for k, v := range cfg.ResourceAttributes {
+			attrs = append(attrs, attribute.String(k, v))
+		}
+	}
+	attrs = append(attrs, semconv.ServiceName(apputil.ID(appConfig)))
+	if appConfig != nil {
+		if appConfig.ProjectName != "" {
+			attrs = append(attrs, semconv.ServiceNamespace(appConfig.ProjectName))
+		}
+		if appConfig.ServerVersion != "" {
+			attrs = append(attrs, semconv.ServiceVersion(appConfig.ServerVersion))
+		}
+		if appConfig.ServerEnv != "" {
+			attrs = append(attrs, semconv.DeploymentEnvironment(appConfig.ServerEnv))
+		}
+	}
+	return resource.NewSchemaless(attrs...)
+}
+
+// InitTracerProvider trace provider；导出：otlp、jaeger(遗留)；采样与资源属性使用 otlp 配置
+func (s *engines) InitTracerProvider() error {
+	stdlog.Println("|*** 加载：服务追踪：Tracer")
+
+	otlpConfig := s.Config.OtlpConfig()
+	sampler, err := newTracerSampler(otlpConfig)
+	if err != nil {
+		return err
+	}
+	providerOptions := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(newTracerResource(s.Config.AppConfig(), otlpConfig)),
+	}
+
+	// otlp
+	if otlpConfig != nil && otlpConfig.Enable {
+		exp, err := s.GetOtlpExporter()
+		if err != nil {
+			return err
+		}
+		providerOptions = append(providerOptions, sdktrace.WithBatcher(exp))
+	}
+
+	// jaeger
+	if cfg := s.Config.JaegerConfig(); cfg != nil && cfg.Enable {
+		exp, err := s.GetJaegerExporter()
+		if err != nil {
+			return err
+		}
+		providerOptions = append(providerOptions, sdktrace.WithBatcher(exp))
+	}
+
+	s.tracerProvider = sdktrace.NewTracerProvider(providerOptions...)
+	otel.SetTracerProvider(s.tracerProvider)
+	return nil
+}
@@ -0,0 +1,295 @@
+package setuputil
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	apputil "github.com/my-saas-platform/api-proto/util/app"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	collectortracev1 "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// testingOtlpReceiver otlp 接收
+type testingOtlpReceiver struct {
+	collectortracev1.UnimplementedTraceServiceServer
+
+	mutex    sync.Mutex
+	requests []*collectortracev1.ExportTraceServiceRequest
+	headers  []map[string]string
+}
+
+func (r *testingOtlpReceiver) Export(ctx context.Context, req *collectortracev1.ExportTraceServiceRequest) (*collectortracev1.ExportTraceServiceResponse, error) {
+	headers := make(map[string]string)
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for k, v := range md {
+			headers[k] = v[0]
+		}
+	}
+	r.record(req, headers)
+	return &collectortracev1.ExportTraceServiceResponse{}, nil
+}
+
+func (r *testingOtlpReceiver) record(req *collectortracev1.ExportTraceServiceRequest, headers map[string]string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.requests = append(r.requests, req)
+	r.headers = append(r.headers, headers)
+}
+
+// ServeHTTP otlp/http：protobuf
+func (r *testingOtlpReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	var body io.Reader = req.Body
+	if req.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(req.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body = gzipReader
+	}
+	data, err := io.ReadAll(body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	exportReq := &collectortracev1.ExportTraceServiceRequest{}
+	if err = proto.Unmarshal(data, exportReq); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	headers := map[string]string{
+		"path":             req.URL.Path,
+		"authorization":    req.Header.Get("Authorization"),
+		"content-encoding": req.Header.Get("Content-Encoding"),
+	}
+	r.record(exportReq, headers)
+
+	resp, _ := proto.Marshal(&collectortracev1.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(resp)
+}
+
+// spans 接收的span名称与资源属性
+func (r *testingOtlpReceiver) spans() (names []string, resourceAttrs map[string]string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	resourceAttrs = make(map[string]string)
+	for _, req := range r.requests {
+		for _, rs := range req.ResourceSpans {
+			for _, kv := range rs.GetResource().GetAttributes() {
+				resourceAttrs[kv.Key] = kv.GetValue().GetStringValue()
+			}
+			for _, ss := range rs.ScopeSpans {
+				for _, span := range ss.Spans {
+					names = append(names, span.Name)
+				}
+			}
+		}
+	}
+	return names, resourceAttrs
+}
+
+// lastHeaders 最后一次请求的请求头
+func (r *testingOtlpReceiver) lastHeaders() map[string]string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if len(r.headers) == 0 {
+		return nil
+	}
+	return r.headers[len(r.headers)-1]
+}
+
+func newTestingTracerEngine(otlpConfig *configs.Infrastructure_Otlp) (*engines, *configs.App) {
+	appConfig := &configs.App{
+		ProjectName:   "testing-project",
+		ServerName:    "testing-service",
+		ServerEnv:     "testing",
+		ServerVersion: "v1.0.0",
+	}
+	return initEngine(&configuration{
+		conf: &configs.Bootstrap{
+			App:            appConfig,
+			Infrastructure: &configs.Infrastructure{Otlp: otlpConfig},
+		},
+	}), appConfig
+}
+
+// exportTestingSpan 创建span并导出
+func exportTestingSpan(t *testing.T, handler *engines, spanName string) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	require.NoError(t, handler.InitTracerProvider())
+	_, span := otel.Tracer("testing").Start(context.Background(), spanName)
+	span.End()
+	require.NoError(t, handler.tracerProvider.Shutdown(context.Background()))
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_InitTracerProvider_OtlpGRPC
+func TestEngines_InitTracerProvider_OtlpGRPC(t *testing.T) {
+	receiver := &testingOtlpReceiver{}
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	collectortracev1.RegisterTraceServiceServer(server, receiver)
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	handler, appConfig := newTestingTracerEngine(&configs.Infrastructure_Otlp{
+		Enable:             true,
+		Endpoint:           lis.Addr().String(),
+		Insecure:           true,
+		Headers:            map[string]string{"authorization": "Bearer testing"},
+		Compression:        OtlpCompressionGzip,
+		ResourceAttributes: map[string]string{"team": "platform", "service.name": "overridden"},
+	})
+	exportTestingSpan(t, handler, "grpc span")
+
+	names, resourceAttrs := receiver.spans()
+	require.Equal(t, []string{"grpc span"}, names)
+	require.Equal(t, apputil.ID(appConfig), resourceAttrs["service.name"])
+	require.Equal(t, "testing-project", resourceAttrs["service.namespace"])
+	require.Equal(t, "v1.0.0", resourceAttrs["service.version"])
+	require.Equal(t, "testing", resourceAttrs["deployment.environment"])
+	require.Equal(t, "platform", resourceAttrs["team"])
+	require.Equal(t, "Bearer testing", receiver.lastHeaders()["authorization"])
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_InitTracerProvider_OtlpHTTP
+func TestEngines_InitTracerProvider_OtlpHTTP(t *testing.T) {
+	receiver := &testingOtlpReceiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	handler, appConfig := newTestingTracerEngine(&configs.Infrastructure_Otlp{
+		Enable:      true,
+		Endpoint:    server.Listener.Addr().String(),
+		Protocol:    OtlpProtocolHTTP,
+		UrlPath:     "/testing/v1/traces",
+		Insecure:    true,
+		Headers:     map[string]string{"Authorization": "Bearer testing"},
+		Compression: OtlpCompressionGzip,
+		Sampler:     TracerSamplerAlwaysOn,
+	})
+	exportTestingSpan(t, handler, "http span")
+
+	names, resourceAttrs := receiver.spans()
+	require.Equal(t, []string{"http span"}, names)
+	require.Equal(t, apputil.ID(appConfig), resourceAttrs["service.name"])
+	require.Equal(t, "/testing/v1/traces", receiver.lastHeaders()["path"])
+	require.Equal(t, "Bearer testing", receiver.lastHeaders()["authorization"])
+	require.Equal(t, "gzip", receiver.lastHeaders()["content-encoding"])
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_InitTracerProvider_AlwaysOff
+func TestEngines_InitTracerProvider_AlwaysOff(t *testing.T) {
+	receiver := &testingOtlpReceiver{}
+	server := httptest.NewServer(receiver)
+	defer server.Close()
+
+	handler, _ := newTestingTracerEngine(&configs.Infrastructure_Otlp{
+		Enable:   true,
+		Endpoint: server.Listener.Addr().String(),
+		Protocol: OtlpProtocolHTTP,
+		Insecure: true,
+		Sampler:  TracerSamplerAlwaysOff,
+	})
+	exportTestingSpan(t, handler, "dropped span")
+
+	names, _ := receiver.spans()
+	require.Empty(t, names)
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestNewTracerSampler
+func TestNewTracerSampler(t *testing.T) {
+	tests := []struct {
+		name     string
+		cfg      *configs.Infrastructure_Otlp
+		wantDesc string
+		wantErr  bool
+	}{
+		{
+			name:     "#default",
+			cfg:      nil,
+			wantDesc: "ParentBased{root:AlwaysOnSampler,",
+		},
+		{
+			name:     "#traceidratio",
+			cfg:      &configs.Infrastructure_Otlp{Sampler: TracerSamplerTraceIDRatio, SamplerRatio: proto.Float64(0.25)},
+			wantDesc: "TraceIDRatioBased{0.25}",
+		},
+		{
+			name:     "#ratio_zero",
+			cfg:      &configs.Infrastructure_Otlp{SamplerRatio: proto.Float64(0)},
+			wantDesc: "ParentBased{root:TraceIDRatioBased{0}",
+		},
+		{
+			name:     "#ratio_unset",
+			cfg:      &configs.Infrastructure_Otlp{Sampler: TracerSamplerTraceIDRatio},
+			wantDesc: "AlwaysOnSampler",
+		},
+		{
+			name:     "#always_off",
+			cfg:      &configs.Infrastructure_Otlp{Sampler: "ALWAYS_OFF"},
+			wantDesc: "AlwaysOffSampler",
+		},
+		{
+			name:    "#invalid_sampler",
+			cfg:     &configs.Infrastructure_Otlp{Sampler: "sometimes"},
+			wantErr: true,
+		},
+		{
+			name:    "#invalid_ratio",
+			cfg:     &configs.Infrastructure_Otlp{SamplerRatio: proto.Float64(1.5)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sampler, err := newTracerSampler(tt.cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, sampler.Description(), tt.wantDesc)
+		})
+	}
+}
+
+// go test -v ./util/setup/ -count=1 -test.run=TestNewOtlpTraceClient
+func TestNewOtlpTraceClient(t *testing.T) {
+	tests := []struct {
+		name    string
+		cfg     *configs.Infrastructure_Otlp
+		wantErr bool
+	}{
+		{name: "#grpc", cfg: &configs.Infrastructure_Otlp{Endpoint: "127.0.0.1:4317", Insecure: true}},
+		{name: "#http_tls", cfg: &configs.Infrastructure_Otlp{Endpoint: "127.0.0.1:4318", Protocol: OtlpProtocolHTTP}},
+		{name: "#no_endpoint", cfg: &configs.Infrastructure_Otlp{}, wantErr: true},
+		{name: "#invalid_protocol", cfg: &configs.Infrastructure_Otlp{Endpoint: "127.0.0.1:4317", Protocol: "udp"}, wantErr: true},
+		{name: "#invalid_compression", cfg: &configs.Infrastructure_Otlp{Endpoint: "127.0.0.1:4317", Compression: "zstd"}, wantErr: true},
+		{name: "#invalid_tls_pem", cfg: &configs.Infrastructure_Otlp{Endpoint: "127.0.0.1:4317", TlsCaPem: "pem"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newOtlpTraceClient(tt.cfg)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
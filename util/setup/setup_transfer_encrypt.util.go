@@ -0,0 +1,65 @@
+package setuputil
+
+import (
+	stdlog "log"
+	"sync"
+	"time"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	transferencryptutil "github.com/my-saas-platform/api-proto/util/transferencrypt"
+	pkgerrors "github.com/pkg/errors"
+)
+
+// GetTransferCipher 传输加密；密钥为 setting.encrypt_secret.transfer_encrypt
+func (s *engines) GetTransferCipher() (*transferencryptutil.Cipher, error) {
+	if s.transferCipher != nil {
+		return s.transferCipher, nil
+	}
+	var err error
+	s.transferCipherMutex.Do(func() {
+		start := time.Now()
+		s.transferCipher, err = s.loadingTransferCipher()
+		s.recordComponent(ComponentTransferEncrypt, start, err)
+	})
+	if err != nil {
+		s.transferCipherMutex = sync.Once{}
+	}
+	return s.transferCipher, err
+}
+
+// loadingTransferCipher 传输加密；校验密钥对
+func (s *engines) loadingTransferCipher() (*transferencryptutil.Cipher, error) {
+	cfg := s.Config.TransferEncryptConfig()
+	if cfg.GetPublicKey() == "" {
+		return nil, pkgerrors.New("[请配置服务再启动] config key : setting.encrypt_secret.transfer_encrypt.public_key")
+	}
+	if cfg.GetPrivateKey() == "" {
+		return nil, pkgerrors.New("[请配置服务再启动] config key : setting.encrypt_secret.transfer_encrypt.private_key")
+	}
+	cipher, err := transferencryptutil.NewCipher(cfg.GetPublicKey(), cfg.GetPrivateKey())
+	if err != nil {
+		return nil, pkgerrors.WithMessage(err, "[请配置服务再启动] setting.encrypt_secret.transfer_encrypt")
+	}
+	stdlog.Println("|*** 加载：传输加密：" + cipher.KeyID())
+	return cipher, nil
+}
+
+// RegisterTransferPublicKeyHTTPServer 在 http 服务上暴露传输加密的公钥；
+// 路径为 transferencryptutil.DefaultPublicKeyPath；未配置 setting.encrypt_secret.transfer_encrypt 时忽略
+func (s *engines) RegisterTransferPublicKeyHTTPServer(srv *khttp.Server) error {
+	if !s.transferEncryptEnabled() {
+		return nil
+	}
+	cipher, err := s.GetTransferCipher()
+	if err != nil {
+		return err
+	}
+	srv.Handle(transferencryptutil.DefaultPublicKeyPath, cipher.PublicKeyHandler())
+	return nil
+}
+
+// transferEncryptEnabled 已配置传输加密的密钥
+func (s *engines) transferEncryptEnabled() bool {
+	cfg := s.Config.TransferEncryptConfig()
+	return cfg.GetPublicKey() != "" || cfg.GetPrivateKey() != ""
+}
@@ -0,0 +1,67 @@
+package setuputil
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	khttp "github.com/go-kratos/kratos/v2/transport/http"
+	configs "github.com/my-saas-platform/api-proto/api/config"
+	transferencryptutil "github.com/my-saas-platform/api-proto/util/transferencrypt"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/setup/ -count=1 -test.run=TestEngines_GetTransferCipher
+func TestEngines_GetTransferCipher(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	transferConfig := &configs.Setting_EncryptSecret_TransferEncrypt{
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})),
+	}
+	newHandler := func(cfg *configs.Setting_EncryptSecret_TransferEncrypt) *engines {
+		return initEngine(&configuration{conf: &configs.Bootstrap{
+			Setting: &configs.Setting{EncryptSecret: &configs.Setting_EncryptSecret{TransferEncrypt: cfg}},
+		}})
+	}
+
+	t.Run("#public_key", func(t *testing.T) {
+		handler := newHandler(transferConfig)
+		cipher, err := handler.GetTransferCipher()
+		require.NoError(t, err)
+		require.Equal(t, ComponentStateReady, findComponentStatus(handler, ComponentTransferEncrypt).State)
+
+		srv := khttp.NewServer()
+		require.NoError(t, handler.RegisterTransferPublicKeyHTTPServer(srv))
+		recorder := httptest.NewRecorder()
+		srv.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, transferencryptutil.DefaultPublicKeyPath, nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.Contains(t, recorder.Body.String(), cipher.KeyID())
+	})
+
+	t.Run("#mismatched_key_pair", func(t *testing.T) {
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		handler := newHandler(&configs.Setting_EncryptSecret_TransferEncrypt{
+			PublicKey:  transferConfig.PublicKey,
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(otherKey)})),
+		})
+		_, err = handler.GetTransferCipher()
+		require.Error(t, err)
+		require.Equal(t, ComponentStateFailed, findComponentStatus(handler, ComponentTransferEncrypt).State)
+	})
+
+	t.Run("#disabled", func(t *testing.T) {
+		handler := newHandler(nil)
+		require.NoError(t, handler.RegisterTransferPublicKeyHTTPServer(khttp.NewServer()))
+		_, err := handler.GetTransferCipher()
+		require.Error(t, err)
+		require.Equal(t, ComponentStateFailed, findComponentStatus(handler, ComponentTransferEncrypt).State)
+	})
+}
@@ -0,0 +1,154 @@
+package tokenkeyutil
+
+import (
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// HeaderKeyID jwt 头部的密钥ID
+	HeaderKeyID = "kid"
+	// refreshKeyIDSeparator 刷新令牌：kid + "." + 密文；密文为 base64(url)，不包含 "."
+	refreshKeyIDSeparator = "."
+)
+
+var (
+	// ErrNoActiveKey 无生效的密钥
+	ErrNoActiveKey = pkgerrors.New("token key : no active key")
+	// ErrUnknownKey kid 不在密钥集合中
+	ErrUnknownKey = pkgerrors.New("token key : unknown key")
+	// ErrRetiredKey 密钥已退役
+	ErrRetiredKey = pkgerrors.New("token key : retired key")
+)
+
+// Key 密钥
+type Key struct {
+	// ID 密钥ID；为空表示旧版本的密钥，签发的 token 不携带 kid
+	ID string
+	// SignKey 访问令牌的签名密钥(HS256)
+	SignKey string
+	// RefreshKey 刷新令牌的加密密钥(AES-CBC)
+	RefreshKey string
+	// NotBefore 生效时间；零值表示立即生效
+	NotBefore time.Time
+	// RetireAt 退役时间；零值表示不退役
+	RetireAt time.Time
+}
+
+// retired 是否已退役
+func (k *Key) retired(now time.Time) bool {
+	return !k.RetireAt.IsZero() && !now.Before(k.RetireAt)
+}
+
+// usable 是否可用于签发
+func (k *Key) usable(now time.Time) bool {
+	return !k.retired(now) && !now.Before(k.NotBefore)
+}
+
+// KeySet 密钥集合
+type KeySet struct {
+	keys map[string]*Key
+	// ordered 签发的优先级：携带 kid 的密钥优先，其次生效时间最晚的密钥
+	ordered []*Key
+}
+
+// NewKeySet 密钥集合；校验密钥ID不重复，刷新令牌的密钥长度
+func NewKeySet(keys []*Key) (*KeySet, error) {
+	s := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for i := range keys {
+		key := keys[i]
+		if key == nil {
+			continue
+		}
+		if strings.Contains(key.ID, refreshKeyIDSeparator) {
+			return nil, pkgerrors.Errorf("token key : kid %q must not contain %q", key.ID, refreshKeyIDSeparator)
+		}
+		if _, ok := s.keys[key.ID]; ok {
+			return nil, pkgerrors.Errorf("token key : duplicate kid %q", key.ID)
+		}
+		if key.SignKey == "" {
+			return nil, pkgerrors.Errorf("token key : kid %q : sign_key is empty", key.ID)
+		}
+		switch len(key.RefreshKey) {
+		case 16, 24, 32:
+		default:
+			return nil, pkgerrors.Errorf("token key : kid %q : refresh_key length must be 16, 24 or 32", key.ID)
+		}
+		if !key.RetireAt.IsZero() && !key.RetireAt.After(key.NotBefore) {
+			return nil, pkgerrors.Errorf("token key : kid %q : retire_at must be after not_before", key.ID)
+		}
+		s.keys[key.ID] = key
+		s.ordered = append(s.ordered, key)
+	}
+	if len(s.keys) == 0 {
+		return nil, pkgerrors.New("token key : empty key set")
+	}
+	sort.SliceStable(s.ordered, func(i, j int) bool {
+		if (s.ordered[i].ID == "") != (s.ordered[j].ID == "") {
+			return s.ordered[i].ID != ""
+		}
+		return s.ordered[i].NotBefore.After(s.ordered[j].NotBefore)
+	})
+	return s, nil
+}
+
+// Active 用于签发的密钥
+func (s *KeySet) Active(now time.Time) (*Key, error) {
+	for _, key := range s.ordered {
+		if key.usable(now) {
+			return key, nil
+		}
+	}
+	return nil, ErrNoActiveKey
+}
+
+// Lookup 用于验证的密钥；接受未退役的密钥，包括尚未生效的密钥
+func (s *KeySet) Lookup(kid string, now time.Time) (*Key, error) {
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, pkgerrors.WithMessagef(ErrUnknownKey, "kid %q", kid)
+	}
+	if key.retired(now) {
+		return nil, pkgerrors.WithMessagef(ErrRetiredKey, "kid %q", kid)
+	}
+	return key, nil
+}
+
+// Rotator 可更新的密钥集合；SignEncryptor 与 RefreshEncryptor 读取最新的密钥集合
+type Rotator struct {
+	keySet atomic.Pointer[KeySet]
+	now    func() time.Time
+}
+
+// NewRotator 可更新的密钥集合
+func NewRotator(keySet *KeySet) *Rotator {
+	r := &Rotator{now: time.Now}
+	r.keySet.Store(keySet)
+	return r
+}
+
+// Update 更新密钥集合；已签发的 token 使用未退役的密钥继续验证
+func (r *Rotator) Update(keySet *KeySet) {
+	if keySet != nil {
+		r.keySet.Store(keySet)
+	}
+}
+
+// KeySet 当前的密钥集合
+func (r *Rotator) KeySet() *KeySet {
+	return r.keySet.Load()
+}
+
+// active ...
+func (r *Rotator) active() (*Key, error) {
+	return r.keySet.Load().Active(r.now())
+}
+
+// lookup ...
+func (r *Rotator) lookup(kid string) (*Key, error) {
+	return r.keySet.Load().Lookup(kid, r.now())
+}
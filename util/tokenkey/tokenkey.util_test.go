@@ -0,0 +1,141 @@
+package tokenkeyutil
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	authpkg "github.com/ikaiguang/go-srv-kit/kratos/auth"
+	"github.com/stretchr/testify/require"
+)
+
+// go test -v ./util/tokenkey/ -count=1 -test.run=TestNewKeySet
+func TestNewKeySet(t *testing.T) {
+	now := time.Now()
+	legacy := &Key{SignKey: "legacy-sign", RefreshKey: "legacy-refresh16"}
+	k1 := &Key{ID: "k1", SignKey: "sign-1", RefreshKey: "refresh-key-1-16", NotBefore: now.Add(-2 * time.Hour)}
+	k2 := &Key{ID: "k2", SignKey: "sign-2", RefreshKey: "refresh-key-2-16", NotBefore: now.Add(-time.Hour)}
+	k3 := &Key{ID: "k3", SignKey: "sign-3", RefreshKey: "refresh-key-3-16", NotBefore: now.Add(time.Hour)}
+
+	// 携带 kid 的密钥优先；其次生效时间最晚的密钥；未生效的密钥不签发
+	keySet, err := NewKeySet([]*Key{legacy, k1, k2, k3})
+	require.NoError(t, err)
+	active, err := keySet.Active(now)
+	require.NoError(t, err)
+	require.Equal(t, "k2", active.ID)
+	active, err = keySet.Active(now.Add(2 * time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, "k3", active.ID)
+
+	// 旧版本的密钥
+	keySet, err = NewKeySet([]*Key{legacy, k3})
+	require.NoError(t, err)
+	active, err = keySet.Active(now)
+	require.NoError(t, err)
+	require.Equal(t, "", active.ID)
+
+	// 验证：接受未生效的密钥，拒绝已退役的密钥
+	retired := &Key{ID: "retired", SignKey: "sign", RefreshKey: "refresh-key-r-16", RetireAt: now.Add(-time.Minute)}
+	keySet, err = NewKeySet([]*Key{k3, retired})
+	require.NoError(t, err)
+	_, err = keySet.Active(now)
+	require.ErrorIs(t, err, ErrNoActiveKey)
+	_, err = keySet.Lookup("k3", now)
+	require.NoError(t, err)
+	_, err = keySet.Lookup("retired", now)
+	require.ErrorIs(t, err, ErrRetiredKey)
+	_, err = keySet.Lookup("unknown", now)
+	require.ErrorIs(t, err, ErrUnknownKey)
+
+	// 校验
+	_, err = NewKeySet(nil)
+	require.Error(t, err)
+	_, err = NewKeySet([]*Key{k1, k1})
+	require.Error(t, err)
+	_, err = NewKeySet([]*Key{{ID: "a.b", SignKey: "sign", RefreshKey: "refresh-key-x-16"}})
+	require.Error(t, err)
+	_, err = NewKeySet([]*Key{{ID: "short", SignKey: "sign", RefreshKey: "short"}})
+	require.Error(t, err)
+	_, err = NewKeySet([]*Key{{ID: "window", SignKey: "sign", RefreshKey: "refresh-key-w-16", NotBefore: now, RetireAt: now}})
+	require.Error(t, err)
+}
+
+// go test -v ./util/tokenkey/ -count=1 -test.run=TestRotator
+func TestRotator(t *testing.T) {
+	var (
+		ctx    = context.Background()
+		now    = time.Now()
+		legacy = &Key{SignKey: "legacy-sign", RefreshKey: "legacy-refresh16"}
+		k1     = &Key{ID: "k1", SignKey: "sign-1", RefreshKey: "refresh-key-1-16"}
+		k2     = &Key{ID: "k2", SignKey: "sign-2", RefreshKey: "refresh-key-2-16", NotBefore: now}
+		claims = func() *authpkg.Claims {
+			return &authpkg.Claims{RegisteredClaims: jwt.RegisteredClaims{ID: "testing", ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour))}}
+		}
+	)
+	keySet, err := NewKeySet([]*Key{legacy})
+	require.NoError(t, err)
+	rotator := NewRotator(keySet)
+	rotator.now = func() time.Time { return now }
+	signer, refresher := rotator.SignEncryptor(), rotator.RefreshEncryptor()
+
+	// 旧版本的密钥：不携带 kid，与 authpkg 的默认实现兼容
+	legacyToken, err := signer.EncryptToken(ctx, claims())
+	require.NoError(t, err)
+	decoded, err := authpkg.NewSignEncryptor(legacy.SignKey).DecryptToken(ctx, legacyToken)
+	require.NoError(t, err)
+	require.Equal(t, "testing", decoded.ID)
+	legacyRefresh, err := refresher.EncryptToken(ctx, claims())
+	require.NoError(t, err)
+	_, err = authpkg.NewCBCCipher(legacy.RefreshKey).DecryptToken(ctx, legacyRefresh)
+	require.NoError(t, err)
+
+	// 轮换：新 token 使用 k2 并携带 kid；旧 token 继续有效
+	keySet, err = NewKeySet([]*Key{legacy, k1, k2})
+	require.NoError(t, err)
+	rotator.Update(keySet)
+	token, err := signer.EncryptToken(ctx, claims())
+	require.NoError(t, err)
+	parsed, _, err := jwt.NewParser().ParseUnverified(token, &authpkg.Claims{})
+	require.NoError(t, err)
+	require.Equal(t, "k2", parsed.Header[HeaderKeyID])
+	_, err = signer.DecryptToken(ctx, token)
+	require.NoError(t, err)
+	_, err = signer.DecryptToken(ctx, legacyToken)
+	require.NoError(t, err)
+	refresh, err := refresher.EncryptToken(ctx, claims())
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(refresh, "k2."))
+	decoded, err = refresher.DecryptToken(ctx, refresh)
+	require.NoError(t, err)
+	require.Equal(t, "testing", decoded.ID)
+	_, err = refresher.DecryptToken(ctx, legacyRefresh)
+	require.NoError(t, err)
+
+	// 退役：旧版本的密钥移除后，旧 token 失效
+	k2Retired := *k2
+	k2Retired.NotBefore, k2Retired.RetireAt = now.Add(-time.Hour), now
+	keySet, err = NewKeySet([]*Key{k1, &k2Retired})
+	require.NoError(t, err)
+	rotator.Update(keySet)
+	_, err = signer.DecryptToken(ctx, legacyToken)
+	require.Error(t, err)
+	_, err = signer.DecryptToken(ctx, token)
+	require.Error(t, err)
+	_, err = refresher.DecryptToken(ctx, refresh)
+	require.ErrorContains(t, err, ErrRetiredKey.Error())
+	token, err = signer.EncryptToken(ctx, claims())
+	require.NoError(t, err)
+	parsed, _, err = jwt.NewParser().ParseUnverified(token, &authpkg.Claims{})
+	require.NoError(t, err)
+	require.Equal(t, "k1", parsed.Header[HeaderKeyID])
+
+	// 伪造：kid 正确，密钥错误
+	forged := jwt.NewWithClaims(jwt.SigningMethodHS256, claims())
+	forged.Header[HeaderKeyID] = "k1"
+	forgedToken, err := forged.SignedString([]byte("other"))
+	require.NoError(t, err)
+	_, err = signer.DecryptToken(ctx, forgedToken)
+	require.Error(t, err)
+}
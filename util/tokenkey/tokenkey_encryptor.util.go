@@ -0,0 +1,136 @@
+package tokenkeyutil
+
+import (
+	"context"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	aespkg "github.com/ikaiguang/go-srv-kit/kit/aes"
+	authpkg "github.com/ikaiguang/go-srv-kit/kratos/auth"
+	errorpkg "github.com/ikaiguang/go-srv-kit/kratos/error"
+	pkgerrors "github.com/pkg/errors"
+)
+
+var (
+	_ authpkg.SignEncryptor    = (*signEncryptor)(nil)
+	_ authpkg.RefreshEncryptor = (*refreshEncryptor)(nil)
+)
+
+// signEncryptor 访问令牌：HS256；头部携带 kid
+type signEncryptor struct {
+	rotator *Rotator
+}
+
+// SignEncryptor 访问令牌；authpkg.Config.SignCrypto
+func (r *Rotator) SignEncryptor() authpkg.SignEncryptor {
+	return &signEncryptor{rotator: r}
+}
+
+// JWTSigningKeyFunc 按 kid 选择密钥；不携带 kid 时使用旧版本的密钥
+func (s *signEncryptor) JWTSigningKeyFunc(ctx context.Context) jwt.Keyfunc {
+	return func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header[HeaderKeyID].(string)
+		key, err := s.rotator.lookup(kid)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(key.SignKey), nil
+	}
+}
+
+// JWTSigningMethod 签名方法
+func (s *signEncryptor) JWTSigningMethod() jwt.SigningMethod {
+	return jwt.SigningMethodHS256
+}
+
+// JWTSigningClaims 签名载体
+func (s *signEncryptor) JWTSigningClaims() jwt.Claims {
+	return &authpkg.Claims{}
+}
+
+// EncryptToken 使用生效的密钥签名
+func (s *signEncryptor) EncryptToken(ctx context.Context, authClaims *authpkg.Claims) (string, error) {
+	key, err := s.rotator.active()
+	if err != nil {
+		e := errorpkg.ErrorBadRequest("sign token failed")
+		return "", errorpkg.Wrap(e, err)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, authClaims)
+	if key.ID != "" {
+		token.Header[HeaderKeyID] = key.ID
+	}
+	signed, err := token.SignedString([]byte(key.SignKey))
+	if err != nil {
+		e := errorpkg.ErrorBadRequest("sign token failed")
+		return "", errorpkg.Wrap(e, err)
+	}
+	return signed, nil
+}
+
+// DecryptToken 验证签名
+func (s *signEncryptor) DecryptToken(ctx context.Context, accessToken string) (*authpkg.Claims, error) {
+	claims := &authpkg.Claims{}
+	_, err := jwt.ParseWithClaims(accessToken, claims, s.JWTSigningKeyFunc(ctx),
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+	)
+	if err != nil {
+		e := errorpkg.ErrorBadRequest("decrypt token failed")
+		return nil, errorpkg.Wrap(e, err)
+	}
+	return claims, nil
+}
+
+// refreshEncryptor 刷新令牌：AES-CBC；kid + "." + 密文
+type refreshEncryptor struct {
+	rotator *Rotator
+}
+
+// RefreshEncryptor 刷新令牌；authpkg.Config.RefreshCrypto
+func (r *Rotator) RefreshEncryptor() authpkg.RefreshEncryptor {
+	return &refreshEncryptor{rotator: r}
+}
+
+// EncryptToken 使用生效的密钥加密
+func (s *refreshEncryptor) EncryptToken(ctx context.Context, refreshClaims *authpkg.Claims) (string, error) {
+	key, err := s.rotator.active()
+	if err != nil {
+		e := errorpkg.ErrorBadRequest("crypto refresh claims failed")
+		return "", errorpkg.Wrap(e, err)
+	}
+	plaintext, err := refreshClaims.EncodeToString()
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := aespkg.EncryptCBC([]byte(plaintext), []byte(key.RefreshKey))
+	if err != nil {
+		e := errorpkg.ErrorBadRequest("crypto refresh claims failed")
+		return "", errorpkg.Wrap(e, err)
+	}
+	if key.ID == "" {
+		return ciphertext, nil
+	}
+	return key.ID + refreshKeyIDSeparator + ciphertext, nil
+}
+
+// DecryptToken 按 kid 选择密钥；不携带 kid 时使用旧版本的密钥
+func (s *refreshEncryptor) DecryptToken(ctx context.Context, refreshToken string) (*authpkg.Claims, error) {
+	kid, ciphertext, ok := strings.Cut(refreshToken, refreshKeyIDSeparator)
+	if !ok {
+		kid, ciphertext = "", refreshToken
+	}
+	key, err := s.rotator.lookup(kid)
+	if err != nil {
+		e := errorpkg.ErrorBadRequest("decode refresh token claims failed")
+		return nil, errorpkg.Wrap(e, err)
+	}
+	plaintext, err := aespkg.DecryptCBC(ciphertext, []byte(key.RefreshKey))
+	if err != nil {
+		e := errorpkg.ErrorBadRequest("decode refresh token claims failed")
+		return nil, errorpkg.Wrap(e, pkgerrors.WithStack(err))
+	}
+	claims := &authpkg.Claims{}
+	if err = claims.DecodeString(plaintext); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
@@ -0,0 +1,265 @@
+package transferencryptutil
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"strings"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+const (
+	// ModeOAEP RSA-OAEP(SHA-256)；适用于密码等短字段
+	ModeOAEP = "oaep"
+	// ModeHybrid RSA-OAEP 加密随机的 AES-256 密钥，AES-GCM 加密内容；适用于较大的内容
+	ModeHybrid = "hybrid"
+
+	// Algorithm 公钥的算法
+	Algorithm = "RSA-OAEP-256"
+	// MinKeyBits 密钥的最小位数
+	MinKeyBits = 2048
+
+	// aesKeySize AES-256
+	aesKeySize = 32
+	// separator 密文的分隔符
+	separator = ":"
+)
+
+var (
+	// ErrInvalidCiphertext 密文格式错误或解密失败；错误信息不包含密文与明文
+	ErrInvalidCiphertext = pkgerrors.New("transfer encrypt : invalid ciphertext")
+)
+
+// Encryptor 公钥加密；客户端或测试使用
+type Encryptor struct {
+	publicKey *rsa.PublicKey
+	keyID     string
+	publicPEM string
+}
+
+// NewEncryptor 公钥：PEM 格式的 PKIX 或 PKCS#1
+func NewEncryptor(publicKeyPEM string) (*Encryptor, error) {
+	publicKey, err := ParsePublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	return newEncryptor(publicKey)
+}
+
+// newEncryptor ...
+func newEncryptor(publicKey *rsa.PublicKey) (*Encryptor, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	sum := sha256.Sum256(der)
+	return &Encryptor{
+		publicKey: publicKey,
+		keyID:     hex.EncodeToString(sum[:8]),
+		publicPEM: string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})),
+	}, nil
+}
+
+// KeyID 公钥指纹：PKIX 的 SHA-256 前 8 个字节
+func (e *Encryptor) KeyID() string {
+	return e.keyID
+}
+
+// PublicKeyPEM PEM 格式的 PKIX 公钥
+func (e *Encryptor) PublicKeyPEM() string {
+	return e.publicPEM
+}
+
+// MaxOAEPSize ModeOAEP 可加密的最大字节数
+func (e *Encryptor) MaxOAEPSize() int {
+	return e.publicKey.Size() - 2*sha256.Size - 2
+}
+
+// Encrypt 加密；mode 为空时，超过 MaxOAEPSize 使用 ModeHybrid
+// 密文格式：oaep:<base64> 或 hybrid:<base64 加密的密钥>:<base64 nonce+密文>
+func (e *Encryptor) Encrypt(mode string, plaintext []byte) (string, error) {
+	if mode == "" {
+		mode = ModeOAEP
+		if len(plaintext) > e.MaxOAEPSize() {
+			mode = ModeHybrid
+		}
+	}
+	switch mode {
+	case ModeOAEP:
+		ciphertext, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, e.publicKey, plaintext, nil)
+		if err != nil {
+			return "", pkgerrors.WithStack(err)
+		}
+		return ModeOAEP + separator + base64.StdEncoding.EncodeToString(ciphertext), nil
+	case ModeHybrid:
+		key := make([]byte, aesKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return "", pkgerrors.WithStack(err)
+		}
+		encryptedKey, err := rsa.EncryptOAEP(sha256.New(), rand.Reader, e.publicKey, key, nil)
+		if err != nil {
+			return "", pkgerrors.WithStack(err)
+		}
+		gcm, err := newGCM(key)
+		if err != nil {
+			return "", err
+		}
+		nonce := make([]byte, gcm.NonceSize())
+		if _, err = rand.Read(nonce); err != nil {
+			return "", pkgerrors.WithStack(err)
+		}
+		sealed := gcm.Seal(nonce, nonce, plaintext, nil)
+		return ModeHybrid +
+			separator + base64.StdEncoding.EncodeToString(encryptedKey) +
+			separator + base64.StdEncoding.EncodeToString(sealed), nil
+	}
+	return "", pkgerrors.Errorf("transfer encrypt : unknown mode %q", mode)
+}
+
+// Cipher 传输加密；私钥解密
+type Cipher struct {
+	*Encryptor
+	privateKey *rsa.PrivateKey
+}
+
+// NewCipher 密钥对：PEM 格式；公钥为 PKIX 或 PKCS#1，私钥为 PKCS#8 或 PKCS#1；
+// 校验密钥的位数与公钥私钥是否匹配
+func NewCipher(publicKeyPEM, privateKeyPEM string) (*Cipher, error) {
+	publicKey, err := ParsePublicKey(publicKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	privateKey, err := ParsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+	if !privateKey.PublicKey.Equal(publicKey) {
+		return nil, pkgerrors.New("transfer encrypt : public key does not match private key")
+	}
+	encryptor, err := newEncryptor(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{
+		Encryptor:  encryptor,
+		privateKey: privateKey,
+	}, nil
+}
+
+// Decrypt 解密 Encrypt 的密文；无前缀时为 base64 的 RSA-OAEP 密文
+func (c *Cipher) Decrypt(ciphertext string) ([]byte, error) {
+	ciphertext = strings.TrimSpace(ciphertext)
+	mode, payload := ModeOAEP, ciphertext
+	if i := strings.Index(ciphertext, separator); i >= 0 {
+		mode, payload = ciphertext[:i], ciphertext[i+1:]
+	}
+	switch mode {
+	case ModeOAEP:
+		data, err := base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			return nil, ErrInvalidCiphertext
+		}
+		plaintext, err := rsa.DecryptOAEP(sha256.New(), nil, c.privateKey, data, nil)
+		if err != nil {
+			return nil, ErrInvalidCiphertext
+		}
+		return plaintext, nil
+	case ModeHybrid:
+		parts := strings.Split(payload, separator)
+		if len(parts) != 2 {
+			return nil, ErrInvalidCiphertext
+		}
+		encryptedKey, err := base64.StdEncoding.DecodeString(parts[0])
+		if err != nil {
+			return nil, ErrInvalidCiphertext
+		}
+		sealed, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return nil, ErrInvalidCiphertext
+		}
+		key, err := rsa.DecryptOAEP(sha256.New(), nil, c.privateKey, encryptedKey, nil)
+		if err != nil || len(key) != aesKeySize {
+			return nil, ErrInvalidCiphertext
+		}
+		gcm, err := newGCM(key)
+		if err != nil {
+			return nil, err
+		}
+		if len(sealed) < gcm.NonceSize() {
+			return nil, ErrInvalidCiphertext
+		}
+		plaintext, err := gcm.Open(nil, sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():], nil)
+		if err != nil {
+			return nil, ErrInvalidCiphertext
+		}
+		return plaintext, nil
+	}
+	return nil, ErrInvalidCiphertext
+}
+
+// newGCM AES-GCM
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, pkgerrors.WithStack(err)
+	}
+	return gcm, nil
+}
+
+// ParsePublicKey PEM 格式的 PKIX 或 PKCS#1 公钥
+func ParsePublicKey(publicKeyPEM string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(publicKeyPEM)))
+	if block == nil {
+		return nil, pkgerrors.New("transfer encrypt : invalid public key pem")
+	}
+	var publicKey *rsa.PublicKey
+	if key, err := x509.ParsePKIXPublicKey(block.Bytes); err == nil {
+		rsaKey, ok := key.(*rsa.PublicKey)
+		if !ok {
+			return nil, pkgerrors.New("transfer encrypt : public key is not rsa")
+		}
+		publicKey = rsaKey
+	} else if publicKey, err = x509.ParsePKCS1PublicKey(block.Bytes); err != nil {
+		return nil, pkgerrors.WithMessage(err, "transfer encrypt : parse public key")
+	}
+	if bits := publicKey.N.BitLen(); bits < MinKeyBits {
+		return nil, pkgerrors.Errorf("transfer encrypt : public key must be at least %d bits, got %d", MinKeyBits, bits)
+	}
+	return publicKey, nil
+}
+
+// ParsePrivateKey PEM 格式的 PKCS#8 或 PKCS#1 私钥
+func ParsePrivateKey(privateKeyPEM string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(privateKeyPEM)))
+	if block == nil {
+		return nil, pkgerrors.New("transfer encrypt : invalid private key pem")
+	}
+	var privateKey *rsa.PrivateKey
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		rsaKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, pkgerrors.New("transfer encrypt : private key is not rsa")
+		}
+		privateKey = rsaKey
+	} else if privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+		return nil, pkgerrors.WithMessage(err, "transfer encrypt : parse private key")
+	}
+	if err := privateKey.Validate(); err != nil {
+		return nil, pkgerrors.WithMessage(err, "transfer encrypt : validate private key")
+	}
+	if bits := privateKey.N.BitLen(); bits < MinKeyBits {
+		return nil, pkgerrors.Errorf("transfer encrypt : private key must be at least %d bits, got %d", MinKeyBits, bits)
+	}
+	return privateKey, nil
+}
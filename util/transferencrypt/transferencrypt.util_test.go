@@ -0,0 +1,211 @@
+package transferencryptutil
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	optionv1 "github.com/my-saas-platform/api-proto/api/options/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// newTestingKeyPair PEM：PKIX 公钥、PKCS#8 私钥
+func newTestingKeyPair(t *testing.T, bits int) (string, string) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, bits)
+	require.NoError(t, err)
+	publicDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	require.NoError(t, err)
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}))
+}
+
+// newTestingDescriptor
+// message TestingReq { string name; string password [transfer_encrypt]; bytes secret [transfer_encrypt];
+// repeated string tokens [transfer_encrypt]; TestingReq child; map<string, TestingReq> items; }
+func newTestingDescriptor(t *testing.T) protoreflect.MessageDescriptor {
+	encrypted := &descriptorpb.FieldOptions{}
+	proto.SetExtension(encrypted, optionv1.E_TransferEncrypt, true)
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, opts *descriptorpb.FieldOptions) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     typ.Enum(),
+			Label:    label.Enum(),
+			Options:  opts,
+		}
+	}
+	optional, repeated := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	child := field("child", 5, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, optional, nil)
+	child.TypeName = proto.String(".testing.TestingReq")
+	items := field("items", 6, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, repeated, nil)
+	items.TypeName = proto.String(".testing.TestingReq.ItemsEntry")
+	entryValue := field("value", 2, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE, optional, nil)
+	entryValue.TypeName = proto.String(".testing.TestingReq")
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("testing/transfer_encrypt.proto"),
+		Package: proto.String("testing"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("TestingReq"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				field("name", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional, nil),
+				field("password", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional, encrypted),
+				field("secret", 3, descriptorpb.FieldDescriptorProto_TYPE_BYTES, optional, encrypted),
+				field("tokens", 4, descriptorpb.FieldDescriptorProto_TYPE_STRING, repeated, encrypted),
+				child,
+				items,
+			},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("ItemsEntry"),
+				Field: []*descriptorpb.FieldDescriptorProto{
+					field("key", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional, nil),
+					entryValue,
+				},
+				Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+			}},
+		}},
+	}, nil)
+	require.NoError(t, err)
+	return file.Messages().Get(0)
+}
+
+// go test -v ./util/transferencrypt/ -count=1 -test.run=TestCipher
+func TestCipher(t *testing.T) {
+	publicKeyPEM, privateKeyPEM := newTestingKeyPair(t, 2048)
+	cipher, err := NewCipher(publicKeyPEM, privateKeyPEM)
+	require.NoError(t, err)
+	encryptor, err := NewEncryptor(publicKeyPEM)
+	require.NoError(t, err)
+	require.Equal(t, cipher.KeyID(), encryptor.KeyID())
+
+	// 短内容：RSA-OAEP；长内容：RSA+AES
+	ciphertext, err := encryptor.Encrypt("", []byte("password"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(ciphertext, ModeOAEP+":"))
+	plaintext, err := cipher.Decrypt(ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, "password", string(plaintext))
+	// 无前缀
+	plaintext, err = cipher.Decrypt(strings.TrimPrefix(ciphertext, ModeOAEP+":"))
+	require.NoError(t, err)
+	require.Equal(t, "password", string(plaintext))
+
+	large := []byte(strings.Repeat("large payload ", 1024))
+	ciphertext, err = encryptor.Encrypt("", large)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(ciphertext, ModeHybrid+":"))
+	plaintext, err = cipher.Decrypt(ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, large, plaintext)
+	_, err = encryptor.Encrypt(ModeOAEP, large)
+	require.Error(t, err)
+
+	// 篡改
+	_, err = cipher.Decrypt(ciphertext[:len(ciphertext)-8] + "AAAAAAA=")
+	require.ErrorIs(t, err, ErrInvalidCiphertext)
+	_, err = cipher.Decrypt("unknown:abc")
+	require.ErrorIs(t, err, ErrInvalidCiphertext)
+
+	// 密钥校验
+	otherPublicKeyPEM, _ := newTestingKeyPair(t, 2048)
+	_, err = NewCipher(otherPublicKeyPEM, privateKeyPEM)
+	require.Error(t, err)
+	weakPublicKeyPEM, weakPrivateKeyPEM := newTestingKeyPair(t, 1024)
+	_, err = NewCipher(weakPublicKeyPEM, weakPrivateKeyPEM)
+	require.Error(t, err)
+	_, err = NewCipher("invalid", privateKeyPEM)
+	require.Error(t, err)
+
+	// 公钥
+	recorder := httptest.NewRecorder()
+	cipher.PublicKeyHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, DefaultPublicKeyPath, nil))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	resp := &PublicKeyResponse{}
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), resp))
+	require.Equal(t, cipher.KeyID(), resp.KeyID)
+	require.Equal(t, Algorithm, resp.Algorithm)
+	_, err = NewEncryptor(resp.PublicKey)
+	require.NoError(t, err)
+	require.NotContains(t, recorder.Body.String(), "PRIVATE")
+}
+
+// go test -v ./util/transferencrypt/ -count=1 -test.run=TestCipher_DecryptMessage
+func TestCipher_DecryptMessage(t *testing.T) {
+	publicKeyPEM, privateKeyPEM := newTestingKeyPair(t, 2048)
+	cipher, err := NewCipher(publicKeyPEM, privateKeyPEM)
+	require.NoError(t, err)
+	md := newTestingDescriptor(t)
+	require.True(t, HasTransferEncryptFields(md))
+	encrypt := func(plaintext string) string {
+		ciphertext, err := cipher.Encrypt("", []byte(plaintext))
+		require.NoError(t, err)
+		return ciphertext
+	}
+
+	fields := md.Fields()
+	child := dynamicpb.NewMessage(md)
+	child.Set(fields.ByName("password"), protoreflect.ValueOfString(encrypt("child-password")))
+	item := dynamicpb.NewMessage(md)
+	item.Set(fields.ByName("password"), protoreflect.ValueOfString(encrypt("item-password")))
+
+	req := dynamicpb.NewMessage(md)
+	req.Set(fields.ByName("name"), protoreflect.ValueOfString("testing"))
+	req.Set(fields.ByName("password"), protoreflect.ValueOfString(encrypt("password")))
+	req.Set(fields.ByName("secret"), protoreflect.ValueOfBytes([]byte(encrypt("secret"))))
+	tokens := req.Mutable(fields.ByName("tokens")).List()
+	tokens.Append(protoreflect.ValueOfString(encrypt("token-1")))
+	tokens.Append(protoreflect.ValueOfString(encrypt("token-2")))
+	req.Set(fields.ByName("child"), protoreflect.ValueOfMessage(child))
+	req.Mutable(fields.ByName("items")).Map().Set(protoreflect.ValueOfString("item").MapKey(), protoreflect.ValueOfMessage(item))
+	original := proto.Clone(req)
+
+	decrypted, err := cipher.DecryptMessage(req)
+	require.NoError(t, err)
+	m := decrypted.ProtoReflect()
+	require.Equal(t, "testing", m.Get(fields.ByName("name")).String())
+	require.Equal(t, "password", m.Get(fields.ByName("password")).String())
+	require.Equal(t, "secret", string(m.Get(fields.ByName("secret")).Bytes()))
+	require.Equal(t, "token-2", m.Get(fields.ByName("tokens")).List().Get(1).String())
+	require.Equal(t, "child-password", m.Get(fields.ByName("child")).Message().Get(fields.ByName("password")).String())
+	itemValue := m.Get(fields.ByName("items")).Map().Get(protoreflect.ValueOfString("item").MapKey())
+	require.Equal(t, "item-password", itemValue.Message().Get(fields.ByName("password")).String())
+	// 原始请求不变
+	require.True(t, proto.Equal(original, req))
+
+	// 脱敏
+	redacted := Redact(decrypted)
+	require.Contains(t, redacted, "testing")
+	require.Contains(t, redacted, RedactedValue)
+	require.NotContains(t, redacted, "child-password")
+	require.NotContains(t, redacted, "token-1")
+
+	// 解密失败：错误仅包含字段名
+	req.Set(fields.ByName("password"), protoreflect.ValueOfString("oaep:plaintext-password"))
+	_, err = cipher.DecryptMessage(req)
+	require.ErrorIs(t, err, ErrInvalidCiphertext)
+	fieldErr, ok := AsFieldError(err)
+	require.True(t, ok)
+	require.Equal(t, "testing.TestingReq.password", fieldErr.Field)
+	require.NotContains(t, err.Error(), "plaintext-password")
+
+	// 不包含传输加密的字段
+	plain := &descriptorpb.FieldOptions{}
+	result, err := cipher.DecryptMessage(plain)
+	require.NoError(t, err)
+	require.Same(t, plain, result)
+}
@@ -0,0 +1,55 @@
+package transferencryptutil
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	// DefaultPublicKeyPath 公钥的 http 路径
+	DefaultPublicKeyPath = "/api/v1/transfer-encrypt/public-key"
+)
+
+// PublicKeyResponse 公钥
+type PublicKeyResponse struct {
+	// KeyID 公钥指纹；公钥变更时变更，客户端可据此更新缓存
+	KeyID string `json:"key_id"`
+	// Algorithm RSA-OAEP-256
+	Algorithm string `json:"algorithm"`
+	// Modes 支持的加密方式
+	Modes []string `json:"modes"`
+	// MaxOAEPSize ModeOAEP 可加密的最大字节数；超过时使用 ModeHybrid
+	MaxOAEPSize int `json:"max_oaep_size"`
+	// PublicKey PEM 格式的 PKIX 公钥
+	PublicKey string `json:"public_key"`
+}
+
+// PublicKey 公钥
+func (e *Encryptor) PublicKey() *PublicKeyResponse {
+	return &PublicKeyResponse{
+		KeyID:       e.keyID,
+		Algorithm:   Algorithm,
+		Modes:       []string{ModeOAEP, ModeHybrid},
+		MaxOAEPSize: e.MaxOAEPSize(),
+		PublicKey:   e.publicPEM,
+	}
+}
+
+// PublicKeyHandler 公钥(json)；仅支持 GET
+func (e *Encryptor) PublicKeyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		w.Header().Set("ETag", `"`+e.keyID+`"`)
+		if r.Header.Get("If-None-Match") == `"`+e.keyID+`"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(e.PublicKey())
+	})
+}
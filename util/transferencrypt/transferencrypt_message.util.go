@@ -0,0 +1,196 @@
+package transferencryptutil
+
+import (
+	"sync"
+
+	optionv1 "github.com/my-saas-platform/api-proto/api/options/v1"
+	pkgerrors "github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	// RedactedValue 脱敏后的值
+	RedactedValue = "******"
+)
+
+// FieldError 字段解密失败；错误信息仅包含字段名
+type FieldError struct {
+	// Field 字段的完整名称；例：saas.api.user.resourcev1.LoginReq.password
+	Field string
+}
+
+// Error ...
+func (e *FieldError) Error() string {
+	return "transfer encrypt : decrypt field " + e.Field + " failed"
+}
+
+// Is errors.Is(err, ErrInvalidCiphertext)
+func (e *FieldError) Is(target error) bool {
+	return target == ErrInvalidCiphertext
+}
+
+// AsFieldError 获取解密失败的字段
+func AsFieldError(err error) (*FieldError, bool) {
+	var e *FieldError
+	ok := pkgerrors.As(err, &e)
+	return e, ok
+}
+
+// IsTransferEncryptField 字段是否配置 (saas.api.options.optionv1.transfer_encrypt) = true
+func IsTransferEncryptField(fd protoreflect.FieldDescriptor) bool {
+	if fd.Kind() != protoreflect.StringKind && fd.Kind() != protoreflect.BytesKind {
+		return false
+	}
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return false
+	}
+	enabled, _ := proto.GetExtension(opts, optionv1.E_TransferEncrypt).(bool)
+	return enabled
+}
+
+// _encryptedMessages 消息(含嵌套的消息)是否包含传输加密的字段；key 为消息的完整名称
+var _encryptedMessages sync.Map
+
+// HasTransferEncryptFields 消息(含嵌套的消息)是否包含传输加密的字段
+func HasTransferEncryptFields(md protoreflect.MessageDescriptor) bool {
+	return hasTransferEncryptFields(md, make(map[protoreflect.FullName]bool))
+}
+
+// hasTransferEncryptFields visiting 防止循环引用
+func hasTransferEncryptFields(md protoreflect.MessageDescriptor, visiting map[protoreflect.FullName]bool) bool {
+	if v, ok := _encryptedMessages.Load(md.FullName()); ok {
+		return v.(bool)
+	}
+	if visiting[md.FullName()] {
+		return false
+	}
+	visiting[md.FullName()] = true
+
+	has := false
+	fields := md.Fields()
+	for i := 0; i < fields.Len() && !has; i++ {
+		fd := fields.Get(i)
+		switch {
+		case IsTransferEncryptField(fd):
+			has = true
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				has = hasTransferEncryptFields(fd.MapValue().Message(), visiting)
+			}
+		case fd.Message() != nil:
+			has = hasTransferEncryptFields(fd.Message(), visiting)
+		}
+	}
+	delete(visiting, md.FullName())
+	// 循环引用时，仅缓存最外层的结果
+	if len(visiting) == 0 || has {
+		_encryptedMessages.Store(md.FullName(), has)
+	}
+	return has
+}
+
+// DecryptMessage 解密传输加密的字段；返回副本，不修改 msg，日志中间件记录的请求参数仍为密文；
+// 不包含传输加密的字段时返回 msg；解密失败时返回 *FieldError
+func (c *Cipher) DecryptMessage(msg proto.Message) (proto.Message, error) {
+	if msg == nil || !HasTransferEncryptFields(msg.ProtoReflect().Descriptor()) {
+		return msg, nil
+	}
+	clone := proto.Clone(msg)
+	err := walkMessage(clone.ProtoReflect(), func(fd protoreflect.FieldDescriptor, v protoreflect.Value) (protoreflect.Value, error) {
+		var ciphertext string
+		if fd.Kind() == protoreflect.BytesKind {
+			ciphertext = string(v.Bytes())
+		} else {
+			ciphertext = v.String()
+		}
+		if ciphertext == "" {
+			return v, nil
+		}
+		plaintext, err := c.Decrypt(ciphertext)
+		if err != nil {
+			return v, &FieldError{Field: string(fd.FullName())}
+		}
+		if fd.Kind() == protoreflect.BytesKind {
+			return protoreflect.ValueOfBytes(plaintext), nil
+		}
+		return protoreflect.ValueOfString(string(plaintext)), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+// Redact 脱敏：传输加密的字段替换为 RedactedValue；用于记录解密后的消息
+func Redact(msg proto.Message) string {
+	if msg == nil {
+		return ""
+	}
+	if HasTransferEncryptFields(msg.ProtoReflect().Descriptor()) {
+		msg = proto.Clone(msg)
+		_ = walkMessage(msg.ProtoReflect(), func(fd protoreflect.FieldDescriptor, v protoreflect.Value) (protoreflect.Value, error) {
+			if fd.Kind() == protoreflect.BytesKind {
+				return protoreflect.ValueOfBytes([]byte(RedactedValue)), nil
+			}
+			return protoreflect.ValueOfString(RedactedValue), nil
+		})
+	}
+	return protojson.MarshalOptions{}.Format(msg)
+}
+
+// walkMessage 遍历已设置的传输加密字段；包含嵌套的消息、列表与 map 的值
+func walkMessage(m protoreflect.Message, fn func(protoreflect.FieldDescriptor, protoreflect.Value) (protoreflect.Value, error)) error {
+	// 遍历结束后再修改消息
+	var fds []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fds = append(fds, fd)
+		return true
+	})
+	for _, fd := range fds {
+		var err error
+		v := m.Get(fd)
+		switch {
+		case IsTransferEncryptField(fd) && fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				var nv protoreflect.Value
+				if nv, err = fn(fd, list.Get(i)); err == nil {
+					list.Set(i, nv)
+				}
+			}
+		case IsTransferEncryptField(fd) && !fd.IsMap():
+			var nv protoreflect.Value
+			if nv, err = fn(fd, v); err == nil {
+				m.Set(fd, nv)
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil || !HasTransferEncryptFields(fd.MapValue().Message()) {
+				continue
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				err = walkMessage(mv.Message(), fn)
+				return err == nil
+			})
+		case fd.Message() != nil:
+			if !HasTransferEncryptFields(fd.Message()) {
+				continue
+			}
+			if fd.IsList() {
+				list := v.List()
+				for i := 0; i < list.Len() && err == nil; i++ {
+					err = walkMessage(list.Get(i).Message(), fn)
+				}
+			} else {
+				err = walkMessage(v.Message(), fn)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}